@@ -3,8 +3,8 @@
 // option features.field_presence = IMPLICIT;
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_go_features, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
@@ -13,10 +13,11 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file grpc/v1/toyotachikuro.proto.
  */
 export const file_grpc_v1_toyotachikuro: GenFile = /*@__PURE__*/
-  fileDesc("ChtncnBjL3YxL3RveW90YWNoaWt1cm8ucHJvdG8SB2dycGMudjEiawoERmlsZRIKCgJpZBgBIAEoCRIWCg5wYXRoaXN0X2ZvbGRlchgCIAEoCRIMCgRzaXplGAMgASgDEjEKDW1vZGlmaWVkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoQCCgdDb21wYW55EgoKAmlkGAEgASgJEhYKDnBhdGhpc3RfZm9sZGVyGAIgASgJEhIKCnNob3J0X25hbWUYAyABKAkSFgoOY2F0ZWdvcnlfaW5kZXgYBCABKAUSGQoRcGVyc2lzdF9sb25nX25hbWUYBSABKAkSGwoTcGVyc2lzdF9wb3N0YWxfY29kZRgGIAEoCRIXCg9wZXJzaXN0X2FkZHJlc3MYByABKAkSEwoLcGVyc2lzdF90ZWwYCCABKAkSEwoLcGVyc2lzdF9mYXgYCSABKAkSFQoNcGVyc2lzdF9lbWFpbBgKIAEoCRIXCg9wZXJzaXN0X3dlYnNpdGUYCyABKAkiLwoPQ29tcGFueUNhdGVnb3J5Eg0KBWluZGV4GAEgASgFEg0KBWxhYmVsGAIgASgJIsMBCgRLb2ppEgoKAmlkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIWCg5wYXRoaXN0X2ZvbGRlchgDIAEoCRIpCgVzdGFydBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMY29tcGFueV9uYW1lGAUgASgJEhUKDWxvY2F0aW9uX25hbWUYBiABKAkSLwoLcGVyc2lzdF9lbmQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIikKD0dldEZpbGVzUmVxdWVzdBIWCg5wYXRoaXN0X2ZvbGRlchgBIAEoCSIwChBHZXRGaWxlc1Jlc3BvbnNlEhwKBWZpbGVzGAEgAygLMg0uZ3JwYy52MS5GaWxlIh0KG0dldEZpbGVQYXRoaXN0Rm9sZGVyUmVxdWVzdCI2ChxHZXRGaWxlUGF0aGlzdEZvbGRlclJlc3BvbnNlEhYKDnBhdGhpc3RfZm9sZGVyGAEgASgJIiYKE0dldENvbXBhbmllc1JlcXVlc3QSDwoHcmVmcmVzaBgBIAEoCCKbAQoUR2V0Q29tcGFuaWVzUmVzcG9uc2USPwoJY29tcGFuaWVzGAEgAygLMiwuZ3JwYy52MS5HZXRDb21wYW5pZXNSZXNwb25zZS5Db21wYW5pZXNFbnRyeRpCCg5Db21wYW5pZXNFbnRyeRILCgNrZXkYASABKAkSHwoFdmFsdWUYAiABKAsyEC5ncnBjLnYxLkNvbXBhbnk6AjgBIh8KEUdldENvbXBhbnlSZXF1ZXN0EgoKAmlkGAEgASgJIjcKEkdldENvbXBhbnlSZXNwb25zZRIhCgdjb21wYW55GAEgASgLMhAuZ3JwYy52MS5Db21wYW55Ik4KFFVwZGF0ZUNvbXBhbnlSZXF1ZXN0Eg8KB3ByZXZfaWQYASABKAkSJQoLbmV3X2NvbXBhbnkYAiABKAsyEC5ncnBjLnYxLkNvbXBhbnkiPwoVVXBkYXRlQ29tcGFueVJlc3BvbnNlEiYKDHByZXZfY29tcGFueRgBIAEoCzIQLmdycGMudjEuQ29tcGFueSIdChtHZXRDb21wYW55Q2F0ZWdvcmllc1JlcXVlc3QiTAocR2V0Q29tcGFueUNhdGVnb3JpZXNSZXNwb25zZRIsCgpjYXRlZ29yaWVzGAEgAygLMhguZ3JwYy52MS5Db21wYW55Q2F0ZWdvcnkiEgoQR2V0S29qaWVzUmVxdWVzdCKJAQoRR2V0S29qaWVzUmVzcG9uc2USNgoGa29qaWVzGAEgAygLMiYuZ3JwYy52MS5HZXRLb2ppZXNSZXNwb25zZS5Lb2ppZXNFbnRyeRo8CgtLb2ppZXNFbnRyeRILCgNrZXkYASABKAkSHAoFdmFsdWUYAiABKAsyDS5ncnBjLnYxLktvamk6AjgBIhwKDkdldEtvamlSZXF1ZXN0EgoKAmlkGAEgASgJIi4KD0dldEtvamlSZXNwb25zZRIbCgRrb2ppGAEgASgLMg0uZ3JwYy52MS5Lb2ppIjQKEVVwZGF0ZUtvamlSZXF1ZXN0Eh8KCG5ld19rb2ppGAEgASgLMg0uZ3JwYy52MS5Lb2ppIjYKElVwZGF0ZUtvamlSZXNwb25zZRIgCglwcmV2X2tvamkYASABKAsyDS5ncnBjLnYxLktvamkyswEKC0ZpbGVTZXJ2aWNlEj8KCEdldEZpbGVzEhguZ3JwYy52MS5HZXRGaWxlc1JlcXVlc3QaGS5ncnBjLnYxLkdldEZpbGVzUmVzcG9uc2USYwoUR2V0RmlsZVBhdGhpc3RGb2xkZXISJC5ncnBjLnYxLkdldEZpbGVQYXRoaXN0Rm9sZGVyUmVxdWVzdBolLmdycGMudjEuR2V0RmlsZVBhdGhpc3RGb2xkZXJSZXNwb25zZTLZAgoOQ29tcGFueVNlcnZpY2USSwoMR2V0Q29tcGFuaWVzEhwuZ3JwYy52MS5HZXRDb21wYW5pZXNSZXF1ZXN0Gh0uZ3JwYy52MS5HZXRDb21wYW5pZXNSZXNwb25zZRJFCgpHZXRDb21wYW55EhouZ3JwYy52MS5HZXRDb21wYW55UmVxdWVzdBobLmdycGMudjEuR2V0Q29tcGFueVJlc3BvbnNlEk4KDVVwZGF0ZUNvbXBhbnkSHS5ncnBjLnYxLlVwZGF0ZUNvbXBhbnlSZXF1ZXN0Gh4uZ3JwYy52MS5VcGRhdGVDb21wYW55UmVzcG9uc2USYwoUR2V0Q29tcGFueUNhdGVnb3JpZXMSJC5ncnBjLnYxLkdldENvbXBhbnlDYXRlZ29yaWVzUmVxdWVzdBolLmdycGMudjEuR2V0Q29tcGFueUNhdGVnb3JpZXNSZXNwb25zZTLWAQoLS29qaVNlcnZpY2USPAoHR2V0S29qaRIXLmdycGMudjEuR2V0S29qaVJlcXVlc3QaGC5ncnBjLnYxLkdldEtvamlSZXNwb25zZRJCCglHZXRLb2ppZXMSGS5ncnBjLnYxLkdldEtvamllc1JlcXVlc3QaGi5ncnBjLnYxLkdldEtvamllc1Jlc3BvbnNlEkUKClVwZGF0ZUtvamkSGi5ncnBjLnYxLlVwZGF0ZUtvamlSZXF1ZXN0GhsuZ3JwYy52MS5VcGRhdGVLb2ppUmVzcG9uc2VCiAEKC2NvbS5ncnBjLnYxQhJUb3lvdGFjaGlrdXJvUHJvdG9QAVoec2VydmVyLWdycGMvZ2VuL2dycGMvdjE7Z3JwY3YxogIDR1hYqgIHR3JwYy5WMcoCB0dycGNcVjHiAhNHcnBjXFYxXEdQQk1ldGFkYXRh6gIIR3JwYzo6VjGSAwcIAtI+AhADYghlZGl0aW9uc3DoBw", [file_google_protobuf_go_features, file_google_protobuf_timestamp]);
+  fileDesc("ChtncnBjL3YxL3RveW90YWNoaWt1cm8ucHJvdG8SB2dycGMudjEi/AEKBEZpbGUSCgoCaWQYASABKAkSFgoOcGF0aGlzdF9mb2xkZXIYAiABKAkSDAoEc2l6ZRgDIAEoAxIxCg1tb2RpZmllZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRuYW1lGAUgASgJEhEKCWV4dGVuc2lvbhgGIAEoCRIOCgZpc19kaXIYByABKAgSFgoOc3ltbGlua190YXJnZXQYCCABKAkSEQoJbWltZV90eXBlGAkgASgJEg4KBmhpZGRlbhgKIAEoCBIOCgZzeXN0ZW0YCyABKAgSEwoLY2hpbGRfY291bnQYDCABKAUinQIKB0NvbXBhbnkSCgoCaWQYASABKAkSFgoOcGF0aGlzdF9mb2xkZXIYAiABKAkSEgoKc2hvcnRfbmFtZRgDIAEoCRIWCg5jYXRlZ29yeV9pbmRleBgEIAEoBRIZChFwZXJzaXN0X2xvbmdfbmFtZRgFIAEoCRIbChNwZXJzaXN0X3Bvc3RhbF9jb2RlGAYgASgJEhcKD3BlcnNpc3RfYWRkcmVzcxgHIAEoCRITCgtwZXJzaXN0X3RlbBgIIAEoCRITCgtwZXJzaXN0X2ZheBgJIAEoCRIVCg1wZXJzaXN0X2VtYWlsGAogASgJEhcKD3BlcnNpc3Rfd2Vic2l0ZRgLIAEoCRIXCg9wZXJzaXN0X3JlYWRpbmcYDCABKAkiLwoPQ29tcGFueUNhdGVnb3J5Eg0KBWluZGV4GAEgASgFEg0KBWxhYmVsGAIgASgJIsMBCgRLb2ppEgoKAmlkGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIWCg5wYXRoaXN0X2ZvbGRlchgDIAEoCRIpCgVzdGFydBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMY29tcGFueV9uYW1lGAUgASgJEhUKDWxvY2F0aW9uX25hbWUYBiABKAkSLwoLcGVyc2lzdF9lbmQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIokBCgtDaGFuZ2VFbnRyeRILCgNzZXEYASABKAQSKAoEdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEa2luZBgDIAEoCRIKCgJvcBgEIAEoCRIWCg5wYXRoaXN0X2ZvbGRlchgFIAEoCRIRCgllbnRpdHlfaWQYBiABKAkiKAoMRmlsZVRyYW5zZmVyEgsKA3NyYxgBIAEoCRILCgNkc3QYAiABKAkiMwoSRmlsZU9wZXJhdGlvbkVycm9yEgwKBGNvZGUYASABKAkSDwoHbWVzc2FnZRgCIAEoCSJ4ChNGaWxlT3BlcmF0aW9uUmVzdWx0EgsKA3NyYxgBIAEoCRILCgNkc3QYAiABKAkSCgoCb2sYAyABKAgSDwoHc2tpcHBlZBgEIAEoCBIqCgVlcnJvchgFIAEoCzIbLmdycGMudjEuRmlsZU9wZXJhdGlvbkVycm9yIlUKDkJhdGNoT3BlcmF0aW9uEikKBGtpbmQYASABKA4yGy5ncnBjLnYxLkJhdGNoT3BlcmF0aW9uS2luZBILCgNzcmMYAiABKAkSCwoDZHN0GAMgASgJIq8BChRCYXRjaE9wZXJhdGlvblJlc3VsdBINCgVpbmRleBgBIAEoBRIqCglvcGVyYXRpb24YAiABKAsyFy5ncnBjLnYxLkJhdGNoT3BlcmF0aW9uEgoKAm9rGAMgASgIEg8KB3NraXBwZWQYBCABKAgSEwoLcm9sbGVkX2JhY2sYBSABKAgSKgoFZXJyb3IYBiABKAsyGy5ncnBjLnYxLkZpbGVPcGVyYXRpb25FcnJvciKeAQoPRm9sZGVyTmFtZUlzc3VlEhUKDXJlbGF0aXZlX3BhdGgYASABKAkSDAoEbmFtZRgCIAEoCRIXCg9ub3JtYWxpemVkX25hbWUYAyABKAkSEAoIY29uZmxpY3QYBCABKAgSDwoHcmVuYW1lZBgFIAEoCBIqCgVlcnJvchgGIAEoCzIbLmdycGMudjEuRmlsZU9wZXJhdGlvbkVycm9yIpwBCglUcmFzaEl0ZW0SCgoCaWQYASABKAkSHwoXb3JpZ2luYWxfcGF0aGlzdF9mb2xkZXIYAiABKAkSEgoKZGVsZXRlZF9ieRgDIAEoCRIwCgxkZWxldGVkX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBmlzX2RpchgFIAEoCBIMCgRzaXplGAYgASgDIrEBCgtGaWxlVmVyc2lvbhIKCgJpZBgBIAEoCRIVCg1yZWxhdGl2ZV9wYXRoGAIgASgJEg4KBnJlYXNvbhgDIAEoCRIuCgpzYXZlZF90aW1lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1tb2RpZmllZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRzaXplGAYgASgDImIKDkR1cGxpY2F0ZUdyb3VwEg4KBmRpZ2VzdBgBIAEoCRIMCgRzaXplGAIgASgDEhwKBWZpbGVzGAMgAygLMg0uZ3JwYy52MS5GaWxlEhQKDHdhc3RlZF9ieXRlcxgEIAEoAyJSCg1GaWxlU2VhcmNoSGl0EhsKBGZpbGUYASABKAsyDS5ncnBjLnYxLkZpbGUSFQoNcmVsYXRpdmVfcGF0aBgCIAEoCRINCgVzY29yZRgDIAEoASIqCgtXb3JrYm9va1JvdxILCgNyb3cYASABKAUSDgoGdmFsdWVzGAIgAygJIpgBChRXb3JrYm9va1NoZWV0U3VtbWFyeRIMCgRuYW1lGAEgASgJEg4KBmhpZGRlbhgCIAEoCBIRCglyb3dfY291bnQYAyABKAUSFAoMY29sdW1uX2NvdW50GAQgASgFEhIKCmNlbGxfY291bnQYBSABKAUSJQoHcHJldmlldxgGIAMoCzIULmdycGMudjEuV29ya2Jvb2tSb3ciPgoRV29ya2Jvb2tDZWxsTWF0Y2gSDQoFc2hlZXQYASABKAkSDAoEY2VsbBgCIAEoCRIMCgR0ZXh0GAMgASgJIpgBChFXb3JrYm9va1NlYXJjaEhpdBIbCgRmaWxlGAEgASgLMg0uZ3JwYy52MS5GaWxlEhUKDXJlbGF0aXZlX3BhdGgYAiABKAkSDQoFc2NvcmUYAyABKAESKwoHbWF0Y2hlcxgEIAMoCzIaLmdycGMudjEuV29ya2Jvb2tDZWxsTWF0Y2gSEwoLbWF0Y2hfY291bnQYBSABKAUiZwoORGlza1VzYWdlRW50cnkSDAoEbmFtZRgBIAEoCRIVCg1yZWxhdGl2ZV9wYXRoGAIgASgJEg4KBmlzX2RpchgDIAEoCBIMCgRzaXplGAQgASgDEhIKCmZpbGVfY291bnQYBSABKAMi+AEKDU1lZGlhTWV0YWRhdGESGwoEZmlsZRgBIAEoCzINLmdycGMudjEuRmlsZRINCgV3aWR0aBgCIAEoBRIOCgZoZWlnaHQYAyABKAUSEwoLb3JpZW50YXRpb24YBCABKAUSMAoMY2FwdHVyZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgtjYW1lcmFfbWFrZRgGIAEoCRIUCgxjYW1lcmFfbW9kZWwYByABKAkSFAoMaGFzX2xvY2F0aW9uGAggASgIEhAKCGxhdGl0dWRlGAkgASgBEhEKCWxvbmdpdHVkZRgKIAEoASJpCg5QaG90b0FsYnVtSXRlbRIoCghtZXRhZGF0YRgBIAEoCzIWLmdycGMudjEuTWVkaWFNZXRhZGF0YRIVCg1yZWxhdGl2ZV9wYXRoGAIgASgJEhYKDm91dHNpZGVfcGVyaW9kGAMgASgIIl4KDVBob3RvQWxidW1EYXkSDAoEZGF0ZRgBIAEoCRInCgZwaG90b3MYAiADKAsyFy5ncnBjLnYxLlBob3RvQWxidW1JdGVtEhYKDm91dHNpZGVfcGVyaW9kGAMgASgIIuICCg9HZXRGaWxlc1JlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkSDQoFZGVwdGgYAiABKAUSDQoFZ2xvYnMYAyADKAkSEgoKZXh0ZW5zaW9ucxgEIAMoCRIQCghtaW5fc2l6ZRgFIAEoAxIQCghtYXhfc2l6ZRgGIAEoAxIyCg5tb2RpZmllZF9hZnRlchgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoPbW9kaWZpZWRfYmVmb3JlGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBImCghzb3J0X2tleRgJIAEoDjIULmdycGMudjEuRmlsZVNvcnRLZXkSEgoKZGVzY2VuZGluZxgKIAEoCBIVCg1mb2xkZXJzX2ZpcnN0GAsgASgIEhEKCXBhZ2Vfc2l6ZRgMIAEoBRISCgpwYWdlX3Rva2VuGA0gASgJIl4KEEdldEZpbGVzUmVzcG9uc2USHAoFZmlsZXMYASADKAsyDS5ncnBjLnYxLkZpbGUSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhMKC3RvdGFsX2NvdW50GAMgASgFIh0KG0dldEZpbGVQYXRoaXN0Rm9sZGVyUmVxdWVzdCI2ChxHZXRGaWxlUGF0aGlzdEZvbGRlclJlc3BvbnNlEhYKDnBhdGhpc3RfZm9sZGVyGAEgASgJImwKEENvcHlGaWxlc1JlcXVlc3QSJAoFaXRlbXMYASADKAsyFS5ncnBjLnYxLkZpbGVUcmFuc2ZlchIyChBvdmVyd3JpdGVfcG9saWN5GAIgASgOMhguZ3JwYy52MS5PdmVyd3JpdGVQb2xpY3kiQgoRQ29weUZpbGVzUmVzcG9uc2USLQoHcmVzdWx0cxgBIAMoCzIcLmdycGMudjEuRmlsZU9wZXJhdGlvblJlc3VsdCJsChBNb3ZlRmlsZXNSZXF1ZXN0EiQKBWl0ZW1zGAEgAygLMhUuZ3JwYy52MS5GaWxlVHJhbnNmZXISMgoQb3ZlcndyaXRlX3BvbGljeRgCIAEoDjIYLmdycGMudjEuT3ZlcndyaXRlUG9saWN5IkIKEU1vdmVGaWxlc1Jlc3BvbnNlEi0KB3Jlc3VsdHMYASADKAsyHC5ncnBjLnYxLkZpbGVPcGVyYXRpb25SZXN1bHQiLQoSRGVsZXRlRmlsZXNSZXF1ZXN0EhcKD3BhdGhpc3RfZm9sZGVycxgBIAMoCSJEChNEZWxldGVGaWxlc1Jlc3BvbnNlEi0KB3Jlc3VsdHMYASADKAsyHC5ncnBjLnYxLkZpbGVPcGVyYXRpb25SZXN1bHQiPgoTQ3JlYXRlRm9sZGVyUmVxdWVzdBIWCg5wYXRoaXN0X2ZvbGRlchgBIAEoCRIPCgdwYXJlbnRzGAIgASgIIjUKFENyZWF0ZUZvbGRlclJlc3BvbnNlEh0KBmZvbGRlchgBIAEoCzINLmdycGMudjEuRmlsZSISChBMaXN0VHJhc2hSZXF1ZXN0IjYKEUxpc3RUcmFzaFJlc3BvbnNlEiEKBWl0ZW1zGAEgAygLMhIuZ3JwYy52MS5UcmFzaEl0ZW0iWgoXUmVzdG9yZUZyb21UcmFzaFJlcXVlc3QSCwoDaWRzGAEgAygJEjIKEG92ZXJ3cml0ZV9wb2xpY3kYAiABKA4yGC5ncnBjLnYxLk92ZXJ3cml0ZVBvbGljeSJJChhSZXN0b3JlRnJvbVRyYXNoUmVzcG9uc2USLQoHcmVzdWx0cxgBIAMoCzIcLmdycGMudjEuRmlsZU9wZXJhdGlvblJlc3VsdCItChFQdXJnZVRyYXNoUmVxdWVzdBILCgNpZHMYASADKAkSCwoDYWxsGAIgASgIIkMKElB1cmdlVHJhc2hSZXNwb25zZRItCgdyZXN1bHRzGAEgAygLMhwuZ3JwYy52MS5GaWxlT3BlcmF0aW9uUmVzdWx0ImEKE0Rvd25sb2FkRmlsZVJlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkSDgoGb2Zmc2V0GAIgASgDEg4KBmxlbmd0aBgDIAEoAxISCgpjaHVua19zaXplGAQgASgFInsKFERvd25sb2FkRmlsZVJlc3BvbnNlEgwKBGRhdGEYASABKAwSDgoGb2Zmc2V0GAIgASgDEhIKCnRvdGFsX3NpemUYAyABKAMSMQoNbW9kaWZpZWRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAivgEKEVVwbG9hZEZpbGVSZXF1ZXN0EhYKDnBhdGhpc3RfZm9sZGVyGAEgASgJEhEKCXVwbG9hZF9pZBgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgDEhgKEGNoZWNrc3VtX2JsYWtlMmIYBCABKAkSMgoQb3ZlcndyaXRlX3BvbGljeRgFIAEoDjIYLmdycGMudjEuT3ZlcndyaXRlUG9saWN5Eg4KBm9mZnNldBgGIAEoAxIMCgRkYXRhGAcgASgMIm4KElVwbG9hZEZpbGVSZXNwb25zZRIRCgl1cGxvYWRfaWQYASABKAkSFQoNcmVjZWl2ZWRfc2l6ZRgCIAEoAxIRCgljb21wbGV0ZWQYAyABKAgSGwoEZmlsZRgEIAEoCzINLmdycGMudjEuRmlsZSJBChVGaW5kRHVwbGljYXRlc1JlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkSEAoIbWluX3NpemUYAiABKAMibgoWRmluZER1cGxpY2F0ZXNSZXNwb25zZRInCgZncm91cHMYASADKAsyFy5ncnBjLnYxLkR1cGxpY2F0ZUdyb3VwEhQKDHdhc3RlZF9ieXRlcxgCIAEoAxIVCg1zY2FubmVkX2NvdW50GAMgASgFIkoKElNlYXJjaEZpbGVzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRIWCg5wYXRoaXN0X2ZvbGRlchgCIAEoCRINCgVsaW1pdBgDIAEoBSJlChNTZWFyY2hGaWxlc1Jlc3BvbnNlEiQKBGhpdHMYASADKAsyFi5ncnBjLnYxLkZpbGVTZWFyY2hIaXQSEwoLdG90YWxfY291bnQYAiABKAUSEwoLaW5kZXhfcmVhZHkYAyABKAgiSQoZR2V0V29ya2Jvb2tTdW1tYXJ5UmVxdWVzdBIWCg5wYXRoaXN0X2ZvbGRlchgBIAEoCRIUCgxwcmV2aWV3X3Jvd3MYAiABKAUiewoaR2V0V29ya2Jvb2tTdW1tYXJ5UmVzcG9uc2USGwoEZmlsZRgBIAEoCzINLmdycGMudjEuRmlsZRItCgZzaGVldHMYAiADKAsyHS5ncnBjLnYxLldvcmtib29rU2hlZXRTdW1tYXJ5EhEKCXRydW5jYXRlZBgDIAEoCCJOChZTZWFyY2hXb3JrYm9va3NSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhYKDnBhdGhpc3RfZm9sZGVyGAIgASgJEg0KBWxpbWl0GAMgASgFIm0KF1NlYXJjaFdvcmtib29rc1Jlc3BvbnNlEigKBGhpdHMYASADKAsyGi5ncnBjLnYxLldvcmtib29rU2VhcmNoSGl0EhMKC3RvdGFsX2NvdW50GAIgASgFEhMKC2luZGV4X3JlYWR5GAMgASgIIn4KFEV4cG9ydEFyY2hpdmVSZXF1ZXN0EhcKD3BhdGhpc3RfZm9sZGVycxgBIAMoCRIPCgdrb2ppX2lkGAIgASgJEhIKCmNvbXBhbnlfaWQYAyABKAkSFAoMYXJjaGl2ZV9uYW1lGAQgASgJEhIKCmNodW5rX3NpemUYBSABKAUiiQEKFUV4cG9ydEFyY2hpdmVSZXNwb25zZRIMCgRkYXRhGAEgASgMEhQKDGFyY2hpdmVfbmFtZRgCIAEoCRIMCgRkb25lGAMgASgIEhIKCmZpbGVfY291bnQYBCABKAUSFQoNc2tpcHBlZF9jb3VudBgFIAEoBRITCgt0b3RhbF9ieXRlcxgGIAEoAyJ5ChNHZXREaXNrVXNhZ2VSZXF1ZXN0EhYKDnBhdGhpc3RfZm9sZGVyGAEgASgJEg8KB2tvamlfaWQYAiABKAkSEgoKY29tcGFueV9pZBgDIAEoCRIUCgx0b3BfY2hpbGRyZW4YBCABKAUSDwoHcmVmcmVzaBgFIAEoCCLHAQoUR2V0RGlza1VzYWdlUmVzcG9uc2USFQoNcmVsYXRpdmVfcGF0aBgBIAEoCRIMCgRzaXplGAIgASgDEhIKCmZpbGVfY291bnQYAyABKAMSFAoMZm9sZGVyX2NvdW50GAQgASgDEhUKDXNraXBwZWRfY291bnQYBSABKAMSMQoQbGFyZ2VzdF9jaGlsZHJlbhgGIAMoCzIXLmdycGMudjEuRGlza1VzYWdlRW50cnkSFgoOY2hpbGRyZW5fY291bnQYByABKAUiMQoXTGlzdEZpbGVWZXJzaW9uc1JlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkiYgoYTGlzdEZpbGVWZXJzaW9uc1Jlc3BvbnNlEiYKCHZlcnNpb25zGAEgAygLMhQuZ3JwYy52MS5GaWxlVmVyc2lvbhIeCgdjdXJyZW50GAIgASgLMg0uZ3JwYy52MS5GaWxlIkcKGVJlc3RvcmVGaWxlVmVyc2lvblJlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkSEgoKdmVyc2lvbl9pZBgCIAEoCSJTChpSZXN0b3JlRmlsZVZlcnNpb25SZXNwb25zZRIbCgRmaWxlGAEgASgLMg0uZ3JwYy52MS5GaWxlEhgKEHNhdmVkX3ZlcnNpb25faWQYAiABKAkiUAoQQmF0Y2hQbGFuUmVxdWVzdBIrCgpvcGVyYXRpb25zGAEgAygLMhcuZ3JwYy52MS5CYXRjaE9wZXJhdGlvbhIPCgdkcnlfcnVuGAIgASgIIooBChFCYXRjaFBsYW5SZXNwb25zZRIuCgdyZXN1bHRzGAEgAygLMh0uZ3JwYy52MS5CYXRjaE9wZXJhdGlvblJlc3VsdBIKCgJvaxgCIAEoCBIQCghleGVjdXRlZBgDIAEoCBITCgtyb2xsZWRfYmFjaxgEIAEoCBISCgpqb3VybmFsX2lkGAUgASgJIkYKG05vcm1hbGl6ZUZvbGRlck5hbWVzUmVxdWVzdBIWCg5wYXRoaXN0X2ZvbGRlchgBIAEoCRIPCgdkcnlfcnVuGAIgASgIIl8KHE5vcm1hbGl6ZUZvbGRlck5hbWVzUmVzcG9uc2USKAoGaXNzdWVzGAEgAygLMhguZ3JwYy52MS5Gb2xkZXJOYW1lSXNzdWUSFQoNcmVuYW1lZF9jb3VudBgCIAEoBSKVAgoTR2V0Q29tcGFuaWVzUmVxdWVzdBIPCgdyZWZyZXNoGAEgASgIEhwKFGluY2x1ZGVfZm9sZGVyX3NpemVzGAIgASgIEhAKCGFyY2hpdmVkGAMgASgIEhgKEGNhdGVnb3J5X2luZGV4ZXMYBCADKAUSDQoFcXVlcnkYBSABKAkSFAoMZW1wdHlfZmllbGRzGAYgAygJEhgKEG5vbl9lbXB0eV9maWVsZHMYByADKAkSKQoIc29ydF9rZXkYCCABKA4yFy5ncnBjLnYxLkNvbXBhbnlTb3J0S2V5EhIKCmRlc2NlbmRpbmcYCSABKAgSEQoJcGFnZV9zaXplGAogASgFEhIKCnBhZ2VfdG9rZW4YCyABKAkihAMKFEdldENvbXBhbmllc1Jlc3BvbnNlEj8KCWNvbXBhbmllcxgBIAMoCzIsLmdycGMudjEuR2V0Q29tcGFuaWVzUmVzcG9uc2UuQ29tcGFuaWVzRW50cnkSEgoKZ2VuZXJhdGlvbhgCIAEoBBJECgxmb2xkZXJfc2l6ZXMYAyADKAsyLi5ncnBjLnYxLkdldENvbXBhbmllc1Jlc3BvbnNlLkZvbGRlclNpemVzRW50cnkSKwoRb3JkZXJlZF9jb21wYW5pZXMYBCADKAsyEC5ncnBjLnYxLkNvbXBhbnkSFwoPbmV4dF9wYWdlX3Rva2VuGAUgASgJEhMKC3RvdGFsX2NvdW50GAYgASgFGkIKDkNvbXBhbmllc0VudHJ5EgsKA2tleRgBIAEoCRIfCgV2YWx1ZRgCIAEoCzIQLmdycGMudjEuQ29tcGFueToCOAEaMgoQRm9sZGVyU2l6ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAM6AjgBIh8KEUdldENvbXBhbnlSZXF1ZXN0EgoKAmlkGAEgASgJIjcKEkdldENvbXBhbnlSZXNwb25zZRIhCgdjb21wYW55GAEgASgLMhAuZ3JwYy52MS5Db21wYW55Ik4KFFVwZGF0ZUNvbXBhbnlSZXF1ZXN0Eg8KB3ByZXZfaWQYASABKAkSJQoLbmV3X2NvbXBhbnkYAiABKAsyEC5ncnBjLnYxLkNvbXBhbnkiPwoVVXBkYXRlQ29tcGFueVJlc3BvbnNlEiYKDHByZXZfY29tcGFueRgBIAEoCzIQLmdycGMudjEuQ29tcGFueSIdChtHZXRDb21wYW55Q2F0ZWdvcmllc1JlcXVlc3QiTAocR2V0Q29tcGFueUNhdGVnb3JpZXNSZXNwb25zZRIsCgpjYXRlZ29yaWVzGAEgAygLMhguZ3JwYy52MS5Db21wYW55Q2F0ZWdvcnkiPQoUQ3JlYXRlQ29tcGFueVJlcXVlc3QSJQoLbmV3X2NvbXBhbnkYASABKAsyEC5ncnBjLnYxLkNvbXBhbnkiVwoVQ3JlYXRlQ29tcGFueVJlc3BvbnNlEiEKB2NvbXBhbnkYASABKAsyEC5ncnBjLnYxLkNvbXBhbnkSGwoTdGVtcGxhdGVfZmlsZV9jb3VudBgCIAEoBSIjChVBcmNoaXZlQ29tcGFueVJlcXVlc3QSCgoCaWQYASABKAkiOwoWQXJjaGl2ZUNvbXBhbnlSZXNwb25zZRIhCgdjb21wYW55GAEgASgLMhAuZ3JwYy52MS5Db21wYW55IiMKFVJlc3RvcmVDb21wYW55UmVxdWVzdBIKCgJpZBgBIAEoCSI7ChZSZXN0b3JlQ29tcGFueVJlc3BvbnNlEiEKB2NvbXBhbnkYASABKAsyEC5ncnBjLnYxLkNvbXBhbnkiMAoQR2V0S29qaWVzUmVxdWVzdBIcChRpbmNsdWRlX2ZvbGRlcl9zaXplcxgBIAEoCCKUAgoRR2V0S29qaWVzUmVzcG9uc2USNgoGa29qaWVzGAEgAygLMiYuZ3JwYy52MS5HZXRLb2ppZXNSZXNwb25zZS5Lb2ppZXNFbnRyeRISCgpnZW5lcmF0aW9uGAIgASgEEkEKDGZvbGRlcl9zaXplcxgDIAMoCzIrLmdycGMudjEuR2V0S29qaWVzUmVzcG9uc2UuRm9sZGVyU2l6ZXNFbnRyeRo8CgtLb2ppZXNFbnRyeRILCgNrZXkYASABKAkSHAoFdmFsdWUYAiABKAsyDS5ncnBjLnYxLktvamk6AjgBGjIKEEZvbGRlclNpemVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgDOgI4ASIcCg5HZXRLb2ppUmVxdWVzdBIKCgJpZBgBIAEoCSIuCg9HZXRLb2ppUmVzcG9uc2USGwoEa29qaRgBIAEoCzINLmdycGMudjEuS29qaSI0ChFDcmVhdGVLb2ppUmVxdWVzdBIfCghuZXdfa29qaRgBIAEoCzINLmdycGMudjEuS29qaSJOChJDcmVhdGVLb2ppUmVzcG9uc2USGwoEa29qaRgBIAEoCzINLmdycGMudjEuS29qaRIbChN0ZW1wbGF0ZV9maWxlX2NvdW50GAIgASgFIjQKEVVwZGF0ZUtvamlSZXF1ZXN0Eh8KCG5ld19rb2ppGAEgASgLMg0uZ3JwYy52MS5Lb2ppIjYKElVwZGF0ZUtvamlSZXNwb25zZRIgCglwcmV2X2tvamkYASABKAsyDS5ncnBjLnYxLktvamkiOwoTR2V0VGh1bWJuYWlsUmVxdWVzdBIWCg5wYXRoaXN0X2ZvbGRlchgBIAEoCRIMCgRzaXplGAIgASgFImYKFEdldFRodW1ibmFpbFJlc3BvbnNlEgwKBGRhdGEYASABKAwSEQoJbWltZV90eXBlGAIgASgJEg0KBXdpZHRoGAMgASgFEg4KBmhlaWdodBgEIAEoBRIOCgZkaWdlc3QYBSABKAkiMQoXR2V0TWVkaWFNZXRhZGF0YVJlcXVlc3QSFgoOcGF0aGlzdF9mb2xkZXIYASABKAkiRAoYR2V0TWVkaWFNZXRhZGF0YVJlc3BvbnNlEigKCG1ldGFkYXRhGAEgASgLMhYuZ3JwYy52MS5NZWRpYU1ldGFkYXRhIisKGEdldEtvamlQaG90b0FsYnVtUmVxdWVzdBIPCgdrb2ppX2lkGAEgASgJIrQBChlHZXRLb2ppUGhvdG9BbGJ1bVJlc3BvbnNlEhsKBGtvamkYASABKAsyDS5ncnBjLnYxLktvamkSJAoEZGF5cxgCIAMoCzIWLmdycGMudjEuUGhvdG9BbGJ1bURheRIoCgd1bmRhdGVkGAMgAygLMhcuZ3JwYy52MS5QaG90b0FsYnVtSXRlbRITCgtwaG90b19jb3VudBgEIAEoBRIVCg1vdXRzaWRlX2NvdW50GAUgASgFIjgKEUdldENoYW5nZXNSZXF1ZXN0EhQKDHNpbmNlX2N1cnNvchgBIAEoCRINCgVsaW1pdBgCIAEoBSJoChJHZXRDaGFuZ2VzUmVzcG9uc2USJQoHY2hhbmdlcxgBIAMoCzIULmdycGMudjEuQ2hhbmdlRW50cnkSEwoLbmV4dF9jdXJzb3IYAiABKAkSFgoOcmVzZXRfcmVxdWlyZWQYAyABKAgqpgEKD092ZXJ3cml0ZVBvbGljeRIgChxPVkVSV1JJVEVfUE9MSUNZX1VOU1BFQ0lGSUVEEAASGQoVT1ZFUldSSVRFX1BPTElDWV9GQUlMEAESGQoVT1ZFUldSSVRFX1BPTElDWV9TS0lQEAISHgoaT1ZFUldSSVRFX1BPTElDWV9PVkVSV1JJVEUQAxIbChdPVkVSV1JJVEVfUE9MSUNZX1JFTkFNRRAEKpUBCgtGaWxlU29ydEtleRIdChlGSUxFX1NPUlRfS0VZX1VOU1BFQ0lGSUVEEAASFgoSRklMRV9TT1JUX0tFWV9OQU1FEAESFgoSRklMRV9TT1JUX0tFWV9QQVRIEAISFgoSRklMRV9TT1JUX0tFWV9TSVpFEAMSHwobRklMRV9TT1JUX0tFWV9NT0RJRklFRF9USU1FEAQqigEKDkNvbXBhbnlTb3J0S2V5EiAKHENPTVBBTllfU09SVF9LRVlfVU5TUEVDSUZJRUQQABIZChVDT01QQU5ZX1NPUlRfS0VZX05BTUUQARIdChlDT01QQU5ZX1NPUlRfS0VZX0NBVEVHT1JZEAISHAoYQ09NUEFOWV9TT1JUX0tFWV9SRUFESU5HEAMquQEKEkJhdGNoT3BlcmF0aW9uS2luZBIkCiBCQVRDSF9PUEVSQVRJT05fS0lORF9VTlNQRUNJRklFRBAAEh0KGUJBVENIX09QRVJBVElPTl9LSU5EX01PVkUQARIdChlCQVRDSF9PUEVSQVRJT05fS0lORF9DT1BZEAISHwobQkFUQ0hfT1BFUkFUSU9OX0tJTkRfREVMRVRFEAMSHgoaQkFUQ0hfT1BFUkFUSU9OX0tJTkRfTUtESVIQBDKgDQoLRmlsZVNlcnZpY2USPwoIR2V0RmlsZXMSGC5ncnBjLnYxLkdldEZpbGVzUmVxdWVzdBoZLmdycGMudjEuR2V0RmlsZXNSZXNwb25zZRJjChRHZXRGaWxlUGF0aGlzdEZvbGRlchIkLmdycGMudjEuR2V0RmlsZVBhdGhpc3RGb2xkZXJSZXF1ZXN0GiUuZ3JwYy52MS5HZXRGaWxlUGF0aGlzdEZvbGRlclJlc3BvbnNlEkIKCUNvcHlGaWxlcxIZLmdycGMudjEuQ29weUZpbGVzUmVxdWVzdBoaLmdycGMudjEuQ29weUZpbGVzUmVzcG9uc2USQgoJTW92ZUZpbGVzEhkuZ3JwYy52MS5Nb3ZlRmlsZXNSZXF1ZXN0GhouZ3JwYy52MS5Nb3ZlRmlsZXNSZXNwb25zZRJICgtEZWxldGVGaWxlcxIbLmdycGMudjEuRGVsZXRlRmlsZXNSZXF1ZXN0GhwuZ3JwYy52MS5EZWxldGVGaWxlc1Jlc3BvbnNlEksKDENyZWF0ZUZvbGRlchIcLmdycGMudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBodLmdycGMudjEuQ3JlYXRlRm9sZGVyUmVzcG9uc2USQgoJTGlzdFRyYXNoEhkuZ3JwYy52MS5MaXN0VHJhc2hSZXF1ZXN0GhouZ3JwYy52MS5MaXN0VHJhc2hSZXNwb25zZRJXChBSZXN0b3JlRnJvbVRyYXNoEiAuZ3JwYy52MS5SZXN0b3JlRnJvbVRyYXNoUmVxdWVzdBohLmdycGMudjEuUmVzdG9yZUZyb21UcmFzaFJlc3BvbnNlEkUKClB1cmdlVHJhc2gSGi5ncnBjLnYxLlB1cmdlVHJhc2hSZXF1ZXN0GhsuZ3JwYy52MS5QdXJnZVRyYXNoUmVzcG9uc2USTQoMRG93bmxvYWRGaWxlEhwuZ3JwYy52MS5Eb3dubG9hZEZpbGVSZXF1ZXN0Gh0uZ3JwYy52MS5Eb3dubG9hZEZpbGVSZXNwb25zZTABEkcKClVwbG9hZEZpbGUSGi5ncnBjLnYxLlVwbG9hZEZpbGVSZXF1ZXN0GhsuZ3JwYy52MS5VcGxvYWRGaWxlUmVzcG9uc2UoARJRCg5GaW5kRHVwbGljYXRlcxIeLmdycGMudjEuRmluZER1cGxpY2F0ZXNSZXF1ZXN0Gh8uZ3JwYy52MS5GaW5kRHVwbGljYXRlc1Jlc3BvbnNlEkgKC1NlYXJjaEZpbGVzEhsuZ3JwYy52MS5TZWFyY2hGaWxlc1JlcXVlc3QaHC5ncnBjLnYxLlNlYXJjaEZpbGVzUmVzcG9uc2USXQoSR2V0V29ya2Jvb2tTdW1tYXJ5EiIuZ3JwYy52MS5HZXRXb3JrYm9va1N1bW1hcnlSZXF1ZXN0GiMuZ3JwYy52MS5HZXRXb3JrYm9va1N1bW1hcnlSZXNwb25zZRJUCg9TZWFyY2hXb3JrYm9va3MSHy5ncnBjLnYxLlNlYXJjaFdvcmtib29rc1JlcXVlc3QaIC5ncnBjLnYxLlNlYXJjaFdvcmtib29rc1Jlc3BvbnNlElAKDUV4cG9ydEFyY2hpdmUSHS5ncnBjLnYxLkV4cG9ydEFyY2hpdmVSZXF1ZXN0Gh4uZ3JwYy52MS5FeHBvcnRBcmNoaXZlUmVzcG9uc2UwARJLCgxHZXREaXNrVXNhZ2USHC5ncnBjLnYxLkdldERpc2tVc2FnZVJlcXVlc3QaHS5ncnBjLnYxLkdldERpc2tVc2FnZVJlc3BvbnNlElcKEExpc3RGaWxlVmVyc2lvbnMSIC5ncnBjLnYxLkxpc3RGaWxlVmVyc2lvbnNSZXF1ZXN0GiEuZ3JwYy52MS5MaXN0RmlsZVZlcnNpb25zUmVzcG9uc2USXQoSUmVzdG9yZUZpbGVWZXJzaW9uEiIuZ3JwYy52MS5SZXN0b3JlRmlsZVZlcnNpb25SZXF1ZXN0GiMuZ3JwYy52MS5SZXN0b3JlRmlsZVZlcnNpb25SZXNwb25zZRJCCglCYXRjaFBsYW4SGS5ncnBjLnYxLkJhdGNoUGxhblJlcXVlc3QaGi5ncnBjLnYxLkJhdGNoUGxhblJlc3BvbnNlEmMKFE5vcm1hbGl6ZUZvbGRlck5hbWVzEiQuZ3JwYy52MS5Ob3JtYWxpemVGb2xkZXJOYW1lc1JlcXVlc3QaJS5ncnBjLnYxLk5vcm1hbGl6ZUZvbGRlck5hbWVzUmVzcG9uc2UyzwQKDkNvbXBhbnlTZXJ2aWNlEksKDEdldENvbXBhbmllcxIcLmdycGMudjEuR2V0Q29tcGFuaWVzUmVxdWVzdBodLmdycGMudjEuR2V0Q29tcGFuaWVzUmVzcG9uc2USRQoKR2V0Q29tcGFueRIaLmdycGMudjEuR2V0Q29tcGFueVJlcXVlc3QaGy5ncnBjLnYxLkdldENvbXBhbnlSZXNwb25zZRJOCg1VcGRhdGVDb21wYW55Eh0uZ3JwYy52MS5VcGRhdGVDb21wYW55UmVxdWVzdBoeLmdycGMudjEuVXBkYXRlQ29tcGFueVJlc3BvbnNlEmMKFEdldENvbXBhbnlDYXRlZ29yaWVzEiQuZ3JwYy52MS5HZXRDb21wYW55Q2F0ZWdvcmllc1JlcXVlc3QaJS5ncnBjLnYxLkdldENvbXBhbnlDYXRlZ29yaWVzUmVzcG9uc2USTgoNQ3JlYXRlQ29tcGFueRIdLmdycGMudjEuQ3JlYXRlQ29tcGFueVJlcXVlc3QaHi5ncnBjLnYxLkNyZWF0ZUNvbXBhbnlSZXNwb25zZRJRCg5BcmNoaXZlQ29tcGFueRIeLmdycGMudjEuQXJjaGl2ZUNvbXBhbnlSZXF1ZXN0Gh8uZ3JwYy52MS5BcmNoaXZlQ29tcGFueVJlc3BvbnNlElEKDlJlc3RvcmVDb21wYW55Eh4uZ3JwYy52MS5SZXN0b3JlQ29tcGFueVJlcXVlc3QaHy5ncnBjLnYxLlJlc3RvcmVDb21wYW55UmVzcG9uc2UynQIKC0tvamlTZXJ2aWNlEjwKB0dldEtvamkSFy5ncnBjLnYxLkdldEtvamlSZXF1ZXN0GhguZ3JwYy52MS5HZXRLb2ppUmVzcG9uc2USQgoJR2V0S29qaWVzEhkuZ3JwYy52MS5HZXRLb2ppZXNSZXF1ZXN0GhouZ3JwYy52MS5HZXRLb2ppZXNSZXNwb25zZRJFCgpVcGRhdGVLb2ppEhouZ3JwYy52MS5VcGRhdGVLb2ppUmVxdWVzdBobLmdycGMudjEuVXBkYXRlS29qaVJlc3BvbnNlEkUKCkNyZWF0ZUtvamkSGi5ncnBjLnYxLkNyZWF0ZUtvamlSZXF1ZXN0GhsuZ3JwYy52MS5DcmVhdGVLb2ppUmVzcG9uc2UylQIKEU11bHRpTWVkaWFTZXJ2aWNlEksKDEdldFRodW1ibmFpbBIcLmdycGMudjEuR2V0VGh1bWJuYWlsUmVxdWVzdBodLmdycGMudjEuR2V0VGh1bWJuYWlsUmVzcG9uc2USVwoQR2V0TWVkaWFNZXRhZGF0YRIgLmdycGMudjEuR2V0TWVkaWFNZXRhZGF0YVJlcXVlc3QaIS5ncnBjLnYxLkdldE1lZGlhTWV0YWRhdGFSZXNwb25zZRJaChFHZXRLb2ppUGhvdG9BbGJ1bRIhLmdycGMudjEuR2V0S29qaVBob3RvQWxidW1SZXF1ZXN0GiIuZ3JwYy52MS5HZXRLb2ppUGhvdG9BbGJ1bVJlc3BvbnNlMlYKDUNoYW5nZVNlcnZpY2USRQoKR2V0Q2hhbmdlcxIaLmdycGMudjEuR2V0Q2hhbmdlc1JlcXVlc3QaGy5ncnBjLnYxLkdldENoYW5nZXNSZXNwb25zZUKIAQoLY29tLmdycGMudjFCElRveW90YWNoaWt1cm9Qcm90b1ABWh5zZXJ2ZXItZ3JwYy9nZW4vZ3JwYy92MTtncnBjdjGiAgNHWFiqAgdHcnBjLlYxygIHR3JwY1xWMeICE0dycGNcVjFcR1BCTWV0YWRhdGHqAghHcnBjOjpWMZIDBwgC0j4CEANiCGVkaXRpb25zcOgH", [file_google_protobuf_go_features, file_google_protobuf_timestamp]);
 
 /**
  * File represents information about a file or directory
+ * id is derived from the slash separated path relative to the FileService root, so it does not depend on where the root is
  *
  * @generated from message grpc.v1.File
  */
@@ -40,6 +41,46 @@ export type File = Message<"grpc.v1.File"> & {
    * @generated from field: google.protobuf.Timestamp modified_time = 4;
    */
   modifiedTime?: Timestamp;
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string extension = 6;
+   */
+  extension: string;
+
+  /**
+   * @generated from field: bool is_dir = 7;
+   */
+  isDir: boolean;
+
+  /**
+   * @generated from field: string symlink_target = 8;
+   */
+  symlinkTarget: string;
+
+  /**
+   * @generated from field: string mime_type = 9;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: bool hidden = 10;
+   */
+  hidden: boolean;
+
+  /**
+   * @generated from field: bool system = 11;
+   */
+  system: boolean;
+
+  /**
+   * @generated from field: int32 child_count = 12;
+   */
+  childCount: number;
 };
 
 /**
@@ -109,6 +150,11 @@ export type Company = Message<"grpc.v1.Company"> & {
    * @generated from field: string persist_website = 11;
    */
   persistWebsite: string;
+
+  /**
+   * @generated from field: string persist_reading = 12;
+   */
+  persistReading: string;
 };
 
 /**
@@ -192,309 +238,2635 @@ export const KojiSchema: GenMessage<Koji> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 3);
 
 /**
- * FileService messages
+ * ChangeEntry represents a change recorded in the change journal
  *
- * @generated from message grpc.v1.GetFilesRequest
+ * @generated from message grpc.v1.ChangeEntry
  */
-export type GetFilesRequest = Message<"grpc.v1.GetFilesRequest"> & {
+export type ChangeEntry = Message<"grpc.v1.ChangeEntry"> & {
   /**
-   * @generated from field: string pathist_folder = 1;
+   * @generated from field: uint64 seq = 1;
+   */
+  seq: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: string kind = 3;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string op = 4;
+   */
+  op: string;
+
+  /**
+   * @generated from field: string pathist_folder = 5;
    */
   pathistFolder: string;
+
+  /**
+   * @generated from field: string entity_id = 6;
+   */
+  entityId: string;
 };
 
 /**
- * Describes the message grpc.v1.GetFilesRequest.
- * Use `create(GetFilesRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.ChangeEntry.
+ * Use `create(ChangeEntrySchema)` to create a new message.
  */
-export const GetFilesRequestSchema: GenMessage<GetFilesRequest> = /*@__PURE__*/
+export const ChangeEntrySchema: GenMessage<ChangeEntry> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 4);
 
 /**
- * @generated from message grpc.v1.GetFilesResponse
+ * FileTransfer represents a pair of source and destination relative paths
+ *
+ * @generated from message grpc.v1.FileTransfer
  */
-export type GetFilesResponse = Message<"grpc.v1.GetFilesResponse"> & {
+export type FileTransfer = Message<"grpc.v1.FileTransfer"> & {
   /**
-   * @generated from field: repeated grpc.v1.File files = 1;
+   * @generated from field: string src = 1;
    */
-  files: File[];
+  src: string;
+
+  /**
+   * @generated from field: string dst = 2;
+   */
+  dst: string;
 };
 
 /**
- * Describes the message grpc.v1.GetFilesResponse.
- * Use `create(GetFilesResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.FileTransfer.
+ * Use `create(FileTransferSchema)` to create a new message.
  */
-export const GetFilesResponseSchema: GenMessage<GetFilesResponse> = /*@__PURE__*/
+export const FileTransferSchema: GenMessage<FileTransfer> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 5);
 
 /**
- * @generated from message grpc.v1.GetFilePathistFolderRequest
+ * FileOperationError represents a structured error of a file operation
+ *
+ * @generated from message grpc.v1.FileOperationError
  */
-export type GetFilePathistFolderRequest = Message<"grpc.v1.GetFilePathistFolderRequest"> & {
+export type FileOperationError = Message<"grpc.v1.FileOperationError"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
 };
 
 /**
- * Describes the message grpc.v1.GetFilePathistFolderRequest.
- * Use `create(GetFilePathistFolderRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.FileOperationError.
+ * Use `create(FileOperationErrorSchema)` to create a new message.
  */
-export const GetFilePathistFolderRequestSchema: GenMessage<GetFilePathistFolderRequest> = /*@__PURE__*/
+export const FileOperationErrorSchema: GenMessage<FileOperationError> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 6);
 
 /**
- * @generated from message grpc.v1.GetFilePathistFolderResponse
+ * FileOperationResult represents the result of a file operation for one item
+ *
+ * @generated from message grpc.v1.FileOperationResult
  */
-export type GetFilePathistFolderResponse = Message<"grpc.v1.GetFilePathistFolderResponse"> & {
+export type FileOperationResult = Message<"grpc.v1.FileOperationResult"> & {
   /**
-   * @generated from field: string pathist_folder = 1;
+   * @generated from field: string src = 1;
    */
-  pathistFolder: string;
+  src: string;
+
+  /**
+   * @generated from field: string dst = 2;
+   */
+  dst: string;
+
+  /**
+   * @generated from field: bool ok = 3;
+   */
+  ok: boolean;
+
+  /**
+   * @generated from field: bool skipped = 4;
+   */
+  skipped: boolean;
+
+  /**
+   * @generated from field: grpc.v1.FileOperationError error = 5;
+   */
+  error?: FileOperationError;
 };
 
 /**
- * Describes the message grpc.v1.GetFilePathistFolderResponse.
- * Use `create(GetFilePathistFolderResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.FileOperationResult.
+ * Use `create(FileOperationResultSchema)` to create a new message.
  */
-export const GetFilePathistFolderResponseSchema: GenMessage<GetFilePathistFolderResponse> = /*@__PURE__*/
+export const FileOperationResultSchema: GenMessage<FileOperationResult> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 7);
 
 /**
- * CompanyService messages
+ * BatchOperation represents an operation in a batch plan
+ * MOVE and COPY use src and dst, DELETE uses src and MKDIR uses dst
  *
- * @generated from message grpc.v1.GetCompaniesRequest
+ * @generated from message grpc.v1.BatchOperation
  */
-export type GetCompaniesRequest = Message<"grpc.v1.GetCompaniesRequest"> & {
+export type BatchOperation = Message<"grpc.v1.BatchOperation"> & {
   /**
-   * @generated from field: bool refresh = 1;
+   * @generated from field: grpc.v1.BatchOperationKind kind = 1;
    */
-  refresh: boolean;
+  kind: BatchOperationKind;
+
+  /**
+   * @generated from field: string src = 2;
+   */
+  src: string;
+
+  /**
+   * @generated from field: string dst = 3;
+   */
+  dst: string;
 };
 
 /**
- * Describes the message grpc.v1.GetCompaniesRequest.
- * Use `create(GetCompaniesRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.BatchOperation.
+ * Use `create(BatchOperationSchema)` to create a new message.
  */
-export const GetCompaniesRequestSchema: GenMessage<GetCompaniesRequest> = /*@__PURE__*/
+export const BatchOperationSchema: GenMessage<BatchOperation> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 8);
 
 /**
- * @generated from message grpc.v1.GetCompaniesResponse
+ * BatchOperationResult represents the result of an operation in a batch plan
+ *
+ * @generated from message grpc.v1.BatchOperationResult
  */
-export type GetCompaniesResponse = Message<"grpc.v1.GetCompaniesResponse"> & {
+export type BatchOperationResult = Message<"grpc.v1.BatchOperationResult"> & {
   /**
-   * @generated from field: map<string, grpc.v1.Company> companies = 1;
+   * @generated from field: int32 index = 1;
    */
-  companies: { [key: string]: Company };
+  index: number;
+
+  /**
+   * @generated from field: grpc.v1.BatchOperation operation = 2;
+   */
+  operation?: BatchOperation;
+
+  /**
+   * @generated from field: bool ok = 3;
+   */
+  ok: boolean;
+
+  /**
+   * @generated from field: bool skipped = 4;
+   */
+  skipped: boolean;
+
+  /**
+   * @generated from field: bool rolled_back = 5;
+   */
+  rolledBack: boolean;
+
+  /**
+   * @generated from field: grpc.v1.FileOperationError error = 6;
+   */
+  error?: FileOperationError;
 };
 
 /**
- * Describes the message grpc.v1.GetCompaniesResponse.
- * Use `create(GetCompaniesResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.BatchOperationResult.
+ * Use `create(BatchOperationResultSchema)` to create a new message.
  */
-export const GetCompaniesResponseSchema: GenMessage<GetCompaniesResponse> = /*@__PURE__*/
+export const BatchOperationResultSchema: GenMessage<BatchOperationResult> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 9);
 
 /**
- * @generated from message grpc.v1.GetCompanyRequest
+ * FolderNameIssue represents a folder whose name is not normalized (NFD, full-width digits or spaces)
+ *
+ * @generated from message grpc.v1.FolderNameIssue
  */
-export type GetCompanyRequest = Message<"grpc.v1.GetCompanyRequest"> & {
+export type FolderNameIssue = Message<"grpc.v1.FolderNameIssue"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string relative_path = 1;
    */
-  id: string;
+  relativePath: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string normalized_name = 3;
+   */
+  normalizedName: string;
+
+  /**
+   * @generated from field: bool conflict = 4;
+   */
+  conflict: boolean;
+
+  /**
+   * @generated from field: bool renamed = 5;
+   */
+  renamed: boolean;
+
+  /**
+   * @generated from field: grpc.v1.FileOperationError error = 6;
+   */
+  error?: FileOperationError;
 };
 
 /**
- * Describes the message grpc.v1.GetCompanyRequest.
- * Use `create(GetCompanyRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.FolderNameIssue.
+ * Use `create(FolderNameIssueSchema)` to create a new message.
  */
-export const GetCompanyRequestSchema: GenMessage<GetCompanyRequest> = /*@__PURE__*/
+export const FolderNameIssueSchema: GenMessage<FolderNameIssue> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 10);
 
 /**
- * @generated from message grpc.v1.GetCompanyResponse
+ * TrashItem represents a file or directory moved into the recycle bin
+ *
+ * @generated from message grpc.v1.TrashItem
  */
-export type GetCompanyResponse = Message<"grpc.v1.GetCompanyResponse"> & {
+export type TrashItem = Message<"grpc.v1.TrashItem"> & {
   /**
-   * @generated from field: grpc.v1.Company company = 1;
+   * @generated from field: string id = 1;
    */
-  company?: Company;
+  id: string;
+
+  /**
+   * @generated from field: string original_pathist_folder = 2;
+   */
+  originalPathistFolder: string;
+
+  /**
+   * @generated from field: string deleted_by = 3;
+   */
+  deletedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deleted_time = 4;
+   */
+  deletedTime?: Timestamp;
+
+  /**
+   * @generated from field: bool is_dir = 5;
+   */
+  isDir: boolean;
+
+  /**
+   * @generated from field: int64 size = 6;
+   */
+  size: bigint;
 };
 
 /**
- * Describes the message grpc.v1.GetCompanyResponse.
- * Use `create(GetCompanyResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.TrashItem.
+ * Use `create(TrashItemSchema)` to create a new message.
  */
-export const GetCompanyResponseSchema: GenMessage<GetCompanyResponse> = /*@__PURE__*/
+export const TrashItemSchema: GenMessage<TrashItem> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 11);
 
 /**
- * @generated from message grpc.v1.UpdateCompanyRequest
+ * FileVersion represents a previous version of a file saved before it was overwritten
+ *
+ * @generated from message grpc.v1.FileVersion
  */
-export type UpdateCompanyRequest = Message<"grpc.v1.UpdateCompanyRequest"> & {
+export type FileVersion = Message<"grpc.v1.FileVersion"> & {
   /**
-   * @generated from field: string prev_id = 1;
+   * @generated from field: string id = 1;
    */
-  prevId: string;
+  id: string;
 
   /**
-   * @generated from field: grpc.v1.Company new_company = 2;
+   * @generated from field: string relative_path = 2;
    */
-  newCompany?: Company;
+  relativePath: string;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp saved_time = 4;
+   */
+  savedTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_time = 5;
+   */
+  modifiedTime?: Timestamp;
+
+  /**
+   * @generated from field: int64 size = 6;
+   */
+  size: bigint;
 };
 
 /**
- * Describes the message grpc.v1.UpdateCompanyRequest.
- * Use `create(UpdateCompanyRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.FileVersion.
+ * Use `create(FileVersionSchema)` to create a new message.
  */
-export const UpdateCompanyRequestSchema: GenMessage<UpdateCompanyRequest> = /*@__PURE__*/
+export const FileVersionSchema: GenMessage<FileVersion> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 12);
 
 /**
- * @generated from message grpc.v1.UpdateCompanyResponse
+ * DuplicateGroup represents files with identical contents
+ *
+ * @generated from message grpc.v1.DuplicateGroup
  */
-export type UpdateCompanyResponse = Message<"grpc.v1.UpdateCompanyResponse"> & {
+export type DuplicateGroup = Message<"grpc.v1.DuplicateGroup"> & {
   /**
-   * @generated from field: grpc.v1.Company prev_company = 1;
+   * @generated from field: string digest = 1;
    */
-  prevCompany?: Company;
+  digest: string;
+
+  /**
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: repeated grpc.v1.File files = 3;
+   */
+  files: File[];
+
+  /**
+   * @generated from field: int64 wasted_bytes = 4;
+   */
+  wastedBytes: bigint;
 };
 
 /**
- * Describes the message grpc.v1.UpdateCompanyResponse.
- * Use `create(UpdateCompanyResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.DuplicateGroup.
+ * Use `create(DuplicateGroupSchema)` to create a new message.
  */
-export const UpdateCompanyResponseSchema: GenMessage<UpdateCompanyResponse> = /*@__PURE__*/
+export const DuplicateGroupSchema: GenMessage<DuplicateGroup> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 13);
 
 /**
- * @generated from message grpc.v1.GetCompanyCategoriesRequest
+ * FileSearchHit represents a ranked result of SearchFiles
+ *
+ * @generated from message grpc.v1.FileSearchHit
  */
-export type GetCompanyCategoriesRequest = Message<"grpc.v1.GetCompanyCategoriesRequest"> & {
+export type FileSearchHit = Message<"grpc.v1.FileSearchHit"> & {
+  /**
+   * @generated from field: grpc.v1.File file = 1;
+   */
+  file?: File;
+
+  /**
+   * @generated from field: string relative_path = 2;
+   */
+  relativePath: string;
+
+  /**
+   * @generated from field: double score = 3;
+   */
+  score: number;
 };
 
 /**
- * Describes the message grpc.v1.GetCompanyCategoriesRequest.
- * Use `create(GetCompanyCategoriesRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.FileSearchHit.
+ * Use `create(FileSearchHitSchema)` to create a new message.
  */
-export const GetCompanyCategoriesRequestSchema: GenMessage<GetCompanyCategoriesRequest> = /*@__PURE__*/
+export const FileSearchHitSchema: GenMessage<FileSearchHit> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 14);
 
 /**
- * @generated from message grpc.v1.GetCompanyCategoriesResponse
+ * WorkbookRow represents the values of a row in a worksheet
+ *
+ * @generated from message grpc.v1.WorkbookRow
  */
-export type GetCompanyCategoriesResponse = Message<"grpc.v1.GetCompanyCategoriesResponse"> & {
+export type WorkbookRow = Message<"grpc.v1.WorkbookRow"> & {
   /**
-   * @generated from field: repeated grpc.v1.CompanyCategory categories = 1;
+   * @generated from field: int32 row = 1;
    */
-  categories: CompanyCategory[];
+  row: number;
+
+  /**
+   * @generated from field: repeated string values = 2;
+   */
+  values: string[];
 };
 
 /**
- * Describes the message grpc.v1.GetCompanyCategoriesResponse.
- * Use `create(GetCompanyCategoriesResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.WorkbookRow.
+ * Use `create(WorkbookRowSchema)` to create a new message.
  */
-export const GetCompanyCategoriesResponseSchema: GenMessage<GetCompanyCategoriesResponse> = /*@__PURE__*/
+export const WorkbookRowSchema: GenMessage<WorkbookRow> = /*@__PURE__*/
   messageDesc(file_grpc_v1_toyotachikuro, 15);
 
 /**
- * KojiService messages
+ * WorkbookSheetSummary represents a worksheet of an xlsx workbook
  *
- * @generated from message grpc.v1.GetKojiesRequest
+ * @generated from message grpc.v1.WorkbookSheetSummary
  */
-export type GetKojiesRequest = Message<"grpc.v1.GetKojiesRequest"> & {
-};
+export type WorkbookSheetSummary = Message<"grpc.v1.WorkbookSheetSummary"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
 
-/**
+  /**
+   * @generated from field: bool hidden = 2;
+   */
+  hidden: boolean;
+
+  /**
+   * @generated from field: int32 row_count = 3;
+   */
+  rowCount: number;
+
+  /**
+   * @generated from field: int32 column_count = 4;
+   */
+  columnCount: number;
+
+  /**
+   * @generated from field: int32 cell_count = 5;
+   */
+  cellCount: number;
+
+  /**
+   * @generated from field: repeated grpc.v1.WorkbookRow preview = 6;
+   */
+  preview: WorkbookRow[];
+};
+
+/**
+ * Describes the message grpc.v1.WorkbookSheetSummary.
+ * Use `create(WorkbookSheetSummarySchema)` to create a new message.
+ */
+export const WorkbookSheetSummarySchema: GenMessage<WorkbookSheetSummary> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 16);
+
+/**
+ * WorkbookCellMatch represents a cell containing the search query
+ *
+ * @generated from message grpc.v1.WorkbookCellMatch
+ */
+export type WorkbookCellMatch = Message<"grpc.v1.WorkbookCellMatch"> & {
+  /**
+   * @generated from field: string sheet = 1;
+   */
+  sheet: string;
+
+  /**
+   * @generated from field: string cell = 2;
+   */
+  cell: string;
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message grpc.v1.WorkbookCellMatch.
+ * Use `create(WorkbookCellMatchSchema)` to create a new message.
+ */
+export const WorkbookCellMatchSchema: GenMessage<WorkbookCellMatch> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 17);
+
+/**
+ * WorkbookSearchHit represents a ranked result of SearchWorkbooks
+ *
+ * @generated from message grpc.v1.WorkbookSearchHit
+ */
+export type WorkbookSearchHit = Message<"grpc.v1.WorkbookSearchHit"> & {
+  /**
+   * @generated from field: grpc.v1.File file = 1;
+   */
+  file?: File;
+
+  /**
+   * @generated from field: string relative_path = 2;
+   */
+  relativePath: string;
+
+  /**
+   * @generated from field: double score = 3;
+   */
+  score: number;
+
+  /**
+   * @generated from field: repeated grpc.v1.WorkbookCellMatch matches = 4;
+   */
+  matches: WorkbookCellMatch[];
+
+  /**
+   * @generated from field: int32 match_count = 5;
+   */
+  matchCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.WorkbookSearchHit.
+ * Use `create(WorkbookSearchHitSchema)` to create a new message.
+ */
+export const WorkbookSearchHitSchema: GenMessage<WorkbookSearchHit> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 18);
+
+/**
+ * DiskUsageEntry represents the disk usage of a file or folder directly under the target folder
+ *
+ * @generated from message grpc.v1.DiskUsageEntry
+ */
+export type DiskUsageEntry = Message<"grpc.v1.DiskUsageEntry"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string relative_path = 2;
+   */
+  relativePath: string;
+
+  /**
+   * @generated from field: bool is_dir = 3;
+   */
+  isDir: boolean;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int64 file_count = 5;
+   */
+  fileCount: bigint;
+};
+
+/**
+ * Describes the message grpc.v1.DiskUsageEntry.
+ * Use `create(DiskUsageEntrySchema)` to create a new message.
+ */
+export const DiskUsageEntrySchema: GenMessage<DiskUsageEntry> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 19);
+
+/**
+ * MediaMetadata represents the metadata of an image file including EXIF
+ *
+ * @generated from message grpc.v1.MediaMetadata
+ */
+export type MediaMetadata = Message<"grpc.v1.MediaMetadata"> & {
+  /**
+   * @generated from field: grpc.v1.File file = 1;
+   */
+  file?: File;
+
+  /**
+   * @generated from field: int32 width = 2;
+   */
+  width: number;
+
+  /**
+   * @generated from field: int32 height = 3;
+   */
+  height: number;
+
+  /**
+   * @generated from field: int32 orientation = 4;
+   */
+  orientation: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp capture_time = 5;
+   */
+  captureTime?: Timestamp;
+
+  /**
+   * @generated from field: string camera_make = 6;
+   */
+  cameraMake: string;
+
+  /**
+   * @generated from field: string camera_model = 7;
+   */
+  cameraModel: string;
+
+  /**
+   * @generated from field: bool has_location = 8;
+   */
+  hasLocation: boolean;
+
+  /**
+   * @generated from field: double latitude = 9;
+   */
+  latitude: number;
+
+  /**
+   * @generated from field: double longitude = 10;
+   */
+  longitude: number;
+};
+
+/**
+ * Describes the message grpc.v1.MediaMetadata.
+ * Use `create(MediaMetadataSchema)` to create a new message.
+ */
+export const MediaMetadataSchema: GenMessage<MediaMetadata> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 20);
+
+/**
+ * PhotoAlbumItem represents a photo in a koji photo album
+ *
+ * @generated from message grpc.v1.PhotoAlbumItem
+ */
+export type PhotoAlbumItem = Message<"grpc.v1.PhotoAlbumItem"> & {
+  /**
+   * @generated from field: grpc.v1.MediaMetadata metadata = 1;
+   */
+  metadata?: MediaMetadata;
+
+  /**
+   * @generated from field: string relative_path = 2;
+   */
+  relativePath: string;
+
+  /**
+   * @generated from field: bool outside_period = 3;
+   */
+  outsidePeriod: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.PhotoAlbumItem.
+ * Use `create(PhotoAlbumItemSchema)` to create a new message.
+ */
+export const PhotoAlbumItemSchema: GenMessage<PhotoAlbumItem> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 21);
+
+/**
+ * PhotoAlbumDay represents photos taken on the same day
+ *
+ * @generated from message grpc.v1.PhotoAlbumDay
+ */
+export type PhotoAlbumDay = Message<"grpc.v1.PhotoAlbumDay"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: repeated grpc.v1.PhotoAlbumItem photos = 2;
+   */
+  photos: PhotoAlbumItem[];
+
+  /**
+   * @generated from field: bool outside_period = 3;
+   */
+  outsidePeriod: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.PhotoAlbumDay.
+ * Use `create(PhotoAlbumDaySchema)` to create a new message.
+ */
+export const PhotoAlbumDaySchema: GenMessage<PhotoAlbumDay> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 22);
+
+/**
+ * FileService messages
+ * GetFilesRequest lists entries under pathist_folder
+ * depth: 0 or 1 lists one level, a larger value recurses, a negative value recurses without limit
+ * page_size: 0 uses the server default, page_token continues from next_page_token
+ * page_token: the first page walks, filters and sorts the folder once and the server keeps that listing,
+ * later pages are cut from it, so changes made after the first page are not listed and removed entries are skipped
+ * page_token is only valid with the same request fields except page_size and page_token,
+ * and is rejected with INVALID_ARGUMENT after the listing is dropped (10 minutes unused, or evicted by newer listings);
+ * request the first page again without page_token in that case
+ *
+ * @generated from message grpc.v1.GetFilesRequest
+ */
+export type GetFilesRequest = Message<"grpc.v1.GetFilesRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int32 depth = 2;
+   */
+  depth: number;
+
+  /**
+   * @generated from field: repeated string globs = 3;
+   */
+  globs: string[];
+
+  /**
+   * @generated from field: repeated string extensions = 4;
+   */
+  extensions: string[];
+
+  /**
+   * @generated from field: int64 min_size = 5;
+   */
+  minSize: bigint;
+
+  /**
+   * @generated from field: int64 max_size = 6;
+   */
+  maxSize: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_after = 7;
+   */
+  modifiedAfter?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_before = 8;
+   */
+  modifiedBefore?: Timestamp;
+
+  /**
+   * @generated from field: grpc.v1.FileSortKey sort_key = 9;
+   */
+  sortKey: FileSortKey;
+
+  /**
+   * @generated from field: bool descending = 10;
+   */
+  descending: boolean;
+
+  /**
+   * @generated from field: bool folders_first = 11;
+   */
+  foldersFirst: boolean;
+
+  /**
+   * @generated from field: int32 page_size = 12;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 13;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetFilesRequest.
+ * Use `create(GetFilesRequestSchema)` to create a new message.
+ */
+export const GetFilesRequestSchema: GenMessage<GetFilesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 23);
+
+/**
+ * @generated from message grpc.v1.GetFilesResponse
+ */
+export type GetFilesResponse = Message<"grpc.v1.GetFilesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.File files = 1;
+   */
+  files: File[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.GetFilesResponse.
+ * Use `create(GetFilesResponseSchema)` to create a new message.
+ */
+export const GetFilesResponseSchema: GenMessage<GetFilesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 24);
+
+/**
+ * @generated from message grpc.v1.GetFilePathistFolderRequest
+ */
+export type GetFilePathistFolderRequest = Message<"grpc.v1.GetFilePathistFolderRequest"> & {
+};
+
+/**
+ * Describes the message grpc.v1.GetFilePathistFolderRequest.
+ * Use `create(GetFilePathistFolderRequestSchema)` to create a new message.
+ */
+export const GetFilePathistFolderRequestSchema: GenMessage<GetFilePathistFolderRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 25);
+
+/**
+ * @generated from message grpc.v1.GetFilePathistFolderResponse
+ */
+export type GetFilePathistFolderResponse = Message<"grpc.v1.GetFilePathistFolderResponse"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetFilePathistFolderResponse.
+ * Use `create(GetFilePathistFolderResponseSchema)` to create a new message.
+ */
+export const GetFilePathistFolderResponseSchema: GenMessage<GetFilePathistFolderResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 26);
+
+/**
+ * @generated from message grpc.v1.CopyFilesRequest
+ */
+export type CopyFilesRequest = Message<"grpc.v1.CopyFilesRequest"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileTransfer items = 1;
+   */
+  items: FileTransfer[];
+
+  /**
+   * @generated from field: grpc.v1.OverwritePolicy overwrite_policy = 2;
+   */
+  overwritePolicy: OverwritePolicy;
+};
+
+/**
+ * Describes the message grpc.v1.CopyFilesRequest.
+ * Use `create(CopyFilesRequestSchema)` to create a new message.
+ */
+export const CopyFilesRequestSchema: GenMessage<CopyFilesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 27);
+
+/**
+ * @generated from message grpc.v1.CopyFilesResponse
+ */
+export type CopyFilesResponse = Message<"grpc.v1.CopyFilesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileOperationResult results = 1;
+   */
+  results: FileOperationResult[];
+};
+
+/**
+ * Describes the message grpc.v1.CopyFilesResponse.
+ * Use `create(CopyFilesResponseSchema)` to create a new message.
+ */
+export const CopyFilesResponseSchema: GenMessage<CopyFilesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 28);
+
+/**
+ * @generated from message grpc.v1.MoveFilesRequest
+ */
+export type MoveFilesRequest = Message<"grpc.v1.MoveFilesRequest"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileTransfer items = 1;
+   */
+  items: FileTransfer[];
+
+  /**
+   * @generated from field: grpc.v1.OverwritePolicy overwrite_policy = 2;
+   */
+  overwritePolicy: OverwritePolicy;
+};
+
+/**
+ * Describes the message grpc.v1.MoveFilesRequest.
+ * Use `create(MoveFilesRequestSchema)` to create a new message.
+ */
+export const MoveFilesRequestSchema: GenMessage<MoveFilesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 29);
+
+/**
+ * @generated from message grpc.v1.MoveFilesResponse
+ */
+export type MoveFilesResponse = Message<"grpc.v1.MoveFilesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileOperationResult results = 1;
+   */
+  results: FileOperationResult[];
+};
+
+/**
+ * Describes the message grpc.v1.MoveFilesResponse.
+ * Use `create(MoveFilesResponseSchema)` to create a new message.
+ */
+export const MoveFilesResponseSchema: GenMessage<MoveFilesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 30);
+
+/**
+ * @generated from message grpc.v1.DeleteFilesRequest
+ */
+export type DeleteFilesRequest = Message<"grpc.v1.DeleteFilesRequest"> & {
+  /**
+   * @generated from field: repeated string pathist_folders = 1;
+   */
+  pathistFolders: string[];
+};
+
+/**
+ * Describes the message grpc.v1.DeleteFilesRequest.
+ * Use `create(DeleteFilesRequestSchema)` to create a new message.
+ */
+export const DeleteFilesRequestSchema: GenMessage<DeleteFilesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 31);
+
+/**
+ * @generated from message grpc.v1.DeleteFilesResponse
+ */
+export type DeleteFilesResponse = Message<"grpc.v1.DeleteFilesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileOperationResult results = 1;
+   */
+  results: FileOperationResult[];
+};
+
+/**
+ * Describes the message grpc.v1.DeleteFilesResponse.
+ * Use `create(DeleteFilesResponseSchema)` to create a new message.
+ */
+export const DeleteFilesResponseSchema: GenMessage<DeleteFilesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 32);
+
+/**
+ * @generated from message grpc.v1.CreateFolderRequest
+ */
+export type CreateFolderRequest = Message<"grpc.v1.CreateFolderRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: bool parents = 2;
+   */
+  parents: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.CreateFolderRequest.
+ * Use `create(CreateFolderRequestSchema)` to create a new message.
+ */
+export const CreateFolderRequestSchema: GenMessage<CreateFolderRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 33);
+
+/**
+ * @generated from message grpc.v1.CreateFolderResponse
+ */
+export type CreateFolderResponse = Message<"grpc.v1.CreateFolderResponse"> & {
+  /**
+   * @generated from field: grpc.v1.File folder = 1;
+   */
+  folder?: File;
+};
+
+/**
+ * Describes the message grpc.v1.CreateFolderResponse.
+ * Use `create(CreateFolderResponseSchema)` to create a new message.
+ */
+export const CreateFolderResponseSchema: GenMessage<CreateFolderResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 34);
+
+/**
+ * @generated from message grpc.v1.ListTrashRequest
+ */
+export type ListTrashRequest = Message<"grpc.v1.ListTrashRequest"> & {
+};
+
+/**
+ * Describes the message grpc.v1.ListTrashRequest.
+ * Use `create(ListTrashRequestSchema)` to create a new message.
+ */
+export const ListTrashRequestSchema: GenMessage<ListTrashRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 35);
+
+/**
+ * @generated from message grpc.v1.ListTrashResponse
+ */
+export type ListTrashResponse = Message<"grpc.v1.ListTrashResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.TrashItem items = 1;
+   */
+  items: TrashItem[];
+};
+
+/**
+ * Describes the message grpc.v1.ListTrashResponse.
+ * Use `create(ListTrashResponseSchema)` to create a new message.
+ */
+export const ListTrashResponseSchema: GenMessage<ListTrashResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 36);
+
+/**
+ * @generated from message grpc.v1.RestoreFromTrashRequest
+ */
+export type RestoreFromTrashRequest = Message<"grpc.v1.RestoreFromTrashRequest"> & {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+
+  /**
+   * @generated from field: grpc.v1.OverwritePolicy overwrite_policy = 2;
+   */
+  overwritePolicy: OverwritePolicy;
+};
+
+/**
+ * Describes the message grpc.v1.RestoreFromTrashRequest.
+ * Use `create(RestoreFromTrashRequestSchema)` to create a new message.
+ */
+export const RestoreFromTrashRequestSchema: GenMessage<RestoreFromTrashRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 37);
+
+/**
+ * @generated from message grpc.v1.RestoreFromTrashResponse
+ */
+export type RestoreFromTrashResponse = Message<"grpc.v1.RestoreFromTrashResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileOperationResult results = 1;
+   */
+  results: FileOperationResult[];
+};
+
+/**
+ * Describes the message grpc.v1.RestoreFromTrashResponse.
+ * Use `create(RestoreFromTrashResponseSchema)` to create a new message.
+ */
+export const RestoreFromTrashResponseSchema: GenMessage<RestoreFromTrashResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 38);
+
+/**
+ * @generated from message grpc.v1.PurgeTrashRequest
+ */
+export type PurgeTrashRequest = Message<"grpc.v1.PurgeTrashRequest"> & {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+
+  /**
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.PurgeTrashRequest.
+ * Use `create(PurgeTrashRequestSchema)` to create a new message.
+ */
+export const PurgeTrashRequestSchema: GenMessage<PurgeTrashRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 39);
+
+/**
+ * @generated from message grpc.v1.PurgeTrashResponse
+ */
+export type PurgeTrashResponse = Message<"grpc.v1.PurgeTrashResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileOperationResult results = 1;
+   */
+  results: FileOperationResult[];
+};
+
+/**
+ * Describes the message grpc.v1.PurgeTrashResponse.
+ * Use `create(PurgeTrashResponseSchema)` to create a new message.
+ */
+export const PurgeTrashResponseSchema: GenMessage<PurgeTrashResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 40);
+
+/**
+ * @generated from message grpc.v1.DownloadFileRequest
+ */
+export type DownloadFileRequest = Message<"grpc.v1.DownloadFileRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int64 offset = 2;
+   */
+  offset: bigint;
+
+  /**
+   * @generated from field: int64 length = 3;
+   */
+  length: bigint;
+
+  /**
+   * @generated from field: int32 chunk_size = 4;
+   */
+  chunkSize: number;
+};
+
+/**
+ * Describes the message grpc.v1.DownloadFileRequest.
+ * Use `create(DownloadFileRequestSchema)` to create a new message.
+ */
+export const DownloadFileRequestSchema: GenMessage<DownloadFileRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 41);
+
+/**
+ * @generated from message grpc.v1.DownloadFileResponse
+ */
+export type DownloadFileResponse = Message<"grpc.v1.DownloadFileResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: int64 offset = 2;
+   */
+  offset: bigint;
+
+  /**
+   * @generated from field: int64 total_size = 3;
+   */
+  totalSize: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp modified_time = 4;
+   */
+  modifiedTime?: Timestamp;
+};
+
+/**
+ * Describes the message grpc.v1.DownloadFileResponse.
+ * Use `create(DownloadFileResponseSchema)` to create a new message.
+ */
+export const DownloadFileResponseSchema: GenMessage<DownloadFileResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 42);
+
+/**
+ * UploadFileRequest carries the upload header in the first message and data chunks in all messages
+ *
+ * @generated from message grpc.v1.UploadFileRequest
+ */
+export type UploadFileRequest = Message<"grpc.v1.UploadFileRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: string upload_id = 2;
+   */
+  uploadId: string;
+
+  /**
+   * @generated from field: int64 total_size = 3;
+   */
+  totalSize: bigint;
+
+  /**
+   * @generated from field: string checksum_blake2b = 4;
+   */
+  checksumBlake2b: string;
+
+  /**
+   * @generated from field: grpc.v1.OverwritePolicy overwrite_policy = 5;
+   */
+  overwritePolicy: OverwritePolicy;
+
+  /**
+   * @generated from field: int64 offset = 6;
+   */
+  offset: bigint;
+
+  /**
+   * @generated from field: bytes data = 7;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message grpc.v1.UploadFileRequest.
+ * Use `create(UploadFileRequestSchema)` to create a new message.
+ */
+export const UploadFileRequestSchema: GenMessage<UploadFileRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 43);
+
+/**
+ * @generated from message grpc.v1.UploadFileResponse
+ */
+export type UploadFileResponse = Message<"grpc.v1.UploadFileResponse"> & {
+  /**
+   * @generated from field: string upload_id = 1;
+   */
+  uploadId: string;
+
+  /**
+   * @generated from field: int64 received_size = 2;
+   */
+  receivedSize: bigint;
+
+  /**
+   * @generated from field: bool completed = 3;
+   */
+  completed: boolean;
+
+  /**
+   * @generated from field: grpc.v1.File file = 4;
+   */
+  file?: File;
+};
+
+/**
+ * Describes the message grpc.v1.UploadFileResponse.
+ * Use `create(UploadFileResponseSchema)` to create a new message.
+ */
+export const UploadFileResponseSchema: GenMessage<UploadFileResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 44);
+
+/**
+ * @generated from message grpc.v1.FindDuplicatesRequest
+ */
+export type FindDuplicatesRequest = Message<"grpc.v1.FindDuplicatesRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int64 min_size = 2;
+   */
+  minSize: bigint;
+};
+
+/**
+ * Describes the message grpc.v1.FindDuplicatesRequest.
+ * Use `create(FindDuplicatesRequestSchema)` to create a new message.
+ */
+export const FindDuplicatesRequestSchema: GenMessage<FindDuplicatesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 45);
+
+/**
+ * @generated from message grpc.v1.FindDuplicatesResponse
+ */
+export type FindDuplicatesResponse = Message<"grpc.v1.FindDuplicatesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.DuplicateGroup groups = 1;
+   */
+  groups: DuplicateGroup[];
+
+  /**
+   * @generated from field: int64 wasted_bytes = 2;
+   */
+  wastedBytes: bigint;
+
+  /**
+   * @generated from field: int32 scanned_count = 3;
+   */
+  scannedCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.FindDuplicatesResponse.
+ * Use `create(FindDuplicatesResponseSchema)` to create a new message.
+ */
+export const FindDuplicatesResponseSchema: GenMessage<FindDuplicatesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 46);
+
+/**
+ * @generated from message grpc.v1.SearchFilesRequest
+ */
+export type SearchFilesRequest = Message<"grpc.v1.SearchFilesRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: string pathist_folder = 2;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message grpc.v1.SearchFilesRequest.
+ * Use `create(SearchFilesRequestSchema)` to create a new message.
+ */
+export const SearchFilesRequestSchema: GenMessage<SearchFilesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 47);
+
+/**
+ * @generated from message grpc.v1.SearchFilesResponse
+ */
+export type SearchFilesResponse = Message<"grpc.v1.SearchFilesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileSearchHit hits = 1;
+   */
+  hits: FileSearchHit[];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount: number;
+
+  /**
+   * @generated from field: bool index_ready = 3;
+   */
+  indexReady: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.SearchFilesResponse.
+ * Use `create(SearchFilesResponseSchema)` to create a new message.
+ */
+export const SearchFilesResponseSchema: GenMessage<SearchFilesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 48);
+
+/**
+ * @generated from message grpc.v1.GetWorkbookSummaryRequest
+ */
+export type GetWorkbookSummaryRequest = Message<"grpc.v1.GetWorkbookSummaryRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int32 preview_rows = 2;
+   */
+  previewRows: number;
+};
+
+/**
+ * Describes the message grpc.v1.GetWorkbookSummaryRequest.
+ * Use `create(GetWorkbookSummaryRequestSchema)` to create a new message.
+ */
+export const GetWorkbookSummaryRequestSchema: GenMessage<GetWorkbookSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 49);
+
+/**
+ * @generated from message grpc.v1.GetWorkbookSummaryResponse
+ */
+export type GetWorkbookSummaryResponse = Message<"grpc.v1.GetWorkbookSummaryResponse"> & {
+  /**
+   * @generated from field: grpc.v1.File file = 1;
+   */
+  file?: File;
+
+  /**
+   * @generated from field: repeated grpc.v1.WorkbookSheetSummary sheets = 2;
+   */
+  sheets: WorkbookSheetSummary[];
+
+  /**
+   * @generated from field: bool truncated = 3;
+   */
+  truncated: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.GetWorkbookSummaryResponse.
+ * Use `create(GetWorkbookSummaryResponseSchema)` to create a new message.
+ */
+export const GetWorkbookSummaryResponseSchema: GenMessage<GetWorkbookSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 50);
+
+/**
+ * @generated from message grpc.v1.SearchWorkbooksRequest
+ */
+export type SearchWorkbooksRequest = Message<"grpc.v1.SearchWorkbooksRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: string pathist_folder = 2;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message grpc.v1.SearchWorkbooksRequest.
+ * Use `create(SearchWorkbooksRequestSchema)` to create a new message.
+ */
+export const SearchWorkbooksRequestSchema: GenMessage<SearchWorkbooksRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 51);
+
+/**
+ * @generated from message grpc.v1.SearchWorkbooksResponse
+ */
+export type SearchWorkbooksResponse = Message<"grpc.v1.SearchWorkbooksResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.WorkbookSearchHit hits = 1;
+   */
+  hits: WorkbookSearchHit[];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount: number;
+
+  /**
+   * @generated from field: bool index_ready = 3;
+   */
+  indexReady: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.SearchWorkbooksResponse.
+ * Use `create(SearchWorkbooksResponseSchema)` to create a new message.
+ */
+export const SearchWorkbooksResponseSchema: GenMessage<SearchWorkbooksResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 52);
+
+/**
+ * @generated from message grpc.v1.ExportArchiveRequest
+ */
+export type ExportArchiveRequest = Message<"grpc.v1.ExportArchiveRequest"> & {
+  /**
+   * @generated from field: repeated string pathist_folders = 1;
+   */
+  pathistFolders: string[];
+
+  /**
+   * @generated from field: string koji_id = 2;
+   */
+  kojiId: string;
+
+  /**
+   * @generated from field: string company_id = 3;
+   */
+  companyId: string;
+
+  /**
+   * @generated from field: string archive_name = 4;
+   */
+  archiveName: string;
+
+  /**
+   * @generated from field: int32 chunk_size = 5;
+   */
+  chunkSize: number;
+};
+
+/**
+ * Describes the message grpc.v1.ExportArchiveRequest.
+ * Use `create(ExportArchiveRequestSchema)` to create a new message.
+ */
+export const ExportArchiveRequestSchema: GenMessage<ExportArchiveRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 53);
+
+/**
+ * ExportArchiveResponse carries the archive name in the first message and the summary in the last message
+ *
+ * @generated from message grpc.v1.ExportArchiveResponse
+ */
+export type ExportArchiveResponse = Message<"grpc.v1.ExportArchiveResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string archive_name = 2;
+   */
+  archiveName: string;
+
+  /**
+   * @generated from field: bool done = 3;
+   */
+  done: boolean;
+
+  /**
+   * @generated from field: int32 file_count = 4;
+   */
+  fileCount: number;
+
+  /**
+   * @generated from field: int32 skipped_count = 5;
+   */
+  skippedCount: number;
+
+  /**
+   * @generated from field: int64 total_bytes = 6;
+   */
+  totalBytes: bigint;
+};
+
+/**
+ * Describes the message grpc.v1.ExportArchiveResponse.
+ * Use `create(ExportArchiveResponseSchema)` to create a new message.
+ */
+export const ExportArchiveResponseSchema: GenMessage<ExportArchiveResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 54);
+
+/**
+ * @generated from message grpc.v1.GetDiskUsageRequest
+ */
+export type GetDiskUsageRequest = Message<"grpc.v1.GetDiskUsageRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: string koji_id = 2;
+   */
+  kojiId: string;
+
+  /**
+   * @generated from field: string company_id = 3;
+   */
+  companyId: string;
+
+  /**
+   * @generated from field: int32 top_children = 4;
+   */
+  topChildren: number;
+
+  /**
+   * @generated from field: bool refresh = 5;
+   */
+  refresh: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.GetDiskUsageRequest.
+ * Use `create(GetDiskUsageRequestSchema)` to create a new message.
+ */
+export const GetDiskUsageRequestSchema: GenMessage<GetDiskUsageRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 55);
+
+/**
+ * @generated from message grpc.v1.GetDiskUsageResponse
+ */
+export type GetDiskUsageResponse = Message<"grpc.v1.GetDiskUsageResponse"> & {
+  /**
+   * @generated from field: string relative_path = 1;
+   */
+  relativePath: string;
+
+  /**
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int64 file_count = 3;
+   */
+  fileCount: bigint;
+
+  /**
+   * @generated from field: int64 folder_count = 4;
+   */
+  folderCount: bigint;
+
+  /**
+   * @generated from field: int64 skipped_count = 5;
+   */
+  skippedCount: bigint;
+
+  /**
+   * @generated from field: repeated grpc.v1.DiskUsageEntry largest_children = 6;
+   */
+  largestChildren: DiskUsageEntry[];
+
+  /**
+   * @generated from field: int32 children_count = 7;
+   */
+  childrenCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.GetDiskUsageResponse.
+ * Use `create(GetDiskUsageResponseSchema)` to create a new message.
+ */
+export const GetDiskUsageResponseSchema: GenMessage<GetDiskUsageResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 56);
+
+/**
+ * @generated from message grpc.v1.ListFileVersionsRequest
+ */
+export type ListFileVersionsRequest = Message<"grpc.v1.ListFileVersionsRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+};
+
+/**
+ * Describes the message grpc.v1.ListFileVersionsRequest.
+ * Use `create(ListFileVersionsRequestSchema)` to create a new message.
+ */
+export const ListFileVersionsRequestSchema: GenMessage<ListFileVersionsRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 57);
+
+/**
+ * @generated from message grpc.v1.ListFileVersionsResponse
+ */
+export type ListFileVersionsResponse = Message<"grpc.v1.ListFileVersionsResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FileVersion versions = 1;
+   */
+  versions: FileVersion[];
+
+  /**
+   * @generated from field: grpc.v1.File current = 2;
+   */
+  current?: File;
+};
+
+/**
+ * Describes the message grpc.v1.ListFileVersionsResponse.
+ * Use `create(ListFileVersionsResponseSchema)` to create a new message.
+ */
+export const ListFileVersionsResponseSchema: GenMessage<ListFileVersionsResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 58);
+
+/**
+ * @generated from message grpc.v1.RestoreFileVersionRequest
+ */
+export type RestoreFileVersionRequest = Message<"grpc.v1.RestoreFileVersionRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: string version_id = 2;
+   */
+  versionId: string;
+};
+
+/**
+ * Describes the message grpc.v1.RestoreFileVersionRequest.
+ * Use `create(RestoreFileVersionRequestSchema)` to create a new message.
+ */
+export const RestoreFileVersionRequestSchema: GenMessage<RestoreFileVersionRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 59);
+
+/**
+ * @generated from message grpc.v1.RestoreFileVersionResponse
+ */
+export type RestoreFileVersionResponse = Message<"grpc.v1.RestoreFileVersionResponse"> & {
+  /**
+   * @generated from field: grpc.v1.File file = 1;
+   */
+  file?: File;
+
+  /**
+   * @generated from field: string saved_version_id = 2;
+   */
+  savedVersionId: string;
+};
+
+/**
+ * Describes the message grpc.v1.RestoreFileVersionResponse.
+ * Use `create(RestoreFileVersionResponseSchema)` to create a new message.
+ */
+export const RestoreFileVersionResponseSchema: GenMessage<RestoreFileVersionResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 60);
+
+/**
+ * @generated from message grpc.v1.BatchPlanRequest
+ */
+export type BatchPlanRequest = Message<"grpc.v1.BatchPlanRequest"> & {
+  /**
+   * @generated from field: repeated grpc.v1.BatchOperation operations = 1;
+   */
+  operations: BatchOperation[];
+
+  /**
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.BatchPlanRequest.
+ * Use `create(BatchPlanRequestSchema)` to create a new message.
+ */
+export const BatchPlanRequestSchema: GenMessage<BatchPlanRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 61);
+
+/**
+ * @generated from message grpc.v1.BatchPlanResponse
+ */
+export type BatchPlanResponse = Message<"grpc.v1.BatchPlanResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.BatchOperationResult results = 1;
+   */
+  results: BatchOperationResult[];
+
+  /**
+   * @generated from field: bool ok = 2;
+   */
+  ok: boolean;
+
+  /**
+   * @generated from field: bool executed = 3;
+   */
+  executed: boolean;
+
+  /**
+   * @generated from field: bool rolled_back = 4;
+   */
+  rolledBack: boolean;
+
+  /**
+   * @generated from field: string journal_id = 5;
+   */
+  journalId: string;
+};
+
+/**
+ * Describes the message grpc.v1.BatchPlanResponse.
+ * Use `create(BatchPlanResponseSchema)` to create a new message.
+ */
+export const BatchPlanResponseSchema: GenMessage<BatchPlanResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 62);
+
+/**
+ * @generated from message grpc.v1.NormalizeFolderNamesRequest
+ */
+export type NormalizeFolderNamesRequest = Message<"grpc.v1.NormalizeFolderNamesRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message grpc.v1.NormalizeFolderNamesRequest.
+ * Use `create(NormalizeFolderNamesRequestSchema)` to create a new message.
+ */
+export const NormalizeFolderNamesRequestSchema: GenMessage<NormalizeFolderNamesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 63);
+
+/**
+ * @generated from message grpc.v1.NormalizeFolderNamesResponse
+ */
+export type NormalizeFolderNamesResponse = Message<"grpc.v1.NormalizeFolderNamesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.FolderNameIssue issues = 1;
+   */
+  issues: FolderNameIssue[];
+
+  /**
+   * @generated from field: int32 renamed_count = 2;
+   */
+  renamedCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.NormalizeFolderNamesResponse.
+ * Use `create(NormalizeFolderNamesResponseSchema)` to create a new message.
+ */
+export const NormalizeFolderNamesResponseSchema: GenMessage<NormalizeFolderNamesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 64);
+
+/**
+ * CompanyService messages
+ * GetCompaniesRequest lists companies
+ * query: space separated terms matched against short_name, persist_reading, persist_long_name, persist_address and persist_tel
+ * empty_fields, non_empty_fields: Company field names such as "persist_tel"
+ * page_size: 0 uses the server default, page_token continues from next_page_token
+ * page_token is rejected once the companies have changed since it was issued, and refresh is ignored with page_token
+ *
+ * @generated from message grpc.v1.GetCompaniesRequest
+ */
+export type GetCompaniesRequest = Message<"grpc.v1.GetCompaniesRequest"> & {
+  /**
+   * @generated from field: bool refresh = 1;
+   */
+  refresh: boolean;
+
+  /**
+   * @generated from field: bool include_folder_sizes = 2;
+   */
+  includeFolderSizes: boolean;
+
+  /**
+   * @generated from field: bool archived = 3;
+   */
+  archived: boolean;
+
+  /**
+   * @generated from field: repeated int32 category_indexes = 4;
+   */
+  categoryIndexes: number[];
+
+  /**
+   * @generated from field: string query = 5;
+   */
+  query: string;
+
+  /**
+   * @generated from field: repeated string empty_fields = 6;
+   */
+  emptyFields: string[];
+
+  /**
+   * @generated from field: repeated string non_empty_fields = 7;
+   */
+  nonEmptyFields: string[];
+
+  /**
+   * @generated from field: grpc.v1.CompanySortKey sort_key = 8;
+   */
+  sortKey: CompanySortKey;
+
+  /**
+   * @generated from field: bool descending = 9;
+   */
+  descending: boolean;
+
+  /**
+   * @generated from field: int32 page_size = 10;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 11;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetCompaniesRequest.
+ * Use `create(GetCompaniesRequestSchema)` to create a new message.
+ */
+export const GetCompaniesRequestSchema: GenMessage<GetCompaniesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 65);
+
+/**
+ * GetCompaniesResponse returns one page of the matching companies in ordered_companies
+ * companies and folder_sizes hold all matches when neither page_size nor page_token is given, otherwise the same page
+ *
+ * @generated from message grpc.v1.GetCompaniesResponse
+ */
+export type GetCompaniesResponse = Message<"grpc.v1.GetCompaniesResponse"> & {
+  /**
+   * @generated from field: map<string, grpc.v1.Company> companies = 1;
+   */
+  companies: { [key: string]: Company };
+
+  /**
+   * @generated from field: uint64 generation = 2;
+   */
+  generation: bigint;
+
+  /**
+   * @generated from field: map<string, int64> folder_sizes = 3;
+   */
+  folderSizes: { [key: string]: bigint };
+
+  /**
+   * @generated from field: repeated grpc.v1.Company ordered_companies = 4;
+   */
+  orderedCompanies: Company[];
+
+  /**
+   * @generated from field: string next_page_token = 5;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int32 total_count = 6;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.GetCompaniesResponse.
+ * Use `create(GetCompaniesResponseSchema)` to create a new message.
+ */
+export const GetCompaniesResponseSchema: GenMessage<GetCompaniesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 66);
+
+/**
+ * @generated from message grpc.v1.GetCompanyRequest
+ */
+export type GetCompanyRequest = Message<"grpc.v1.GetCompanyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetCompanyRequest.
+ * Use `create(GetCompanyRequestSchema)` to create a new message.
+ */
+export const GetCompanyRequestSchema: GenMessage<GetCompanyRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 67);
+
+/**
+ * @generated from message grpc.v1.GetCompanyResponse
+ */
+export type GetCompanyResponse = Message<"grpc.v1.GetCompanyResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Company company = 1;
+   */
+  company?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.GetCompanyResponse.
+ * Use `create(GetCompanyResponseSchema)` to create a new message.
+ */
+export const GetCompanyResponseSchema: GenMessage<GetCompanyResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 68);
+
+/**
+ * @generated from message grpc.v1.UpdateCompanyRequest
+ */
+export type UpdateCompanyRequest = Message<"grpc.v1.UpdateCompanyRequest"> & {
+  /**
+   * @generated from field: string prev_id = 1;
+   */
+  prevId: string;
+
+  /**
+   * @generated from field: grpc.v1.Company new_company = 2;
+   */
+  newCompany?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.UpdateCompanyRequest.
+ * Use `create(UpdateCompanyRequestSchema)` to create a new message.
+ */
+export const UpdateCompanyRequestSchema: GenMessage<UpdateCompanyRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 69);
+
+/**
+ * @generated from message grpc.v1.UpdateCompanyResponse
+ */
+export type UpdateCompanyResponse = Message<"grpc.v1.UpdateCompanyResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Company prev_company = 1;
+   */
+  prevCompany?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.UpdateCompanyResponse.
+ * Use `create(UpdateCompanyResponseSchema)` to create a new message.
+ */
+export const UpdateCompanyResponseSchema: GenMessage<UpdateCompanyResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 70);
+
+/**
+ * @generated from message grpc.v1.GetCompanyCategoriesRequest
+ */
+export type GetCompanyCategoriesRequest = Message<"grpc.v1.GetCompanyCategoriesRequest"> & {
+};
+
+/**
+ * Describes the message grpc.v1.GetCompanyCategoriesRequest.
+ * Use `create(GetCompanyCategoriesRequestSchema)` to create a new message.
+ */
+export const GetCompanyCategoriesRequestSchema: GenMessage<GetCompanyCategoriesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 71);
+
+/**
+ * @generated from message grpc.v1.GetCompanyCategoriesResponse
+ */
+export type GetCompanyCategoriesResponse = Message<"grpc.v1.GetCompanyCategoriesResponse"> & {
+  /**
+   * @generated from field: repeated grpc.v1.CompanyCategory categories = 1;
+   */
+  categories: CompanyCategory[];
+};
+
+/**
+ * Describes the message grpc.v1.GetCompanyCategoriesResponse.
+ * Use `create(GetCompanyCategoriesResponseSchema)` to create a new message.
+ */
+export const GetCompanyCategoriesResponseSchema: GenMessage<GetCompanyCategoriesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 72);
+
+/**
+ * @generated from message grpc.v1.CreateCompanyRequest
+ */
+export type CreateCompanyRequest = Message<"grpc.v1.CreateCompanyRequest"> & {
+  /**
+   * @generated from field: grpc.v1.Company new_company = 1;
+   */
+  newCompany?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.CreateCompanyRequest.
+ * Use `create(CreateCompanyRequestSchema)` to create a new message.
+ */
+export const CreateCompanyRequestSchema: GenMessage<CreateCompanyRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 73);
+
+/**
+ * @generated from message grpc.v1.CreateCompanyResponse
+ */
+export type CreateCompanyResponse = Message<"grpc.v1.CreateCompanyResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Company company = 1;
+   */
+  company?: Company;
+
+  /**
+   * @generated from field: int32 template_file_count = 2;
+   */
+  templateFileCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.CreateCompanyResponse.
+ * Use `create(CreateCompanyResponseSchema)` to create a new message.
+ */
+export const CreateCompanyResponseSchema: GenMessage<CreateCompanyResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 74);
+
+/**
+ * @generated from message grpc.v1.ArchiveCompanyRequest
+ */
+export type ArchiveCompanyRequest = Message<"grpc.v1.ArchiveCompanyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message grpc.v1.ArchiveCompanyRequest.
+ * Use `create(ArchiveCompanyRequestSchema)` to create a new message.
+ */
+export const ArchiveCompanyRequestSchema: GenMessage<ArchiveCompanyRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 75);
+
+/**
+ * @generated from message grpc.v1.ArchiveCompanyResponse
+ */
+export type ArchiveCompanyResponse = Message<"grpc.v1.ArchiveCompanyResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Company company = 1;
+   */
+  company?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.ArchiveCompanyResponse.
+ * Use `create(ArchiveCompanyResponseSchema)` to create a new message.
+ */
+export const ArchiveCompanyResponseSchema: GenMessage<ArchiveCompanyResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 76);
+
+/**
+ * @generated from message grpc.v1.RestoreCompanyRequest
+ */
+export type RestoreCompanyRequest = Message<"grpc.v1.RestoreCompanyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message grpc.v1.RestoreCompanyRequest.
+ * Use `create(RestoreCompanyRequestSchema)` to create a new message.
+ */
+export const RestoreCompanyRequestSchema: GenMessage<RestoreCompanyRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 77);
+
+/**
+ * @generated from message grpc.v1.RestoreCompanyResponse
+ */
+export type RestoreCompanyResponse = Message<"grpc.v1.RestoreCompanyResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Company company = 1;
+   */
+  company?: Company;
+};
+
+/**
+ * Describes the message grpc.v1.RestoreCompanyResponse.
+ * Use `create(RestoreCompanyResponseSchema)` to create a new message.
+ */
+export const RestoreCompanyResponseSchema: GenMessage<RestoreCompanyResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 78);
+
+/**
+ * KojiService messages
+ *
+ * @generated from message grpc.v1.GetKojiesRequest
+ */
+export type GetKojiesRequest = Message<"grpc.v1.GetKojiesRequest"> & {
+  /**
+   * @generated from field: bool include_folder_sizes = 1;
+   */
+  includeFolderSizes: boolean;
+};
+
+/**
  * Describes the message grpc.v1.GetKojiesRequest.
  * Use `create(GetKojiesRequestSchema)` to create a new message.
  */
 export const GetKojiesRequestSchema: GenMessage<GetKojiesRequest> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 16);
+  messageDesc(file_grpc_v1_toyotachikuro, 79);
+
+/**
+ * @generated from message grpc.v1.GetKojiesResponse
+ */
+export type GetKojiesResponse = Message<"grpc.v1.GetKojiesResponse"> & {
+  /**
+   * @generated from field: map<string, grpc.v1.Koji> kojies = 1;
+   */
+  kojies: { [key: string]: Koji };
+
+  /**
+   * @generated from field: uint64 generation = 2;
+   */
+  generation: bigint;
+
+  /**
+   * @generated from field: map<string, int64> folder_sizes = 3;
+   */
+  folderSizes: { [key: string]: bigint };
+};
+
+/**
+ * Describes the message grpc.v1.GetKojiesResponse.
+ * Use `create(GetKojiesResponseSchema)` to create a new message.
+ */
+export const GetKojiesResponseSchema: GenMessage<GetKojiesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 80);
+
+/**
+ * @generated from message grpc.v1.GetKojiRequest
+ */
+export type GetKojiRequest = Message<"grpc.v1.GetKojiRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetKojiRequest.
+ * Use `create(GetKojiRequestSchema)` to create a new message.
+ */
+export const GetKojiRequestSchema: GenMessage<GetKojiRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 81);
+
+/**
+ * @generated from message grpc.v1.GetKojiResponse
+ */
+export type GetKojiResponse = Message<"grpc.v1.GetKojiResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Koji koji = 1;
+   */
+  koji?: Koji;
+};
+
+/**
+ * Describes the message grpc.v1.GetKojiResponse.
+ * Use `create(GetKojiResponseSchema)` to create a new message.
+ */
+export const GetKojiResponseSchema: GenMessage<GetKojiResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 82);
+
+/**
+ * @generated from message grpc.v1.CreateKojiRequest
+ */
+export type CreateKojiRequest = Message<"grpc.v1.CreateKojiRequest"> & {
+  /**
+   * @generated from field: grpc.v1.Koji new_koji = 1;
+   */
+  newKoji?: Koji;
+};
+
+/**
+ * Describes the message grpc.v1.CreateKojiRequest.
+ * Use `create(CreateKojiRequestSchema)` to create a new message.
+ */
+export const CreateKojiRequestSchema: GenMessage<CreateKojiRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 83);
+
+/**
+ * @generated from message grpc.v1.CreateKojiResponse
+ */
+export type CreateKojiResponse = Message<"grpc.v1.CreateKojiResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Koji koji = 1;
+   */
+  koji?: Koji;
+
+  /**
+   * @generated from field: int32 template_file_count = 2;
+   */
+  templateFileCount: number;
+};
+
+/**
+ * Describes the message grpc.v1.CreateKojiResponse.
+ * Use `create(CreateKojiResponseSchema)` to create a new message.
+ */
+export const CreateKojiResponseSchema: GenMessage<CreateKojiResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 84);
+
+/**
+ * @generated from message grpc.v1.UpdateKojiRequest
+ */
+export type UpdateKojiRequest = Message<"grpc.v1.UpdateKojiRequest"> & {
+  /**
+   * @generated from field: grpc.v1.Koji new_koji = 1;
+   */
+  newKoji?: Koji;
+};
+
+/**
+ * Describes the message grpc.v1.UpdateKojiRequest.
+ * Use `create(UpdateKojiRequestSchema)` to create a new message.
+ */
+export const UpdateKojiRequestSchema: GenMessage<UpdateKojiRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 85);
+
+/**
+ * @generated from message grpc.v1.UpdateKojiResponse
+ */
+export type UpdateKojiResponse = Message<"grpc.v1.UpdateKojiResponse"> & {
+  /**
+   * @generated from field: grpc.v1.Koji prev_koji = 1;
+   */
+  prevKoji?: Koji;
+};
+
+/**
+ * Describes the message grpc.v1.UpdateKojiResponse.
+ * Use `create(UpdateKojiResponseSchema)` to create a new message.
+ */
+export const UpdateKojiResponseSchema: GenMessage<UpdateKojiResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 86);
+
+/**
+ * MultiMediaService messages
+ *
+ * @generated from message grpc.v1.GetThumbnailRequest
+ */
+export type GetThumbnailRequest = Message<"grpc.v1.GetThumbnailRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+
+  /**
+   * @generated from field: int32 size = 2;
+   */
+  size: number;
+};
+
+/**
+ * Describes the message grpc.v1.GetThumbnailRequest.
+ * Use `create(GetThumbnailRequestSchema)` to create a new message.
+ */
+export const GetThumbnailRequestSchema: GenMessage<GetThumbnailRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 87);
+
+/**
+ * @generated from message grpc.v1.GetThumbnailResponse
+ */
+export type GetThumbnailResponse = Message<"grpc.v1.GetThumbnailResponse"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int32 width = 3;
+   */
+  width: number;
+
+  /**
+   * @generated from field: int32 height = 4;
+   */
+  height: number;
+
+  /**
+   * @generated from field: string digest = 5;
+   */
+  digest: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetThumbnailResponse.
+ * Use `create(GetThumbnailResponseSchema)` to create a new message.
+ */
+export const GetThumbnailResponseSchema: GenMessage<GetThumbnailResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 88);
+
+/**
+ * @generated from message grpc.v1.GetMediaMetadataRequest
+ */
+export type GetMediaMetadataRequest = Message<"grpc.v1.GetMediaMetadataRequest"> & {
+  /**
+   * @generated from field: string pathist_folder = 1;
+   */
+  pathistFolder: string;
+};
+
+/**
+ * Describes the message grpc.v1.GetMediaMetadataRequest.
+ * Use `create(GetMediaMetadataRequestSchema)` to create a new message.
+ */
+export const GetMediaMetadataRequestSchema: GenMessage<GetMediaMetadataRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 89);
 
 /**
- * @generated from message grpc.v1.GetKojiesResponse
+ * @generated from message grpc.v1.GetMediaMetadataResponse
  */
-export type GetKojiesResponse = Message<"grpc.v1.GetKojiesResponse"> & {
+export type GetMediaMetadataResponse = Message<"grpc.v1.GetMediaMetadataResponse"> & {
   /**
-   * @generated from field: map<string, grpc.v1.Koji> kojies = 1;
+   * @generated from field: grpc.v1.MediaMetadata metadata = 1;
    */
-  kojies: { [key: string]: Koji };
+  metadata?: MediaMetadata;
 };
 
 /**
- * Describes the message grpc.v1.GetKojiesResponse.
- * Use `create(GetKojiesResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.GetMediaMetadataResponse.
+ * Use `create(GetMediaMetadataResponseSchema)` to create a new message.
  */
-export const GetKojiesResponseSchema: GenMessage<GetKojiesResponse> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 17);
+export const GetMediaMetadataResponseSchema: GenMessage<GetMediaMetadataResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 90);
 
 /**
- * @generated from message grpc.v1.GetKojiRequest
+ * @generated from message grpc.v1.GetKojiPhotoAlbumRequest
  */
-export type GetKojiRequest = Message<"grpc.v1.GetKojiRequest"> & {
+export type GetKojiPhotoAlbumRequest = Message<"grpc.v1.GetKojiPhotoAlbumRequest"> & {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string koji_id = 1;
    */
-  id: string;
+  kojiId: string;
 };
 
 /**
- * Describes the message grpc.v1.GetKojiRequest.
- * Use `create(GetKojiRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.GetKojiPhotoAlbumRequest.
+ * Use `create(GetKojiPhotoAlbumRequestSchema)` to create a new message.
  */
-export const GetKojiRequestSchema: GenMessage<GetKojiRequest> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 18);
+export const GetKojiPhotoAlbumRequestSchema: GenMessage<GetKojiPhotoAlbumRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 91);
 
 /**
- * @generated from message grpc.v1.GetKojiResponse
+ * @generated from message grpc.v1.GetKojiPhotoAlbumResponse
  */
-export type GetKojiResponse = Message<"grpc.v1.GetKojiResponse"> & {
+export type GetKojiPhotoAlbumResponse = Message<"grpc.v1.GetKojiPhotoAlbumResponse"> & {
   /**
    * @generated from field: grpc.v1.Koji koji = 1;
    */
   koji?: Koji;
+
+  /**
+   * @generated from field: repeated grpc.v1.PhotoAlbumDay days = 2;
+   */
+  days: PhotoAlbumDay[];
+
+  /**
+   * @generated from field: repeated grpc.v1.PhotoAlbumItem undated = 3;
+   */
+  undated: PhotoAlbumItem[];
+
+  /**
+   * @generated from field: int32 photo_count = 4;
+   */
+  photoCount: number;
+
+  /**
+   * @generated from field: int32 outside_count = 5;
+   */
+  outsideCount: number;
 };
 
 /**
- * Describes the message grpc.v1.GetKojiResponse.
- * Use `create(GetKojiResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.GetKojiPhotoAlbumResponse.
+ * Use `create(GetKojiPhotoAlbumResponseSchema)` to create a new message.
  */
-export const GetKojiResponseSchema: GenMessage<GetKojiResponse> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 19);
+export const GetKojiPhotoAlbumResponseSchema: GenMessage<GetKojiPhotoAlbumResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 92);
 
 /**
- * @generated from message grpc.v1.UpdateKojiRequest
+ * ChangeService messages
+ *
+ * @generated from message grpc.v1.GetChangesRequest
  */
-export type UpdateKojiRequest = Message<"grpc.v1.UpdateKojiRequest"> & {
+export type GetChangesRequest = Message<"grpc.v1.GetChangesRequest"> & {
   /**
-   * @generated from field: grpc.v1.Koji new_koji = 1;
+   * @generated from field: string since_cursor = 1;
    */
-  newKoji?: Koji;
+  sinceCursor: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message grpc.v1.UpdateKojiRequest.
- * Use `create(UpdateKojiRequestSchema)` to create a new message.
+ * Describes the message grpc.v1.GetChangesRequest.
+ * Use `create(GetChangesRequestSchema)` to create a new message.
  */
-export const UpdateKojiRequestSchema: GenMessage<UpdateKojiRequest> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 20);
+export const GetChangesRequestSchema: GenMessage<GetChangesRequest> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 93);
 
 /**
- * @generated from message grpc.v1.UpdateKojiResponse
+ * @generated from message grpc.v1.GetChangesResponse
  */
-export type UpdateKojiResponse = Message<"grpc.v1.UpdateKojiResponse"> & {
+export type GetChangesResponse = Message<"grpc.v1.GetChangesResponse"> & {
   /**
-   * @generated from field: grpc.v1.Koji prev_koji = 1;
+   * @generated from field: repeated grpc.v1.ChangeEntry changes = 1;
    */
-  prevKoji?: Koji;
+  changes: ChangeEntry[];
+
+  /**
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * @generated from field: bool reset_required = 3;
+   */
+  resetRequired: boolean;
 };
 
 /**
- * Describes the message grpc.v1.UpdateKojiResponse.
- * Use `create(UpdateKojiResponseSchema)` to create a new message.
+ * Describes the message grpc.v1.GetChangesResponse.
+ * Use `create(GetChangesResponseSchema)` to create a new message.
  */
-export const UpdateKojiResponseSchema: GenMessage<UpdateKojiResponse> = /*@__PURE__*/
-  messageDesc(file_grpc_v1_toyotachikuro, 21);
+export const GetChangesResponseSchema: GenMessage<GetChangesResponse> = /*@__PURE__*/
+  messageDesc(file_grpc_v1_toyotachikuro, 94);
+
+/**
+ * OverwritePolicy specifies how to handle an existing destination
+ *
+ * @generated from enum grpc.v1.OverwritePolicy
+ */
+export enum OverwritePolicy {
+  /**
+   * @generated from enum value: OVERWRITE_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OVERWRITE_POLICY_FAIL = 1;
+   */
+  FAIL = 1,
+
+  /**
+   * @generated from enum value: OVERWRITE_POLICY_SKIP = 2;
+   */
+  SKIP = 2,
+
+  /**
+   * @generated from enum value: OVERWRITE_POLICY_OVERWRITE = 3;
+   */
+  OVERWRITE = 3,
+
+  /**
+   * @generated from enum value: OVERWRITE_POLICY_RENAME = 4;
+   */
+  RENAME = 4,
+}
+
+/**
+ * Describes the enum grpc.v1.OverwritePolicy.
+ */
+export const OverwritePolicySchema: GenEnum<OverwritePolicy> = /*@__PURE__*/
+  enumDesc(file_grpc_v1_toyotachikuro, 0);
+
+/**
+ * FileSortKey specifies the sort key of GetFiles
+ *
+ * @generated from enum grpc.v1.FileSortKey
+ */
+export enum FileSortKey {
+  /**
+   * @generated from enum value: FILE_SORT_KEY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FILE_SORT_KEY_NAME = 1;
+   */
+  NAME = 1,
+
+  /**
+   * @generated from enum value: FILE_SORT_KEY_PATH = 2;
+   */
+  PATH = 2,
+
+  /**
+   * @generated from enum value: FILE_SORT_KEY_SIZE = 3;
+   */
+  SIZE = 3,
+
+  /**
+   * @generated from enum value: FILE_SORT_KEY_MODIFIED_TIME = 4;
+   */
+  MODIFIED_TIME = 4,
+}
+
+/**
+ * Describes the enum grpc.v1.FileSortKey.
+ */
+export const FileSortKeySchema: GenEnum<FileSortKey> = /*@__PURE__*/
+  enumDesc(file_grpc_v1_toyotachikuro, 1);
+
+/**
+ * CompanySortKey specifies the sort key of GetCompanies
+ *
+ * @generated from enum grpc.v1.CompanySortKey
+ */
+export enum CompanySortKey {
+  /**
+   * @generated from enum value: COMPANY_SORT_KEY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: COMPANY_SORT_KEY_NAME = 1;
+   */
+  NAME = 1,
+
+  /**
+   * @generated from enum value: COMPANY_SORT_KEY_CATEGORY = 2;
+   */
+  CATEGORY = 2,
+
+  /**
+   * @generated from enum value: COMPANY_SORT_KEY_READING = 3;
+   */
+  READING = 3,
+}
+
+/**
+ * Describes the enum grpc.v1.CompanySortKey.
+ */
+export const CompanySortKeySchema: GenEnum<CompanySortKey> = /*@__PURE__*/
+  enumDesc(file_grpc_v1_toyotachikuro, 2);
+
+/**
+ * BatchOperationKind specifies the kind of an operation in a batch plan
+ *
+ * @generated from enum grpc.v1.BatchOperationKind
+ */
+export enum BatchOperationKind {
+  /**
+   * @generated from enum value: BATCH_OPERATION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: BATCH_OPERATION_KIND_MOVE = 1;
+   */
+  MOVE = 1,
+
+  /**
+   * @generated from enum value: BATCH_OPERATION_KIND_COPY = 2;
+   */
+  COPY = 2,
+
+  /**
+   * @generated from enum value: BATCH_OPERATION_KIND_DELETE = 3;
+   */
+  DELETE = 3,
+
+  /**
+   * @generated from enum value: BATCH_OPERATION_KIND_MKDIR = 4;
+   */
+  MKDIR = 4,
+}
+
+/**
+ * Describes the enum grpc.v1.BatchOperationKind.
+ */
+export const BatchOperationKindSchema: GenEnum<BatchOperationKind> = /*@__PURE__*/
+  enumDesc(file_grpc_v1_toyotachikuro, 3);
 
 /**
  * FileService provides operations for file management
@@ -518,6 +2890,158 @@ export const FileService: GenService<{
     input: typeof GetFilePathistFolderRequestSchema;
     output: typeof GetFilePathistFolderResponseSchema;
   },
+  /**
+   * @generated from rpc grpc.v1.FileService.CopyFiles
+   */
+  copyFiles: {
+    methodKind: "unary";
+    input: typeof CopyFilesRequestSchema;
+    output: typeof CopyFilesResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.MoveFiles
+   */
+  moveFiles: {
+    methodKind: "unary";
+    input: typeof MoveFilesRequestSchema;
+    output: typeof MoveFilesResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.DeleteFiles
+   */
+  deleteFiles: {
+    methodKind: "unary";
+    input: typeof DeleteFilesRequestSchema;
+    output: typeof DeleteFilesResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.CreateFolder
+   */
+  createFolder: {
+    methodKind: "unary";
+    input: typeof CreateFolderRequestSchema;
+    output: typeof CreateFolderResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.ListTrash
+   */
+  listTrash: {
+    methodKind: "unary";
+    input: typeof ListTrashRequestSchema;
+    output: typeof ListTrashResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.RestoreFromTrash
+   */
+  restoreFromTrash: {
+    methodKind: "unary";
+    input: typeof RestoreFromTrashRequestSchema;
+    output: typeof RestoreFromTrashResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.PurgeTrash
+   */
+  purgeTrash: {
+    methodKind: "unary";
+    input: typeof PurgeTrashRequestSchema;
+    output: typeof PurgeTrashResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.DownloadFile
+   */
+  downloadFile: {
+    methodKind: "server_streaming";
+    input: typeof DownloadFileRequestSchema;
+    output: typeof DownloadFileResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.UploadFile
+   */
+  uploadFile: {
+    methodKind: "client_streaming";
+    input: typeof UploadFileRequestSchema;
+    output: typeof UploadFileResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.FindDuplicates
+   */
+  findDuplicates: {
+    methodKind: "unary";
+    input: typeof FindDuplicatesRequestSchema;
+    output: typeof FindDuplicatesResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.SearchFiles
+   */
+  searchFiles: {
+    methodKind: "unary";
+    input: typeof SearchFilesRequestSchema;
+    output: typeof SearchFilesResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.GetWorkbookSummary
+   */
+  getWorkbookSummary: {
+    methodKind: "unary";
+    input: typeof GetWorkbookSummaryRequestSchema;
+    output: typeof GetWorkbookSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.SearchWorkbooks
+   */
+  searchWorkbooks: {
+    methodKind: "unary";
+    input: typeof SearchWorkbooksRequestSchema;
+    output: typeof SearchWorkbooksResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.ExportArchive
+   */
+  exportArchive: {
+    methodKind: "server_streaming";
+    input: typeof ExportArchiveRequestSchema;
+    output: typeof ExportArchiveResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.GetDiskUsage
+   */
+  getDiskUsage: {
+    methodKind: "unary";
+    input: typeof GetDiskUsageRequestSchema;
+    output: typeof GetDiskUsageResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.ListFileVersions
+   */
+  listFileVersions: {
+    methodKind: "unary";
+    input: typeof ListFileVersionsRequestSchema;
+    output: typeof ListFileVersionsResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.RestoreFileVersion
+   */
+  restoreFileVersion: {
+    methodKind: "unary";
+    input: typeof RestoreFileVersionRequestSchema;
+    output: typeof RestoreFileVersionResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.BatchPlan
+   */
+  batchPlan: {
+    methodKind: "unary";
+    input: typeof BatchPlanRequestSchema;
+    output: typeof BatchPlanResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.FileService.NormalizeFolderNames
+   */
+  normalizeFolderNames: {
+    methodKind: "unary";
+    input: typeof NormalizeFolderNamesRequestSchema;
+    output: typeof NormalizeFolderNamesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_grpc_v1_toyotachikuro, 0);
 
@@ -559,6 +3083,30 @@ export const CompanyService: GenService<{
     input: typeof GetCompanyCategoriesRequestSchema;
     output: typeof GetCompanyCategoriesResponseSchema;
   },
+  /**
+   * @generated from rpc grpc.v1.CompanyService.CreateCompany
+   */
+  createCompany: {
+    methodKind: "unary";
+    input: typeof CreateCompanyRequestSchema;
+    output: typeof CreateCompanyResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.CompanyService.ArchiveCompany
+   */
+  archiveCompany: {
+    methodKind: "unary";
+    input: typeof ArchiveCompanyRequestSchema;
+    output: typeof ArchiveCompanyResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.CompanyService.RestoreCompany
+   */
+  restoreCompany: {
+    methodKind: "unary";
+    input: typeof RestoreCompanyRequestSchema;
+    output: typeof RestoreCompanyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_grpc_v1_toyotachikuro, 1);
 
@@ -592,6 +3140,64 @@ export const KojiService: GenService<{
     input: typeof UpdateKojiRequestSchema;
     output: typeof UpdateKojiResponseSchema;
   },
+  /**
+   * @generated from rpc grpc.v1.KojiService.CreateKoji
+   */
+  createKoji: {
+    methodKind: "unary";
+    input: typeof CreateKojiRequestSchema;
+    output: typeof CreateKojiResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_grpc_v1_toyotachikuro, 2);
 
+/**
+ * MultiMediaService provides operations for images and other media files
+ *
+ * @generated from service grpc.v1.MultiMediaService
+ */
+export const MultiMediaService: GenService<{
+  /**
+   * @generated from rpc grpc.v1.MultiMediaService.GetThumbnail
+   */
+  getThumbnail: {
+    methodKind: "unary";
+    input: typeof GetThumbnailRequestSchema;
+    output: typeof GetThumbnailResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.MultiMediaService.GetMediaMetadata
+   */
+  getMediaMetadata: {
+    methodKind: "unary";
+    input: typeof GetMediaMetadataRequestSchema;
+    output: typeof GetMediaMetadataResponseSchema;
+  },
+  /**
+   * @generated from rpc grpc.v1.MultiMediaService.GetKojiPhotoAlbum
+   */
+  getKojiPhotoAlbum: {
+    methodKind: "unary";
+    input: typeof GetKojiPhotoAlbumRequestSchema;
+    output: typeof GetKojiPhotoAlbumResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_grpc_v1_toyotachikuro, 3);
+
+/**
+ * ChangeService provides the change journal for catching up after downtime
+ *
+ * @generated from service grpc.v1.ChangeService
+ */
+export const ChangeService: GenService<{
+  /**
+   * @generated from rpc grpc.v1.ChangeService.GetChanges
+   */
+  getChanges: {
+    methodKind: "unary";
+    input: typeof GetChangesRequestSchema;
+    output: typeof GetChangesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_grpc_v1_toyotachikuro, 4);
+
@@ -66,11 +66,14 @@ func main() {
 	// ターミナルで読みやすいように簡易フォーマットで出力する。
 	fmt.Printf("ManagedFolder: %s\n", resFileBasePath.GetPathistFolder())
 	fmt.Printf("PathistFolder: %s\n", req.GetPathistFolder())
-	fmt.Println("IsDir\tSize\tModified\tPathistFolder\tIdealPath")
+	fmt.Println("IsDir\tSize\tModified\tMimeType\tName\tPathistFolder")
 	for _, file := range resFiles.GetFiles() {
-		fmt.Printf("%d\t%s\t%s\t\n",
+		fmt.Printf("%t\t%d\t%s\t%s\t%s\t%s\n",
+			file.GetIsDir(),
 			file.GetSize(),
 			file.GetModifiedTime().AsTime().Format(time.RFC3339Nano),
+			file.GetMimeType(),
+			file.GetName(),
 			file.GetPathistFolder(),
 		)
 	}
@@ -48,11 +48,15 @@ func main() {
 	fileService := &services.FileService{}
 	companyService := &services.CompanyService{}
 	kojiService := &services.KojiService{}
+	changeService := &services.ChangeService{}
+	multiMediaService := &services.MultiMediaService{}
 
 	// サービスをサービスコレクションに追加
 	srvCollection.AddService("FileService", fileService)
 	srvCollection.AddService("CompanyService", companyService)
 	srvCollection.AddService("KojiService", kojiService)
+	srvCollection.AddService("ChangeService", changeService)
+	srvCollection.AddService("MultiMediaService", multiMediaService)
 
 	// サービスの起動
 	if err := srvCollection.StartAll(); err != nil {
@@ -71,12 +75,29 @@ func main() {
 	kojiPath, kojiConnectHandler := grpcv1connect.NewKojiServiceHandler(kojiService)
 	mux.Handle(kojiPath, kojiConnectHandler)
 
+	changePath, changeConnectHandler := grpcv1connect.NewChangeServiceHandler(changeService)
+	mux.Handle(changePath, changeConnectHandler)
+
+	multiMediaPath, multiMediaConnectHandler := grpcv1connect.NewMultiMediaServiceHandler(multiMediaService)
+	mux.Handle(multiMediaPath, multiMediaConnectHandler)
+
+	// ファイル配信用の HTTP ハンドラ（ブラウザでのプレビュー用）
+	mux.HandleFunc(services.FilesHTTPPrefix, fileService.ServeFiles)
+
+	// フォルダーをZIPにまとめてダウンロードする HTTP ハンドラ
+	mux.HandleFunc(services.ExportHTTPPath, fileService.ServeExport)
+
+	// サムネイル配信用の HTTP ハンドラ（写真フォルダーのギャラリー表示用）
+	mux.HandleFunc(services.ThumbnailsHTTPPrefix, multiMediaService.ServeThumbnails)
+
 	// gRPC ハンドラの登録
 
 	reflector := grpcreflect.NewStaticReflector(
 		grpcv1connect.FileServiceName,
 		grpcv1connect.CompanyServiceName,
 		grpcv1connect.KojiServiceName,
+		grpcv1connect.ChangeServiceName,
+		grpcv1connect.MultiMediaServiceName,
 	)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
@@ -209,8 +230,9 @@ func writePEM(path, typ string, data []byte) error {
 func cors(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version, Range, If-None-Match, If-Modified-Since, If-Range")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, OPTIONS")
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Disposition, Content-Range, Accept-Ranges, ETag, Last-Modified")
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusNoContent)
 			return
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	grpcv1 "server-grpc/gen/grpc/v1"
+	"server-grpc/gen/grpc/v1/grpcv1connect"
+)
+
+func main() {
+	var (
+		baseURL = flag.String("base-url", "http://localhost:9090", "FileService のベース URL (例: http://localhost:9090)")
+		target  = flag.String("target", "", "検査する相対パス (未指定時はルート)")
+		fix     = flag.Bool("fix", false, "表記が統一されていないフォルダー名を変更します (未指定時は検出結果のみ表示)")
+		jsonOut = flag.Bool("json", false, "JSON 形式で出力します")
+		timeout = flag.Duration("timeout", 5*time.Minute, "RPC 呼び出しのタイムアウト")
+	)
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	client := grpcv1connect.NewFileServiceClient(http.DefaultClient, *baseURL)
+
+	req := grpcv1.NormalizeFolderNamesRequest_builder{
+		PathistFolder: *target,
+		DryRun:        !*fix,
+	}.Build()
+
+	res, err := client.NormalizeFolderNames(ctx, req)
+	if err != nil {
+		log.Fatalf("NormalizeFolderNames の呼び出しに失敗しました: %v", err)
+	}
+
+	if *jsonOut {
+		data, err := json.MarshalIndent(res.GetIssues(), "", "  ")
+		if err != nil {
+			log.Fatalf("レスポンスの JSON 変換に失敗しました: %v", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	// ターミナル表示
+	fmt.Println("Status\tPath\tNormalizedName")
+	for _, issue := range res.GetIssues() {
+		status := "pending"
+		switch {
+		case issue.GetRenamed():
+			status = "renamed"
+		case issue.GetConflict():
+			status = "conflict"
+		case issue.HasError():
+			status = issue.GetError().GetCode()
+		}
+		fmt.Printf("%s\t%q\t%s\n", status, issue.GetRelativePath(), issue.GetNormalizedName())
+	}
+	fmt.Printf("Issues: %d, Renamed: %d\n", len(res.GetIssues()), res.GetRenamedCount())
+}
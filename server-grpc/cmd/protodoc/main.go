@@ -84,14 +84,14 @@ func writeFileDoc(g *protogen.GeneratedFile, file *protogen.File) {
 	}
 
 	if len(file.Services) > 0 {
-		fmt.Fprintln(g, "### Services\n")
+		fmt.Fprint(g, "### Services\n\n")
 		for _, service := range file.Services {
 			fmt.Fprintf(g, "#### %s\n\n", service.GoName)
 			if desc := commentText(service.Comments.Leading); desc != "" {
 				fmt.Fprintf(g, "%s\n\n", desc)
 			}
 			if len(service.Methods) == 0 {
-				fmt.Fprintln(g, "_RPC は定義されていません。_\n")
+				fmt.Fprint(g, "_RPC は定義されていません。_\n\n")
 				continue
 			}
 
@@ -111,14 +111,14 @@ func writeFileDoc(g *protogen.GeneratedFile, file *protogen.File) {
 	}
 
 	if len(file.Messages) > 0 {
-		fmt.Fprintln(g, "### Messages\n")
+		fmt.Fprint(g, "### Messages\n\n")
 		for _, message := range file.Messages {
 			writeMessage(g, message, 4)
 		}
 	}
 
 	if len(file.Enums) > 0 {
-		fmt.Fprintln(g, "### Enums\n")
+		fmt.Fprint(g, "### Enums\n\n")
 		for _, enum := range file.Enums {
 			writeEnum(g, enum, 4)
 		}
@@ -148,7 +148,7 @@ func writeMessage(g *protogen.GeneratedFile, message *protogen.Message, headingL
 		}
 		fmt.Fprintln(g)
 	} else {
-		fmt.Fprintln(g, "_フィールドは定義されていません。_\n")
+		fmt.Fprint(g, "_フィールドは定義されていません。_\n\n")
 	}
 
 	if len(message.Enums) > 0 {
@@ -169,7 +169,7 @@ func writeEnum(g *protogen.GeneratedFile, enum *protogen.Enum, headingLevel int)
 		fmt.Fprintf(g, "%s\n\n", desc)
 	}
 	if len(enum.Values) == 0 {
-		fmt.Fprintln(g, "_値は定義されていません。_\n")
+		fmt.Fprint(g, "_値は定義されていません。_\n\n")
 		return
 	}
 
@@ -27,6 +27,10 @@ const (
 	CompanyServiceName = "grpc.v1.CompanyService"
 	// KojiServiceName is the fully-qualified name of the KojiService service.
 	KojiServiceName = "grpc.v1.KojiService"
+	// MultiMediaServiceName is the fully-qualified name of the MultiMediaService service.
+	MultiMediaServiceName = "grpc.v1.MultiMediaService"
+	// ChangeServiceName is the fully-qualified name of the ChangeService service.
+	ChangeServiceName = "grpc.v1.ChangeService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -42,6 +46,55 @@ const (
 	// FileServiceGetFilePathistFolderProcedure is the fully-qualified name of the FileService's
 	// GetFilePathistFolder RPC.
 	FileServiceGetFilePathistFolderProcedure = "/grpc.v1.FileService/GetFilePathistFolder"
+	// FileServiceCopyFilesProcedure is the fully-qualified name of the FileService's CopyFiles RPC.
+	FileServiceCopyFilesProcedure = "/grpc.v1.FileService/CopyFiles"
+	// FileServiceMoveFilesProcedure is the fully-qualified name of the FileService's MoveFiles RPC.
+	FileServiceMoveFilesProcedure = "/grpc.v1.FileService/MoveFiles"
+	// FileServiceDeleteFilesProcedure is the fully-qualified name of the FileService's DeleteFiles RPC.
+	FileServiceDeleteFilesProcedure = "/grpc.v1.FileService/DeleteFiles"
+	// FileServiceCreateFolderProcedure is the fully-qualified name of the FileService's CreateFolder
+	// RPC.
+	FileServiceCreateFolderProcedure = "/grpc.v1.FileService/CreateFolder"
+	// FileServiceListTrashProcedure is the fully-qualified name of the FileService's ListTrash RPC.
+	FileServiceListTrashProcedure = "/grpc.v1.FileService/ListTrash"
+	// FileServiceRestoreFromTrashProcedure is the fully-qualified name of the FileService's
+	// RestoreFromTrash RPC.
+	FileServiceRestoreFromTrashProcedure = "/grpc.v1.FileService/RestoreFromTrash"
+	// FileServicePurgeTrashProcedure is the fully-qualified name of the FileService's PurgeTrash RPC.
+	FileServicePurgeTrashProcedure = "/grpc.v1.FileService/PurgeTrash"
+	// FileServiceDownloadFileProcedure is the fully-qualified name of the FileService's DownloadFile
+	// RPC.
+	FileServiceDownloadFileProcedure = "/grpc.v1.FileService/DownloadFile"
+	// FileServiceUploadFileProcedure is the fully-qualified name of the FileService's UploadFile RPC.
+	FileServiceUploadFileProcedure = "/grpc.v1.FileService/UploadFile"
+	// FileServiceFindDuplicatesProcedure is the fully-qualified name of the FileService's
+	// FindDuplicates RPC.
+	FileServiceFindDuplicatesProcedure = "/grpc.v1.FileService/FindDuplicates"
+	// FileServiceSearchFilesProcedure is the fully-qualified name of the FileService's SearchFiles RPC.
+	FileServiceSearchFilesProcedure = "/grpc.v1.FileService/SearchFiles"
+	// FileServiceGetWorkbookSummaryProcedure is the fully-qualified name of the FileService's
+	// GetWorkbookSummary RPC.
+	FileServiceGetWorkbookSummaryProcedure = "/grpc.v1.FileService/GetWorkbookSummary"
+	// FileServiceSearchWorkbooksProcedure is the fully-qualified name of the FileService's
+	// SearchWorkbooks RPC.
+	FileServiceSearchWorkbooksProcedure = "/grpc.v1.FileService/SearchWorkbooks"
+	// FileServiceExportArchiveProcedure is the fully-qualified name of the FileService's ExportArchive
+	// RPC.
+	FileServiceExportArchiveProcedure = "/grpc.v1.FileService/ExportArchive"
+	// FileServiceGetDiskUsageProcedure is the fully-qualified name of the FileService's GetDiskUsage
+	// RPC.
+	FileServiceGetDiskUsageProcedure = "/grpc.v1.FileService/GetDiskUsage"
+	// FileServiceListFileVersionsProcedure is the fully-qualified name of the FileService's
+	// ListFileVersions RPC.
+	FileServiceListFileVersionsProcedure = "/grpc.v1.FileService/ListFileVersions"
+	// FileServiceRestoreFileVersionProcedure is the fully-qualified name of the FileService's
+	// RestoreFileVersion RPC.
+	FileServiceRestoreFileVersionProcedure = "/grpc.v1.FileService/RestoreFileVersion"
+	// FileServiceBatchPlanProcedure is the fully-qualified name of the FileService's BatchPlan RPC.
+	FileServiceBatchPlanProcedure = "/grpc.v1.FileService/BatchPlan"
+	// FileServiceNormalizeFolderNamesProcedure is the fully-qualified name of the FileService's
+	// NormalizeFolderNames RPC.
+	FileServiceNormalizeFolderNamesProcedure = "/grpc.v1.FileService/NormalizeFolderNames"
 	// CompanyServiceGetCompaniesProcedure is the fully-qualified name of the CompanyService's
 	// GetCompanies RPC.
 	CompanyServiceGetCompaniesProcedure = "/grpc.v1.CompanyService/GetCompanies"
@@ -54,18 +107,60 @@ const (
 	// CompanyServiceGetCompanyCategoriesProcedure is the fully-qualified name of the CompanyService's
 	// GetCompanyCategories RPC.
 	CompanyServiceGetCompanyCategoriesProcedure = "/grpc.v1.CompanyService/GetCompanyCategories"
+	// CompanyServiceCreateCompanyProcedure is the fully-qualified name of the CompanyService's
+	// CreateCompany RPC.
+	CompanyServiceCreateCompanyProcedure = "/grpc.v1.CompanyService/CreateCompany"
+	// CompanyServiceArchiveCompanyProcedure is the fully-qualified name of the CompanyService's
+	// ArchiveCompany RPC.
+	CompanyServiceArchiveCompanyProcedure = "/grpc.v1.CompanyService/ArchiveCompany"
+	// CompanyServiceRestoreCompanyProcedure is the fully-qualified name of the CompanyService's
+	// RestoreCompany RPC.
+	CompanyServiceRestoreCompanyProcedure = "/grpc.v1.CompanyService/RestoreCompany"
 	// KojiServiceGetKojiProcedure is the fully-qualified name of the KojiService's GetKoji RPC.
 	KojiServiceGetKojiProcedure = "/grpc.v1.KojiService/GetKoji"
 	// KojiServiceGetKojiesProcedure is the fully-qualified name of the KojiService's GetKojies RPC.
 	KojiServiceGetKojiesProcedure = "/grpc.v1.KojiService/GetKojies"
 	// KojiServiceUpdateKojiProcedure is the fully-qualified name of the KojiService's UpdateKoji RPC.
 	KojiServiceUpdateKojiProcedure = "/grpc.v1.KojiService/UpdateKoji"
+	// KojiServiceCreateKojiProcedure is the fully-qualified name of the KojiService's CreateKoji RPC.
+	KojiServiceCreateKojiProcedure = "/grpc.v1.KojiService/CreateKoji"
+	// MultiMediaServiceGetThumbnailProcedure is the fully-qualified name of the MultiMediaService's
+	// GetThumbnail RPC.
+	MultiMediaServiceGetThumbnailProcedure = "/grpc.v1.MultiMediaService/GetThumbnail"
+	// MultiMediaServiceGetMediaMetadataProcedure is the fully-qualified name of the MultiMediaService's
+	// GetMediaMetadata RPC.
+	MultiMediaServiceGetMediaMetadataProcedure = "/grpc.v1.MultiMediaService/GetMediaMetadata"
+	// MultiMediaServiceGetKojiPhotoAlbumProcedure is the fully-qualified name of the
+	// MultiMediaService's GetKojiPhotoAlbum RPC.
+	MultiMediaServiceGetKojiPhotoAlbumProcedure = "/grpc.v1.MultiMediaService/GetKojiPhotoAlbum"
+	// ChangeServiceGetChangesProcedure is the fully-qualified name of the ChangeService's GetChanges
+	// RPC.
+	ChangeServiceGetChangesProcedure = "/grpc.v1.ChangeService/GetChanges"
 )
 
 // FileServiceClient is a client for the grpc.v1.FileService service.
 type FileServiceClient interface {
 	GetFiles(context.Context, *v1.GetFilesRequest) (*v1.GetFilesResponse, error)
 	GetFilePathistFolder(context.Context, *v1.GetFilePathistFolderRequest) (*v1.GetFilePathistFolderResponse, error)
+	CopyFiles(context.Context, *v1.CopyFilesRequest) (*v1.CopyFilesResponse, error)
+	MoveFiles(context.Context, *v1.MoveFilesRequest) (*v1.MoveFilesResponse, error)
+	DeleteFiles(context.Context, *v1.DeleteFilesRequest) (*v1.DeleteFilesResponse, error)
+	CreateFolder(context.Context, *v1.CreateFolderRequest) (*v1.CreateFolderResponse, error)
+	ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error)
+	RestoreFromTrash(context.Context, *v1.RestoreFromTrashRequest) (*v1.RestoreFromTrashResponse, error)
+	PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error)
+	DownloadFile(context.Context, *v1.DownloadFileRequest) (*connect.ServerStreamForClient[v1.DownloadFileResponse], error)
+	UploadFile(context.Context) (*connect.ClientStreamForClientSimple[v1.UploadFileRequest, v1.UploadFileResponse], error)
+	FindDuplicates(context.Context, *v1.FindDuplicatesRequest) (*v1.FindDuplicatesResponse, error)
+	SearchFiles(context.Context, *v1.SearchFilesRequest) (*v1.SearchFilesResponse, error)
+	GetWorkbookSummary(context.Context, *v1.GetWorkbookSummaryRequest) (*v1.GetWorkbookSummaryResponse, error)
+	SearchWorkbooks(context.Context, *v1.SearchWorkbooksRequest) (*v1.SearchWorkbooksResponse, error)
+	ExportArchive(context.Context, *v1.ExportArchiveRequest) (*connect.ServerStreamForClient[v1.ExportArchiveResponse], error)
+	GetDiskUsage(context.Context, *v1.GetDiskUsageRequest) (*v1.GetDiskUsageResponse, error)
+	ListFileVersions(context.Context, *v1.ListFileVersionsRequest) (*v1.ListFileVersionsResponse, error)
+	RestoreFileVersion(context.Context, *v1.RestoreFileVersionRequest) (*v1.RestoreFileVersionResponse, error)
+	BatchPlan(context.Context, *v1.BatchPlanRequest) (*v1.BatchPlanResponse, error)
+	NormalizeFolderNames(context.Context, *v1.NormalizeFolderNamesRequest) (*v1.NormalizeFolderNamesResponse, error)
 }
 
 // NewFileServiceClient constructs a client for the grpc.v1.FileService service. By default, it uses
@@ -91,6 +186,120 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(fileServiceMethods.ByName("GetFilePathistFolder")),
 			connect.WithClientOptions(opts...),
 		),
+		copyFiles: connect.NewClient[v1.CopyFilesRequest, v1.CopyFilesResponse](
+			httpClient,
+			baseURL+FileServiceCopyFilesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("CopyFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		moveFiles: connect.NewClient[v1.MoveFilesRequest, v1.MoveFilesResponse](
+			httpClient,
+			baseURL+FileServiceMoveFilesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("MoveFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFiles: connect.NewClient[v1.DeleteFilesRequest, v1.DeleteFilesResponse](
+			httpClient,
+			baseURL+FileServiceDeleteFilesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("DeleteFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		createFolder: connect.NewClient[v1.CreateFolderRequest, v1.CreateFolderResponse](
+			httpClient,
+			baseURL+FileServiceCreateFolderProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("CreateFolder")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrash: connect.NewClient[v1.ListTrashRequest, v1.ListTrashResponse](
+			httpClient,
+			baseURL+FileServiceListTrashProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("ListTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreFromTrash: connect.NewClient[v1.RestoreFromTrashRequest, v1.RestoreFromTrashResponse](
+			httpClient,
+			baseURL+FileServiceRestoreFromTrashProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("RestoreFromTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTrash: connect.NewClient[v1.PurgeTrashRequest, v1.PurgeTrashResponse](
+			httpClient,
+			baseURL+FileServicePurgeTrashProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("PurgeTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadFile: connect.NewClient[v1.DownloadFileRequest, v1.DownloadFileResponse](
+			httpClient,
+			baseURL+FileServiceDownloadFileProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("DownloadFile")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadFile: connect.NewClient[v1.UploadFileRequest, v1.UploadFileResponse](
+			httpClient,
+			baseURL+FileServiceUploadFileProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("UploadFile")),
+			connect.WithClientOptions(opts...),
+		),
+		findDuplicates: connect.NewClient[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse](
+			httpClient,
+			baseURL+FileServiceFindDuplicatesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("FindDuplicates")),
+			connect.WithClientOptions(opts...),
+		),
+		searchFiles: connect.NewClient[v1.SearchFilesRequest, v1.SearchFilesResponse](
+			httpClient,
+			baseURL+FileServiceSearchFilesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("SearchFiles")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkbookSummary: connect.NewClient[v1.GetWorkbookSummaryRequest, v1.GetWorkbookSummaryResponse](
+			httpClient,
+			baseURL+FileServiceGetWorkbookSummaryProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("GetWorkbookSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		searchWorkbooks: connect.NewClient[v1.SearchWorkbooksRequest, v1.SearchWorkbooksResponse](
+			httpClient,
+			baseURL+FileServiceSearchWorkbooksProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("SearchWorkbooks")),
+			connect.WithClientOptions(opts...),
+		),
+		exportArchive: connect.NewClient[v1.ExportArchiveRequest, v1.ExportArchiveResponse](
+			httpClient,
+			baseURL+FileServiceExportArchiveProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("ExportArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		getDiskUsage: connect.NewClient[v1.GetDiskUsageRequest, v1.GetDiskUsageResponse](
+			httpClient,
+			baseURL+FileServiceGetDiskUsageProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("GetDiskUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		listFileVersions: connect.NewClient[v1.ListFileVersionsRequest, v1.ListFileVersionsResponse](
+			httpClient,
+			baseURL+FileServiceListFileVersionsProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("ListFileVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreFileVersion: connect.NewClient[v1.RestoreFileVersionRequest, v1.RestoreFileVersionResponse](
+			httpClient,
+			baseURL+FileServiceRestoreFileVersionProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("RestoreFileVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		batchPlan: connect.NewClient[v1.BatchPlanRequest, v1.BatchPlanResponse](
+			httpClient,
+			baseURL+FileServiceBatchPlanProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("BatchPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		normalizeFolderNames: connect.NewClient[v1.NormalizeFolderNamesRequest, v1.NormalizeFolderNamesResponse](
+			httpClient,
+			baseURL+FileServiceNormalizeFolderNamesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("NormalizeFolderNames")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -98,6 +307,25 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 type fileServiceClient struct {
 	getFiles             *connect.Client[v1.GetFilesRequest, v1.GetFilesResponse]
 	getFilePathistFolder *connect.Client[v1.GetFilePathistFolderRequest, v1.GetFilePathistFolderResponse]
+	copyFiles            *connect.Client[v1.CopyFilesRequest, v1.CopyFilesResponse]
+	moveFiles            *connect.Client[v1.MoveFilesRequest, v1.MoveFilesResponse]
+	deleteFiles          *connect.Client[v1.DeleteFilesRequest, v1.DeleteFilesResponse]
+	createFolder         *connect.Client[v1.CreateFolderRequest, v1.CreateFolderResponse]
+	listTrash            *connect.Client[v1.ListTrashRequest, v1.ListTrashResponse]
+	restoreFromTrash     *connect.Client[v1.RestoreFromTrashRequest, v1.RestoreFromTrashResponse]
+	purgeTrash           *connect.Client[v1.PurgeTrashRequest, v1.PurgeTrashResponse]
+	downloadFile         *connect.Client[v1.DownloadFileRequest, v1.DownloadFileResponse]
+	uploadFile           *connect.Client[v1.UploadFileRequest, v1.UploadFileResponse]
+	findDuplicates       *connect.Client[v1.FindDuplicatesRequest, v1.FindDuplicatesResponse]
+	searchFiles          *connect.Client[v1.SearchFilesRequest, v1.SearchFilesResponse]
+	getWorkbookSummary   *connect.Client[v1.GetWorkbookSummaryRequest, v1.GetWorkbookSummaryResponse]
+	searchWorkbooks      *connect.Client[v1.SearchWorkbooksRequest, v1.SearchWorkbooksResponse]
+	exportArchive        *connect.Client[v1.ExportArchiveRequest, v1.ExportArchiveResponse]
+	getDiskUsage         *connect.Client[v1.GetDiskUsageRequest, v1.GetDiskUsageResponse]
+	listFileVersions     *connect.Client[v1.ListFileVersionsRequest, v1.ListFileVersionsResponse]
+	restoreFileVersion   *connect.Client[v1.RestoreFileVersionRequest, v1.RestoreFileVersionResponse]
+	batchPlan            *connect.Client[v1.BatchPlanRequest, v1.BatchPlanResponse]
+	normalizeFolderNames *connect.Client[v1.NormalizeFolderNamesRequest, v1.NormalizeFolderNamesResponse]
 }
 
 // GetFiles calls grpc.v1.FileService.GetFiles.
@@ -118,10 +346,188 @@ func (c *fileServiceClient) GetFilePathistFolder(ctx context.Context, req *v1.Ge
 	return nil, err
 }
 
+// CopyFiles calls grpc.v1.FileService.CopyFiles.
+func (c *fileServiceClient) CopyFiles(ctx context.Context, req *v1.CopyFilesRequest) (*v1.CopyFilesResponse, error) {
+	response, err := c.copyFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MoveFiles calls grpc.v1.FileService.MoveFiles.
+func (c *fileServiceClient) MoveFiles(ctx context.Context, req *v1.MoveFilesRequest) (*v1.MoveFilesResponse, error) {
+	response, err := c.moveFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteFiles calls grpc.v1.FileService.DeleteFiles.
+func (c *fileServiceClient) DeleteFiles(ctx context.Context, req *v1.DeleteFilesRequest) (*v1.DeleteFilesResponse, error) {
+	response, err := c.deleteFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateFolder calls grpc.v1.FileService.CreateFolder.
+func (c *fileServiceClient) CreateFolder(ctx context.Context, req *v1.CreateFolderRequest) (*v1.CreateFolderResponse, error) {
+	response, err := c.createFolder.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTrash calls grpc.v1.FileService.ListTrash.
+func (c *fileServiceClient) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	response, err := c.listTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreFromTrash calls grpc.v1.FileService.RestoreFromTrash.
+func (c *fileServiceClient) RestoreFromTrash(ctx context.Context, req *v1.RestoreFromTrashRequest) (*v1.RestoreFromTrashResponse, error) {
+	response, err := c.restoreFromTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeTrash calls grpc.v1.FileService.PurgeTrash.
+func (c *fileServiceClient) PurgeTrash(ctx context.Context, req *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error) {
+	response, err := c.purgeTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DownloadFile calls grpc.v1.FileService.DownloadFile.
+func (c *fileServiceClient) DownloadFile(ctx context.Context, req *v1.DownloadFileRequest) (*connect.ServerStreamForClient[v1.DownloadFileResponse], error) {
+	return c.downloadFile.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// UploadFile calls grpc.v1.FileService.UploadFile.
+func (c *fileServiceClient) UploadFile(ctx context.Context) (*connect.ClientStreamForClientSimple[v1.UploadFileRequest, v1.UploadFileResponse], error) {
+	return c.uploadFile.CallClientStreamSimple(ctx)
+}
+
+// FindDuplicates calls grpc.v1.FileService.FindDuplicates.
+func (c *fileServiceClient) FindDuplicates(ctx context.Context, req *v1.FindDuplicatesRequest) (*v1.FindDuplicatesResponse, error) {
+	response, err := c.findDuplicates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchFiles calls grpc.v1.FileService.SearchFiles.
+func (c *fileServiceClient) SearchFiles(ctx context.Context, req *v1.SearchFilesRequest) (*v1.SearchFilesResponse, error) {
+	response, err := c.searchFiles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetWorkbookSummary calls grpc.v1.FileService.GetWorkbookSummary.
+func (c *fileServiceClient) GetWorkbookSummary(ctx context.Context, req *v1.GetWorkbookSummaryRequest) (*v1.GetWorkbookSummaryResponse, error) {
+	response, err := c.getWorkbookSummary.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchWorkbooks calls grpc.v1.FileService.SearchWorkbooks.
+func (c *fileServiceClient) SearchWorkbooks(ctx context.Context, req *v1.SearchWorkbooksRequest) (*v1.SearchWorkbooksResponse, error) {
+	response, err := c.searchWorkbooks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportArchive calls grpc.v1.FileService.ExportArchive.
+func (c *fileServiceClient) ExportArchive(ctx context.Context, req *v1.ExportArchiveRequest) (*connect.ServerStreamForClient[v1.ExportArchiveResponse], error) {
+	return c.exportArchive.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetDiskUsage calls grpc.v1.FileService.GetDiskUsage.
+func (c *fileServiceClient) GetDiskUsage(ctx context.Context, req *v1.GetDiskUsageRequest) (*v1.GetDiskUsageResponse, error) {
+	response, err := c.getDiskUsage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListFileVersions calls grpc.v1.FileService.ListFileVersions.
+func (c *fileServiceClient) ListFileVersions(ctx context.Context, req *v1.ListFileVersionsRequest) (*v1.ListFileVersionsResponse, error) {
+	response, err := c.listFileVersions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreFileVersion calls grpc.v1.FileService.RestoreFileVersion.
+func (c *fileServiceClient) RestoreFileVersion(ctx context.Context, req *v1.RestoreFileVersionRequest) (*v1.RestoreFileVersionResponse, error) {
+	response, err := c.restoreFileVersion.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchPlan calls grpc.v1.FileService.BatchPlan.
+func (c *fileServiceClient) BatchPlan(ctx context.Context, req *v1.BatchPlanRequest) (*v1.BatchPlanResponse, error) {
+	response, err := c.batchPlan.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// NormalizeFolderNames calls grpc.v1.FileService.NormalizeFolderNames.
+func (c *fileServiceClient) NormalizeFolderNames(ctx context.Context, req *v1.NormalizeFolderNamesRequest) (*v1.NormalizeFolderNamesResponse, error) {
+	response, err := c.normalizeFolderNames.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // FileServiceHandler is an implementation of the grpc.v1.FileService service.
 type FileServiceHandler interface {
 	GetFiles(context.Context, *v1.GetFilesRequest) (*v1.GetFilesResponse, error)
 	GetFilePathistFolder(context.Context, *v1.GetFilePathistFolderRequest) (*v1.GetFilePathistFolderResponse, error)
+	CopyFiles(context.Context, *v1.CopyFilesRequest) (*v1.CopyFilesResponse, error)
+	MoveFiles(context.Context, *v1.MoveFilesRequest) (*v1.MoveFilesResponse, error)
+	DeleteFiles(context.Context, *v1.DeleteFilesRequest) (*v1.DeleteFilesResponse, error)
+	CreateFolder(context.Context, *v1.CreateFolderRequest) (*v1.CreateFolderResponse, error)
+	ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error)
+	RestoreFromTrash(context.Context, *v1.RestoreFromTrashRequest) (*v1.RestoreFromTrashResponse, error)
+	PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error)
+	DownloadFile(context.Context, *v1.DownloadFileRequest, *connect.ServerStream[v1.DownloadFileResponse]) error
+	UploadFile(context.Context, *connect.ClientStream[v1.UploadFileRequest]) (*v1.UploadFileResponse, error)
+	FindDuplicates(context.Context, *v1.FindDuplicatesRequest) (*v1.FindDuplicatesResponse, error)
+	SearchFiles(context.Context, *v1.SearchFilesRequest) (*v1.SearchFilesResponse, error)
+	GetWorkbookSummary(context.Context, *v1.GetWorkbookSummaryRequest) (*v1.GetWorkbookSummaryResponse, error)
+	SearchWorkbooks(context.Context, *v1.SearchWorkbooksRequest) (*v1.SearchWorkbooksResponse, error)
+	ExportArchive(context.Context, *v1.ExportArchiveRequest, *connect.ServerStream[v1.ExportArchiveResponse]) error
+	GetDiskUsage(context.Context, *v1.GetDiskUsageRequest) (*v1.GetDiskUsageResponse, error)
+	ListFileVersions(context.Context, *v1.ListFileVersionsRequest) (*v1.ListFileVersionsResponse, error)
+	RestoreFileVersion(context.Context, *v1.RestoreFileVersionRequest) (*v1.RestoreFileVersionResponse, error)
+	BatchPlan(context.Context, *v1.BatchPlanRequest) (*v1.BatchPlanResponse, error)
+	NormalizeFolderNames(context.Context, *v1.NormalizeFolderNamesRequest) (*v1.NormalizeFolderNamesResponse, error)
 }
 
 // NewFileServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -143,12 +549,164 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(fileServiceMethods.ByName("GetFilePathistFolder")),
 		connect.WithHandlerOptions(opts...),
 	)
+	fileServiceCopyFilesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceCopyFilesProcedure,
+		svc.CopyFiles,
+		connect.WithSchema(fileServiceMethods.ByName("CopyFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceMoveFilesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceMoveFilesProcedure,
+		svc.MoveFiles,
+		connect.WithSchema(fileServiceMethods.ByName("MoveFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceDeleteFilesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceDeleteFilesProcedure,
+		svc.DeleteFiles,
+		connect.WithSchema(fileServiceMethods.ByName("DeleteFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceCreateFolderHandler := connect.NewUnaryHandlerSimple(
+		FileServiceCreateFolderProcedure,
+		svc.CreateFolder,
+		connect.WithSchema(fileServiceMethods.ByName("CreateFolder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceListTrashHandler := connect.NewUnaryHandlerSimple(
+		FileServiceListTrashProcedure,
+		svc.ListTrash,
+		connect.WithSchema(fileServiceMethods.ByName("ListTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceRestoreFromTrashHandler := connect.NewUnaryHandlerSimple(
+		FileServiceRestoreFromTrashProcedure,
+		svc.RestoreFromTrash,
+		connect.WithSchema(fileServiceMethods.ByName("RestoreFromTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServicePurgeTrashHandler := connect.NewUnaryHandlerSimple(
+		FileServicePurgeTrashProcedure,
+		svc.PurgeTrash,
+		connect.WithSchema(fileServiceMethods.ByName("PurgeTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceDownloadFileHandler := connect.NewServerStreamHandlerSimple(
+		FileServiceDownloadFileProcedure,
+		svc.DownloadFile,
+		connect.WithSchema(fileServiceMethods.ByName("DownloadFile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceUploadFileHandler := connect.NewClientStreamHandlerSimple(
+		FileServiceUploadFileProcedure,
+		svc.UploadFile,
+		connect.WithSchema(fileServiceMethods.ByName("UploadFile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceFindDuplicatesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceFindDuplicatesProcedure,
+		svc.FindDuplicates,
+		connect.WithSchema(fileServiceMethods.ByName("FindDuplicates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceSearchFilesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceSearchFilesProcedure,
+		svc.SearchFiles,
+		connect.WithSchema(fileServiceMethods.ByName("SearchFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceGetWorkbookSummaryHandler := connect.NewUnaryHandlerSimple(
+		FileServiceGetWorkbookSummaryProcedure,
+		svc.GetWorkbookSummary,
+		connect.WithSchema(fileServiceMethods.ByName("GetWorkbookSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceSearchWorkbooksHandler := connect.NewUnaryHandlerSimple(
+		FileServiceSearchWorkbooksProcedure,
+		svc.SearchWorkbooks,
+		connect.WithSchema(fileServiceMethods.ByName("SearchWorkbooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceExportArchiveHandler := connect.NewServerStreamHandlerSimple(
+		FileServiceExportArchiveProcedure,
+		svc.ExportArchive,
+		connect.WithSchema(fileServiceMethods.ByName("ExportArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceGetDiskUsageHandler := connect.NewUnaryHandlerSimple(
+		FileServiceGetDiskUsageProcedure,
+		svc.GetDiskUsage,
+		connect.WithSchema(fileServiceMethods.ByName("GetDiskUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceListFileVersionsHandler := connect.NewUnaryHandlerSimple(
+		FileServiceListFileVersionsProcedure,
+		svc.ListFileVersions,
+		connect.WithSchema(fileServiceMethods.ByName("ListFileVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceRestoreFileVersionHandler := connect.NewUnaryHandlerSimple(
+		FileServiceRestoreFileVersionProcedure,
+		svc.RestoreFileVersion,
+		connect.WithSchema(fileServiceMethods.ByName("RestoreFileVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceBatchPlanHandler := connect.NewUnaryHandlerSimple(
+		FileServiceBatchPlanProcedure,
+		svc.BatchPlan,
+		connect.WithSchema(fileServiceMethods.ByName("BatchPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceNormalizeFolderNamesHandler := connect.NewUnaryHandlerSimple(
+		FileServiceNormalizeFolderNamesProcedure,
+		svc.NormalizeFolderNames,
+		connect.WithSchema(fileServiceMethods.ByName("NormalizeFolderNames")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/grpc.v1.FileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FileServiceGetFilesProcedure:
 			fileServiceGetFilesHandler.ServeHTTP(w, r)
 		case FileServiceGetFilePathistFolderProcedure:
 			fileServiceGetFilePathistFolderHandler.ServeHTTP(w, r)
+		case FileServiceCopyFilesProcedure:
+			fileServiceCopyFilesHandler.ServeHTTP(w, r)
+		case FileServiceMoveFilesProcedure:
+			fileServiceMoveFilesHandler.ServeHTTP(w, r)
+		case FileServiceDeleteFilesProcedure:
+			fileServiceDeleteFilesHandler.ServeHTTP(w, r)
+		case FileServiceCreateFolderProcedure:
+			fileServiceCreateFolderHandler.ServeHTTP(w, r)
+		case FileServiceListTrashProcedure:
+			fileServiceListTrashHandler.ServeHTTP(w, r)
+		case FileServiceRestoreFromTrashProcedure:
+			fileServiceRestoreFromTrashHandler.ServeHTTP(w, r)
+		case FileServicePurgeTrashProcedure:
+			fileServicePurgeTrashHandler.ServeHTTP(w, r)
+		case FileServiceDownloadFileProcedure:
+			fileServiceDownloadFileHandler.ServeHTTP(w, r)
+		case FileServiceUploadFileProcedure:
+			fileServiceUploadFileHandler.ServeHTTP(w, r)
+		case FileServiceFindDuplicatesProcedure:
+			fileServiceFindDuplicatesHandler.ServeHTTP(w, r)
+		case FileServiceSearchFilesProcedure:
+			fileServiceSearchFilesHandler.ServeHTTP(w, r)
+		case FileServiceGetWorkbookSummaryProcedure:
+			fileServiceGetWorkbookSummaryHandler.ServeHTTP(w, r)
+		case FileServiceSearchWorkbooksProcedure:
+			fileServiceSearchWorkbooksHandler.ServeHTTP(w, r)
+		case FileServiceExportArchiveProcedure:
+			fileServiceExportArchiveHandler.ServeHTTP(w, r)
+		case FileServiceGetDiskUsageProcedure:
+			fileServiceGetDiskUsageHandler.ServeHTTP(w, r)
+		case FileServiceListFileVersionsProcedure:
+			fileServiceListFileVersionsHandler.ServeHTTP(w, r)
+		case FileServiceRestoreFileVersionProcedure:
+			fileServiceRestoreFileVersionHandler.ServeHTTP(w, r)
+		case FileServiceBatchPlanProcedure:
+			fileServiceBatchPlanHandler.ServeHTTP(w, r)
+		case FileServiceNormalizeFolderNamesProcedure:
+			fileServiceNormalizeFolderNamesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,12 +724,91 @@ func (UnimplementedFileServiceHandler) GetFilePathistFolder(context.Context, *v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.GetFilePathistFolder is not implemented"))
 }
 
+func (UnimplementedFileServiceHandler) CopyFiles(context.Context, *v1.CopyFilesRequest) (*v1.CopyFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.CopyFiles is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) MoveFiles(context.Context, *v1.MoveFilesRequest) (*v1.MoveFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.MoveFiles is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) DeleteFiles(context.Context, *v1.DeleteFilesRequest) (*v1.DeleteFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.DeleteFiles is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) CreateFolder(context.Context, *v1.CreateFolderRequest) (*v1.CreateFolderResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.CreateFolder is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) ListTrash(context.Context, *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.ListTrash is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) RestoreFromTrash(context.Context, *v1.RestoreFromTrashRequest) (*v1.RestoreFromTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.RestoreFromTrash is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.PurgeTrash is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) DownloadFile(context.Context, *v1.DownloadFileRequest, *connect.ServerStream[v1.DownloadFileResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.DownloadFile is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) UploadFile(context.Context, *connect.ClientStream[v1.UploadFileRequest]) (*v1.UploadFileResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.UploadFile is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) FindDuplicates(context.Context, *v1.FindDuplicatesRequest) (*v1.FindDuplicatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.FindDuplicates is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) SearchFiles(context.Context, *v1.SearchFilesRequest) (*v1.SearchFilesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.SearchFiles is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) GetWorkbookSummary(context.Context, *v1.GetWorkbookSummaryRequest) (*v1.GetWorkbookSummaryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.GetWorkbookSummary is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) SearchWorkbooks(context.Context, *v1.SearchWorkbooksRequest) (*v1.SearchWorkbooksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.SearchWorkbooks is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) ExportArchive(context.Context, *v1.ExportArchiveRequest, *connect.ServerStream[v1.ExportArchiveResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.ExportArchive is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) GetDiskUsage(context.Context, *v1.GetDiskUsageRequest) (*v1.GetDiskUsageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.GetDiskUsage is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) ListFileVersions(context.Context, *v1.ListFileVersionsRequest) (*v1.ListFileVersionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.ListFileVersions is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) RestoreFileVersion(context.Context, *v1.RestoreFileVersionRequest) (*v1.RestoreFileVersionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.RestoreFileVersion is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) BatchPlan(context.Context, *v1.BatchPlanRequest) (*v1.BatchPlanResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.BatchPlan is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) NormalizeFolderNames(context.Context, *v1.NormalizeFolderNamesRequest) (*v1.NormalizeFolderNamesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.FileService.NormalizeFolderNames is not implemented"))
+}
+
 // CompanyServiceClient is a client for the grpc.v1.CompanyService service.
 type CompanyServiceClient interface {
 	GetCompanies(context.Context, *v1.GetCompaniesRequest) (*v1.GetCompaniesResponse, error)
 	GetCompany(context.Context, *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error)
 	UpdateCompany(context.Context, *v1.UpdateCompanyRequest) (*v1.UpdateCompanyResponse, error)
 	GetCompanyCategories(context.Context, *v1.GetCompanyCategoriesRequest) (*v1.GetCompanyCategoriesResponse, error)
+	CreateCompany(context.Context, *v1.CreateCompanyRequest) (*v1.CreateCompanyResponse, error)
+	ArchiveCompany(context.Context, *v1.ArchiveCompanyRequest) (*v1.ArchiveCompanyResponse, error)
+	RestoreCompany(context.Context, *v1.RestoreCompanyRequest) (*v1.RestoreCompanyResponse, error)
 }
 
 // NewCompanyServiceClient constructs a client for the grpc.v1.CompanyService service. By default,
@@ -209,6 +846,24 @@ func NewCompanyServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(companyServiceMethods.ByName("GetCompanyCategories")),
 			connect.WithClientOptions(opts...),
 		),
+		createCompany: connect.NewClient[v1.CreateCompanyRequest, v1.CreateCompanyResponse](
+			httpClient,
+			baseURL+CompanyServiceCreateCompanyProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("CreateCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveCompany: connect.NewClient[v1.ArchiveCompanyRequest, v1.ArchiveCompanyResponse](
+			httpClient,
+			baseURL+CompanyServiceArchiveCompanyProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("ArchiveCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreCompany: connect.NewClient[v1.RestoreCompanyRequest, v1.RestoreCompanyResponse](
+			httpClient,
+			baseURL+CompanyServiceRestoreCompanyProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("RestoreCompany")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -218,6 +873,9 @@ type companyServiceClient struct {
 	getCompany           *connect.Client[v1.GetCompanyRequest, v1.GetCompanyResponse]
 	updateCompany        *connect.Client[v1.UpdateCompanyRequest, v1.UpdateCompanyResponse]
 	getCompanyCategories *connect.Client[v1.GetCompanyCategoriesRequest, v1.GetCompanyCategoriesResponse]
+	createCompany        *connect.Client[v1.CreateCompanyRequest, v1.CreateCompanyResponse]
+	archiveCompany       *connect.Client[v1.ArchiveCompanyRequest, v1.ArchiveCompanyResponse]
+	restoreCompany       *connect.Client[v1.RestoreCompanyRequest, v1.RestoreCompanyResponse]
 }
 
 // GetCompanies calls grpc.v1.CompanyService.GetCompanies.
@@ -256,12 +914,42 @@ func (c *companyServiceClient) GetCompanyCategories(ctx context.Context, req *v1
 	return nil, err
 }
 
+// CreateCompany calls grpc.v1.CompanyService.CreateCompany.
+func (c *companyServiceClient) CreateCompany(ctx context.Context, req *v1.CreateCompanyRequest) (*v1.CreateCompanyResponse, error) {
+	response, err := c.createCompany.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ArchiveCompany calls grpc.v1.CompanyService.ArchiveCompany.
+func (c *companyServiceClient) ArchiveCompany(ctx context.Context, req *v1.ArchiveCompanyRequest) (*v1.ArchiveCompanyResponse, error) {
+	response, err := c.archiveCompany.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreCompany calls grpc.v1.CompanyService.RestoreCompany.
+func (c *companyServiceClient) RestoreCompany(ctx context.Context, req *v1.RestoreCompanyRequest) (*v1.RestoreCompanyResponse, error) {
+	response, err := c.restoreCompany.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // CompanyServiceHandler is an implementation of the grpc.v1.CompanyService service.
 type CompanyServiceHandler interface {
 	GetCompanies(context.Context, *v1.GetCompaniesRequest) (*v1.GetCompaniesResponse, error)
 	GetCompany(context.Context, *v1.GetCompanyRequest) (*v1.GetCompanyResponse, error)
 	UpdateCompany(context.Context, *v1.UpdateCompanyRequest) (*v1.UpdateCompanyResponse, error)
 	GetCompanyCategories(context.Context, *v1.GetCompanyCategoriesRequest) (*v1.GetCompanyCategoriesResponse, error)
+	CreateCompany(context.Context, *v1.CreateCompanyRequest) (*v1.CreateCompanyResponse, error)
+	ArchiveCompany(context.Context, *v1.ArchiveCompanyRequest) (*v1.ArchiveCompanyResponse, error)
+	RestoreCompany(context.Context, *v1.RestoreCompanyRequest) (*v1.RestoreCompanyResponse, error)
 }
 
 // NewCompanyServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -295,6 +983,24 @@ func NewCompanyServiceHandler(svc CompanyServiceHandler, opts ...connect.Handler
 		connect.WithSchema(companyServiceMethods.ByName("GetCompanyCategories")),
 		connect.WithHandlerOptions(opts...),
 	)
+	companyServiceCreateCompanyHandler := connect.NewUnaryHandlerSimple(
+		CompanyServiceCreateCompanyProcedure,
+		svc.CreateCompany,
+		connect.WithSchema(companyServiceMethods.ByName("CreateCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	companyServiceArchiveCompanyHandler := connect.NewUnaryHandlerSimple(
+		CompanyServiceArchiveCompanyProcedure,
+		svc.ArchiveCompany,
+		connect.WithSchema(companyServiceMethods.ByName("ArchiveCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	companyServiceRestoreCompanyHandler := connect.NewUnaryHandlerSimple(
+		CompanyServiceRestoreCompanyProcedure,
+		svc.RestoreCompany,
+		connect.WithSchema(companyServiceMethods.ByName("RestoreCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/grpc.v1.CompanyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CompanyServiceGetCompaniesProcedure:
@@ -305,6 +1011,12 @@ func NewCompanyServiceHandler(svc CompanyServiceHandler, opts ...connect.Handler
 			companyServiceUpdateCompanyHandler.ServeHTTP(w, r)
 		case CompanyServiceGetCompanyCategoriesProcedure:
 			companyServiceGetCompanyCategoriesHandler.ServeHTTP(w, r)
+		case CompanyServiceCreateCompanyProcedure:
+			companyServiceCreateCompanyHandler.ServeHTTP(w, r)
+		case CompanyServiceArchiveCompanyProcedure:
+			companyServiceArchiveCompanyHandler.ServeHTTP(w, r)
+		case CompanyServiceRestoreCompanyProcedure:
+			companyServiceRestoreCompanyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -330,11 +1042,24 @@ func (UnimplementedCompanyServiceHandler) GetCompanyCategories(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.CompanyService.GetCompanyCategories is not implemented"))
 }
 
+func (UnimplementedCompanyServiceHandler) CreateCompany(context.Context, *v1.CreateCompanyRequest) (*v1.CreateCompanyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.CompanyService.CreateCompany is not implemented"))
+}
+
+func (UnimplementedCompanyServiceHandler) ArchiveCompany(context.Context, *v1.ArchiveCompanyRequest) (*v1.ArchiveCompanyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.CompanyService.ArchiveCompany is not implemented"))
+}
+
+func (UnimplementedCompanyServiceHandler) RestoreCompany(context.Context, *v1.RestoreCompanyRequest) (*v1.RestoreCompanyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.CompanyService.RestoreCompany is not implemented"))
+}
+
 // KojiServiceClient is a client for the grpc.v1.KojiService service.
 type KojiServiceClient interface {
 	GetKoji(context.Context, *v1.GetKojiRequest) (*v1.GetKojiResponse, error)
 	GetKojies(context.Context, *v1.GetKojiesRequest) (*v1.GetKojiesResponse, error)
 	UpdateKoji(context.Context, *v1.UpdateKojiRequest) (*v1.UpdateKojiResponse, error)
+	CreateKoji(context.Context, *v1.CreateKojiRequest) (*v1.CreateKojiResponse, error)
 }
 
 // NewKojiServiceClient constructs a client for the grpc.v1.KojiService service. By default, it uses
@@ -366,6 +1091,12 @@ func NewKojiServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(kojiServiceMethods.ByName("UpdateKoji")),
 			connect.WithClientOptions(opts...),
 		),
+		createKoji: connect.NewClient[v1.CreateKojiRequest, v1.CreateKojiResponse](
+			httpClient,
+			baseURL+KojiServiceCreateKojiProcedure,
+			connect.WithSchema(kojiServiceMethods.ByName("CreateKoji")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -374,6 +1105,7 @@ type kojiServiceClient struct {
 	getKoji    *connect.Client[v1.GetKojiRequest, v1.GetKojiResponse]
 	getKojies  *connect.Client[v1.GetKojiesRequest, v1.GetKojiesResponse]
 	updateKoji *connect.Client[v1.UpdateKojiRequest, v1.UpdateKojiResponse]
+	createKoji *connect.Client[v1.CreateKojiRequest, v1.CreateKojiResponse]
 }
 
 // GetKoji calls grpc.v1.KojiService.GetKoji.
@@ -403,11 +1135,21 @@ func (c *kojiServiceClient) UpdateKoji(ctx context.Context, req *v1.UpdateKojiRe
 	return nil, err
 }
 
+// CreateKoji calls grpc.v1.KojiService.CreateKoji.
+func (c *kojiServiceClient) CreateKoji(ctx context.Context, req *v1.CreateKojiRequest) (*v1.CreateKojiResponse, error) {
+	response, err := c.createKoji.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // KojiServiceHandler is an implementation of the grpc.v1.KojiService service.
 type KojiServiceHandler interface {
 	GetKoji(context.Context, *v1.GetKojiRequest) (*v1.GetKojiResponse, error)
 	GetKojies(context.Context, *v1.GetKojiesRequest) (*v1.GetKojiesResponse, error)
 	UpdateKoji(context.Context, *v1.UpdateKojiRequest) (*v1.UpdateKojiResponse, error)
+	CreateKoji(context.Context, *v1.CreateKojiRequest) (*v1.CreateKojiResponse, error)
 }
 
 // NewKojiServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -435,6 +1177,12 @@ func NewKojiServiceHandler(svc KojiServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(kojiServiceMethods.ByName("UpdateKoji")),
 		connect.WithHandlerOptions(opts...),
 	)
+	kojiServiceCreateKojiHandler := connect.NewUnaryHandlerSimple(
+		KojiServiceCreateKojiProcedure,
+		svc.CreateKoji,
+		connect.WithSchema(kojiServiceMethods.ByName("CreateKoji")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/grpc.v1.KojiService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KojiServiceGetKojiProcedure:
@@ -443,6 +1191,8 @@ func NewKojiServiceHandler(svc KojiServiceHandler, opts ...connect.HandlerOption
 			kojiServiceGetKojiesHandler.ServeHTTP(w, r)
 		case KojiServiceUpdateKojiProcedure:
 			kojiServiceUpdateKojiHandler.ServeHTTP(w, r)
+		case KojiServiceCreateKojiProcedure:
+			kojiServiceCreateKojiHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -463,3 +1213,215 @@ func (UnimplementedKojiServiceHandler) GetKojies(context.Context, *v1.GetKojiesR
 func (UnimplementedKojiServiceHandler) UpdateKoji(context.Context, *v1.UpdateKojiRequest) (*v1.UpdateKojiResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.KojiService.UpdateKoji is not implemented"))
 }
+
+func (UnimplementedKojiServiceHandler) CreateKoji(context.Context, *v1.CreateKojiRequest) (*v1.CreateKojiResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.KojiService.CreateKoji is not implemented"))
+}
+
+// MultiMediaServiceClient is a client for the grpc.v1.MultiMediaService service.
+type MultiMediaServiceClient interface {
+	GetThumbnail(context.Context, *v1.GetThumbnailRequest) (*v1.GetThumbnailResponse, error)
+	GetMediaMetadata(context.Context, *v1.GetMediaMetadataRequest) (*v1.GetMediaMetadataResponse, error)
+	GetKojiPhotoAlbum(context.Context, *v1.GetKojiPhotoAlbumRequest) (*v1.GetKojiPhotoAlbumResponse, error)
+}
+
+// NewMultiMediaServiceClient constructs a client for the grpc.v1.MultiMediaService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMultiMediaServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MultiMediaServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	multiMediaServiceMethods := v1.File_grpc_v1_toyotachikuro_proto.Services().ByName("MultiMediaService").Methods()
+	return &multiMediaServiceClient{
+		getThumbnail: connect.NewClient[v1.GetThumbnailRequest, v1.GetThumbnailResponse](
+			httpClient,
+			baseURL+MultiMediaServiceGetThumbnailProcedure,
+			connect.WithSchema(multiMediaServiceMethods.ByName("GetThumbnail")),
+			connect.WithClientOptions(opts...),
+		),
+		getMediaMetadata: connect.NewClient[v1.GetMediaMetadataRequest, v1.GetMediaMetadataResponse](
+			httpClient,
+			baseURL+MultiMediaServiceGetMediaMetadataProcedure,
+			connect.WithSchema(multiMediaServiceMethods.ByName("GetMediaMetadata")),
+			connect.WithClientOptions(opts...),
+		),
+		getKojiPhotoAlbum: connect.NewClient[v1.GetKojiPhotoAlbumRequest, v1.GetKojiPhotoAlbumResponse](
+			httpClient,
+			baseURL+MultiMediaServiceGetKojiPhotoAlbumProcedure,
+			connect.WithSchema(multiMediaServiceMethods.ByName("GetKojiPhotoAlbum")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// multiMediaServiceClient implements MultiMediaServiceClient.
+type multiMediaServiceClient struct {
+	getThumbnail      *connect.Client[v1.GetThumbnailRequest, v1.GetThumbnailResponse]
+	getMediaMetadata  *connect.Client[v1.GetMediaMetadataRequest, v1.GetMediaMetadataResponse]
+	getKojiPhotoAlbum *connect.Client[v1.GetKojiPhotoAlbumRequest, v1.GetKojiPhotoAlbumResponse]
+}
+
+// GetThumbnail calls grpc.v1.MultiMediaService.GetThumbnail.
+func (c *multiMediaServiceClient) GetThumbnail(ctx context.Context, req *v1.GetThumbnailRequest) (*v1.GetThumbnailResponse, error) {
+	response, err := c.getThumbnail.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMediaMetadata calls grpc.v1.MultiMediaService.GetMediaMetadata.
+func (c *multiMediaServiceClient) GetMediaMetadata(ctx context.Context, req *v1.GetMediaMetadataRequest) (*v1.GetMediaMetadataResponse, error) {
+	response, err := c.getMediaMetadata.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetKojiPhotoAlbum calls grpc.v1.MultiMediaService.GetKojiPhotoAlbum.
+func (c *multiMediaServiceClient) GetKojiPhotoAlbum(ctx context.Context, req *v1.GetKojiPhotoAlbumRequest) (*v1.GetKojiPhotoAlbumResponse, error) {
+	response, err := c.getKojiPhotoAlbum.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MultiMediaServiceHandler is an implementation of the grpc.v1.MultiMediaService service.
+type MultiMediaServiceHandler interface {
+	GetThumbnail(context.Context, *v1.GetThumbnailRequest) (*v1.GetThumbnailResponse, error)
+	GetMediaMetadata(context.Context, *v1.GetMediaMetadataRequest) (*v1.GetMediaMetadataResponse, error)
+	GetKojiPhotoAlbum(context.Context, *v1.GetKojiPhotoAlbumRequest) (*v1.GetKojiPhotoAlbumResponse, error)
+}
+
+// NewMultiMediaServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMultiMediaServiceHandler(svc MultiMediaServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	multiMediaServiceMethods := v1.File_grpc_v1_toyotachikuro_proto.Services().ByName("MultiMediaService").Methods()
+	multiMediaServiceGetThumbnailHandler := connect.NewUnaryHandlerSimple(
+		MultiMediaServiceGetThumbnailProcedure,
+		svc.GetThumbnail,
+		connect.WithSchema(multiMediaServiceMethods.ByName("GetThumbnail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiMediaServiceGetMediaMetadataHandler := connect.NewUnaryHandlerSimple(
+		MultiMediaServiceGetMediaMetadataProcedure,
+		svc.GetMediaMetadata,
+		connect.WithSchema(multiMediaServiceMethods.ByName("GetMediaMetadata")),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiMediaServiceGetKojiPhotoAlbumHandler := connect.NewUnaryHandlerSimple(
+		MultiMediaServiceGetKojiPhotoAlbumProcedure,
+		svc.GetKojiPhotoAlbum,
+		connect.WithSchema(multiMediaServiceMethods.ByName("GetKojiPhotoAlbum")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/grpc.v1.MultiMediaService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MultiMediaServiceGetThumbnailProcedure:
+			multiMediaServiceGetThumbnailHandler.ServeHTTP(w, r)
+		case MultiMediaServiceGetMediaMetadataProcedure:
+			multiMediaServiceGetMediaMetadataHandler.ServeHTTP(w, r)
+		case MultiMediaServiceGetKojiPhotoAlbumProcedure:
+			multiMediaServiceGetKojiPhotoAlbumHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMultiMediaServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMultiMediaServiceHandler struct{}
+
+func (UnimplementedMultiMediaServiceHandler) GetThumbnail(context.Context, *v1.GetThumbnailRequest) (*v1.GetThumbnailResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.MultiMediaService.GetThumbnail is not implemented"))
+}
+
+func (UnimplementedMultiMediaServiceHandler) GetMediaMetadata(context.Context, *v1.GetMediaMetadataRequest) (*v1.GetMediaMetadataResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.MultiMediaService.GetMediaMetadata is not implemented"))
+}
+
+func (UnimplementedMultiMediaServiceHandler) GetKojiPhotoAlbum(context.Context, *v1.GetKojiPhotoAlbumRequest) (*v1.GetKojiPhotoAlbumResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.MultiMediaService.GetKojiPhotoAlbum is not implemented"))
+}
+
+// ChangeServiceClient is a client for the grpc.v1.ChangeService service.
+type ChangeServiceClient interface {
+	GetChanges(context.Context, *v1.GetChangesRequest) (*v1.GetChangesResponse, error)
+}
+
+// NewChangeServiceClient constructs a client for the grpc.v1.ChangeService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChangeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChangeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	changeServiceMethods := v1.File_grpc_v1_toyotachikuro_proto.Services().ByName("ChangeService").Methods()
+	return &changeServiceClient{
+		getChanges: connect.NewClient[v1.GetChangesRequest, v1.GetChangesResponse](
+			httpClient,
+			baseURL+ChangeServiceGetChangesProcedure,
+			connect.WithSchema(changeServiceMethods.ByName("GetChanges")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// changeServiceClient implements ChangeServiceClient.
+type changeServiceClient struct {
+	getChanges *connect.Client[v1.GetChangesRequest, v1.GetChangesResponse]
+}
+
+// GetChanges calls grpc.v1.ChangeService.GetChanges.
+func (c *changeServiceClient) GetChanges(ctx context.Context, req *v1.GetChangesRequest) (*v1.GetChangesResponse, error) {
+	response, err := c.getChanges.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ChangeServiceHandler is an implementation of the grpc.v1.ChangeService service.
+type ChangeServiceHandler interface {
+	GetChanges(context.Context, *v1.GetChangesRequest) (*v1.GetChangesResponse, error)
+}
+
+// NewChangeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChangeServiceHandler(svc ChangeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	changeServiceMethods := v1.File_grpc_v1_toyotachikuro_proto.Services().ByName("ChangeService").Methods()
+	changeServiceGetChangesHandler := connect.NewUnaryHandlerSimple(
+		ChangeServiceGetChangesProcedure,
+		svc.GetChanges,
+		connect.WithSchema(changeServiceMethods.ByName("GetChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/grpc.v1.ChangeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChangeServiceGetChangesProcedure:
+			changeServiceGetChangesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChangeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChangeServiceHandler struct{}
+
+func (UnimplementedChangeServiceHandler) GetChanges(context.Context, *v1.GetChangesRequest) (*v1.GetChangesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grpc.v1.ChangeService.GetChanges is not implemented"))
+}
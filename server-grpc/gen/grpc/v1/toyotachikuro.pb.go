@@ -22,13 +22,223 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// OverwritePolicy specifies how to handle an existing destination
+type OverwritePolicy int32
+
+const (
+	OverwritePolicy_OVERWRITE_POLICY_UNSPECIFIED OverwritePolicy = 0
+	OverwritePolicy_OVERWRITE_POLICY_FAIL        OverwritePolicy = 1
+	OverwritePolicy_OVERWRITE_POLICY_SKIP        OverwritePolicy = 2
+	OverwritePolicy_OVERWRITE_POLICY_OVERWRITE   OverwritePolicy = 3
+	OverwritePolicy_OVERWRITE_POLICY_RENAME      OverwritePolicy = 4
+)
+
+// Enum value maps for OverwritePolicy.
+var (
+	OverwritePolicy_name = map[int32]string{
+		0: "OVERWRITE_POLICY_UNSPECIFIED",
+		1: "OVERWRITE_POLICY_FAIL",
+		2: "OVERWRITE_POLICY_SKIP",
+		3: "OVERWRITE_POLICY_OVERWRITE",
+		4: "OVERWRITE_POLICY_RENAME",
+	}
+	OverwritePolicy_value = map[string]int32{
+		"OVERWRITE_POLICY_UNSPECIFIED": 0,
+		"OVERWRITE_POLICY_FAIL":        1,
+		"OVERWRITE_POLICY_SKIP":        2,
+		"OVERWRITE_POLICY_OVERWRITE":   3,
+		"OVERWRITE_POLICY_RENAME":      4,
+	}
+)
+
+func (x OverwritePolicy) Enum() *OverwritePolicy {
+	p := new(OverwritePolicy)
+	*p = x
+	return p
+}
+
+func (x OverwritePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverwritePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_v1_toyotachikuro_proto_enumTypes[0].Descriptor()
+}
+
+func (OverwritePolicy) Type() protoreflect.EnumType {
+	return &file_grpc_v1_toyotachikuro_proto_enumTypes[0]
+}
+
+func (x OverwritePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// FileSortKey specifies the sort key of GetFiles
+type FileSortKey int32
+
+const (
+	FileSortKey_FILE_SORT_KEY_UNSPECIFIED   FileSortKey = 0
+	FileSortKey_FILE_SORT_KEY_NAME          FileSortKey = 1
+	FileSortKey_FILE_SORT_KEY_PATH          FileSortKey = 2
+	FileSortKey_FILE_SORT_KEY_SIZE          FileSortKey = 3
+	FileSortKey_FILE_SORT_KEY_MODIFIED_TIME FileSortKey = 4
+)
+
+// Enum value maps for FileSortKey.
+var (
+	FileSortKey_name = map[int32]string{
+		0: "FILE_SORT_KEY_UNSPECIFIED",
+		1: "FILE_SORT_KEY_NAME",
+		2: "FILE_SORT_KEY_PATH",
+		3: "FILE_SORT_KEY_SIZE",
+		4: "FILE_SORT_KEY_MODIFIED_TIME",
+	}
+	FileSortKey_value = map[string]int32{
+		"FILE_SORT_KEY_UNSPECIFIED":   0,
+		"FILE_SORT_KEY_NAME":          1,
+		"FILE_SORT_KEY_PATH":          2,
+		"FILE_SORT_KEY_SIZE":          3,
+		"FILE_SORT_KEY_MODIFIED_TIME": 4,
+	}
+)
+
+func (x FileSortKey) Enum() *FileSortKey {
+	p := new(FileSortKey)
+	*p = x
+	return p
+}
+
+func (x FileSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_v1_toyotachikuro_proto_enumTypes[1].Descriptor()
+}
+
+func (FileSortKey) Type() protoreflect.EnumType {
+	return &file_grpc_v1_toyotachikuro_proto_enumTypes[1]
+}
+
+func (x FileSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// CompanySortKey specifies the sort key of GetCompanies
+type CompanySortKey int32
+
+const (
+	CompanySortKey_COMPANY_SORT_KEY_UNSPECIFIED CompanySortKey = 0
+	CompanySortKey_COMPANY_SORT_KEY_NAME        CompanySortKey = 1
+	CompanySortKey_COMPANY_SORT_KEY_CATEGORY    CompanySortKey = 2
+	CompanySortKey_COMPANY_SORT_KEY_READING     CompanySortKey = 3
+)
+
+// Enum value maps for CompanySortKey.
+var (
+	CompanySortKey_name = map[int32]string{
+		0: "COMPANY_SORT_KEY_UNSPECIFIED",
+		1: "COMPANY_SORT_KEY_NAME",
+		2: "COMPANY_SORT_KEY_CATEGORY",
+		3: "COMPANY_SORT_KEY_READING",
+	}
+	CompanySortKey_value = map[string]int32{
+		"COMPANY_SORT_KEY_UNSPECIFIED": 0,
+		"COMPANY_SORT_KEY_NAME":        1,
+		"COMPANY_SORT_KEY_CATEGORY":    2,
+		"COMPANY_SORT_KEY_READING":     3,
+	}
+)
+
+func (x CompanySortKey) Enum() *CompanySortKey {
+	p := new(CompanySortKey)
+	*p = x
+	return p
+}
+
+func (x CompanySortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompanySortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_v1_toyotachikuro_proto_enumTypes[2].Descriptor()
+}
+
+func (CompanySortKey) Type() protoreflect.EnumType {
+	return &file_grpc_v1_toyotachikuro_proto_enumTypes[2]
+}
+
+func (x CompanySortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// BatchOperationKind specifies the kind of an operation in a batch plan
+type BatchOperationKind int32
+
+const (
+	BatchOperationKind_BATCH_OPERATION_KIND_UNSPECIFIED BatchOperationKind = 0
+	BatchOperationKind_BATCH_OPERATION_KIND_MOVE        BatchOperationKind = 1
+	BatchOperationKind_BATCH_OPERATION_KIND_COPY        BatchOperationKind = 2
+	BatchOperationKind_BATCH_OPERATION_KIND_DELETE      BatchOperationKind = 3
+	BatchOperationKind_BATCH_OPERATION_KIND_MKDIR       BatchOperationKind = 4
+)
+
+// Enum value maps for BatchOperationKind.
+var (
+	BatchOperationKind_name = map[int32]string{
+		0: "BATCH_OPERATION_KIND_UNSPECIFIED",
+		1: "BATCH_OPERATION_KIND_MOVE",
+		2: "BATCH_OPERATION_KIND_COPY",
+		3: "BATCH_OPERATION_KIND_DELETE",
+		4: "BATCH_OPERATION_KIND_MKDIR",
+	}
+	BatchOperationKind_value = map[string]int32{
+		"BATCH_OPERATION_KIND_UNSPECIFIED": 0,
+		"BATCH_OPERATION_KIND_MOVE":        1,
+		"BATCH_OPERATION_KIND_COPY":        2,
+		"BATCH_OPERATION_KIND_DELETE":      3,
+		"BATCH_OPERATION_KIND_MKDIR":       4,
+	}
+)
+
+func (x BatchOperationKind) Enum() *BatchOperationKind {
+	p := new(BatchOperationKind)
+	*p = x
+	return p
+}
+
+func (x BatchOperationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchOperationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_v1_toyotachikuro_proto_enumTypes[3].Descriptor()
+}
+
+func (BatchOperationKind) Type() protoreflect.EnumType {
+	return &file_grpc_v1_toyotachikuro_proto_enumTypes[3]
+}
+
+func (x BatchOperationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // File represents information about a file or directory
+// id is derived from the slash separated path relative to the FileService root, so it does not depend on where the root is
 type File struct {
 	state                    protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id            string                 `protobuf:"bytes,1,opt,name=id"`
 	xxx_hidden_PathistFolder string                 `protobuf:"bytes,2,opt,name=pathist_folder,json=pathistFolder"`
 	xxx_hidden_Size          int64                  `protobuf:"varint,3,opt,name=size"`
 	xxx_hidden_ModifiedTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=modified_time,json=modifiedTime"`
+	xxx_hidden_Name          string                 `protobuf:"bytes,5,opt,name=name"`
+	xxx_hidden_Extension     string                 `protobuf:"bytes,6,opt,name=extension"`
+	xxx_hidden_IsDir         bool                   `protobuf:"varint,7,opt,name=is_dir,json=isDir"`
+	xxx_hidden_SymlinkTarget string                 `protobuf:"bytes,8,opt,name=symlink_target,json=symlinkTarget"`
+	xxx_hidden_MimeType      string                 `protobuf:"bytes,9,opt,name=mime_type,json=mimeType"`
+	xxx_hidden_Hidden        bool                   `protobuf:"varint,10,opt,name=hidden"`
+	xxx_hidden_System        bool                   `protobuf:"varint,11,opt,name=system"`
+	xxx_hidden_ChildCount    int32                  `protobuf:"varint,12,opt,name=child_count,json=childCount"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -86,6 +296,62 @@ func (x *File) GetModifiedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *File) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *File) GetExtension() string {
+	if x != nil {
+		return x.xxx_hidden_Extension
+	}
+	return ""
+}
+
+func (x *File) GetIsDir() bool {
+	if x != nil {
+		return x.xxx_hidden_IsDir
+	}
+	return false
+}
+
+func (x *File) GetSymlinkTarget() string {
+	if x != nil {
+		return x.xxx_hidden_SymlinkTarget
+	}
+	return ""
+}
+
+func (x *File) GetMimeType() string {
+	if x != nil {
+		return x.xxx_hidden_MimeType
+	}
+	return ""
+}
+
+func (x *File) GetHidden() bool {
+	if x != nil {
+		return x.xxx_hidden_Hidden
+	}
+	return false
+}
+
+func (x *File) GetSystem() bool {
+	if x != nil {
+		return x.xxx_hidden_System
+	}
+	return false
+}
+
+func (x *File) GetChildCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_ChildCount
+	}
+	return 0
+}
+
 func (x *File) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -102,6 +368,38 @@ func (x *File) SetModifiedTime(v *timestamppb.Timestamp) {
 	x.xxx_hidden_ModifiedTime = v
 }
 
+func (x *File) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *File) SetExtension(v string) {
+	x.xxx_hidden_Extension = v
+}
+
+func (x *File) SetIsDir(v bool) {
+	x.xxx_hidden_IsDir = v
+}
+
+func (x *File) SetSymlinkTarget(v string) {
+	x.xxx_hidden_SymlinkTarget = v
+}
+
+func (x *File) SetMimeType(v string) {
+	x.xxx_hidden_MimeType = v
+}
+
+func (x *File) SetHidden(v bool) {
+	x.xxx_hidden_Hidden = v
+}
+
+func (x *File) SetSystem(v bool) {
+	x.xxx_hidden_System = v
+}
+
+func (x *File) SetChildCount(v int32) {
+	x.xxx_hidden_ChildCount = v
+}
+
 func (x *File) HasModifiedTime() bool {
 	if x == nil {
 		return false
@@ -120,6 +418,14 @@ type File_builder struct {
 	PathistFolder string
 	Size          int64
 	ModifiedTime  *timestamppb.Timestamp
+	Name          string
+	Extension     string
+	IsDir         bool
+	SymlinkTarget string
+	MimeType      string
+	Hidden        bool
+	System        bool
+	ChildCount    int32
 }
 
 func (b0 File_builder) Build() *File {
@@ -130,6 +436,14 @@ func (b0 File_builder) Build() *File {
 	x.xxx_hidden_PathistFolder = b.PathistFolder
 	x.xxx_hidden_Size = b.Size
 	x.xxx_hidden_ModifiedTime = b.ModifiedTime
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Extension = b.Extension
+	x.xxx_hidden_IsDir = b.IsDir
+	x.xxx_hidden_SymlinkTarget = b.SymlinkTarget
+	x.xxx_hidden_MimeType = b.MimeType
+	x.xxx_hidden_Hidden = b.Hidden
+	x.xxx_hidden_System = b.System
+	x.xxx_hidden_ChildCount = b.ChildCount
 	return m0
 }
 
@@ -147,6 +461,7 @@ type Company struct {
 	xxx_hidden_PersistFax        string                 `protobuf:"bytes,9,opt,name=persist_fax,json=persistFax"`
 	xxx_hidden_PersistEmail      string                 `protobuf:"bytes,10,opt,name=persist_email,json=persistEmail"`
 	xxx_hidden_PersistWebsite    string                 `protobuf:"bytes,11,opt,name=persist_website,json=persistWebsite"`
+	xxx_hidden_PersistReading    string                 `protobuf:"bytes,12,opt,name=persist_reading,json=persistReading"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -253,6 +568,13 @@ func (x *Company) GetPersistWebsite() string {
 	return ""
 }
 
+func (x *Company) GetPersistReading() string {
+	if x != nil {
+		return x.xxx_hidden_PersistReading
+	}
+	return ""
+}
+
 func (x *Company) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -297,6 +619,10 @@ func (x *Company) SetPersistWebsite(v string) {
 	x.xxx_hidden_PersistWebsite = v
 }
 
+func (x *Company) SetPersistReading(v string) {
+	x.xxx_hidden_PersistReading = v
+}
+
 type Company_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -311,6 +637,7 @@ type Company_builder struct {
 	PersistFax        string
 	PersistEmail      string
 	PersistWebsite    string
+	PersistReading    string
 }
 
 func (b0 Company_builder) Build() *Company {
@@ -328,6 +655,7 @@ func (b0 Company_builder) Build() *Company {
 	x.xxx_hidden_PersistFax = b.PersistFax
 	x.xxx_hidden_PersistEmail = b.PersistEmail
 	x.xxx_hidden_PersistWebsite = b.PersistWebsite
+	x.xxx_hidden_PersistReading = b.PersistReading
 	return m0
 }
 
@@ -567,28 +895,33 @@ func (b0 Koji_builder) Build() *Koji {
 	return m0
 }
 
-// FileService messages
-type GetFilesRequest struct {
+// ChangeEntry represents a change recorded in the change journal
+type ChangeEntry struct {
 	state                    protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Seq           uint64                 `protobuf:"varint,1,opt,name=seq"`
+	xxx_hidden_Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time"`
+	xxx_hidden_Kind          string                 `protobuf:"bytes,3,opt,name=kind"`
+	xxx_hidden_Op            string                 `protobuf:"bytes,4,opt,name=op"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,5,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_EntityId      string                 `protobuf:"bytes,6,opt,name=entity_id,json=entityId"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetFilesRequest) Reset() {
-	*x = GetFilesRequest{}
+func (x *ChangeEntry) Reset() {
+	*x = ChangeEntry{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilesRequest) String() string {
+func (x *ChangeEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilesRequest) ProtoMessage() {}
+func (*ChangeEntry) ProtoMessage() {}
 
-func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,52 +933,130 @@ func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetFilesRequest) GetPathistFolder() string {
+func (x *ChangeEntry) GetSeq() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Seq
+	}
+	return 0
+}
+
+func (x *ChangeEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_Time
+	}
+	return nil
+}
+
+func (x *ChangeEntry) GetKind() string {
+	if x != nil {
+		return x.xxx_hidden_Kind
+	}
+	return ""
+}
+
+func (x *ChangeEntry) GetOp() string {
+	if x != nil {
+		return x.xxx_hidden_Op
+	}
+	return ""
+}
+
+func (x *ChangeEntry) GetPathistFolder() string {
 	if x != nil {
 		return x.xxx_hidden_PathistFolder
 	}
 	return ""
 }
 
-func (x *GetFilesRequest) SetPathistFolder(v string) {
+func (x *ChangeEntry) GetEntityId() string {
+	if x != nil {
+		return x.xxx_hidden_EntityId
+	}
+	return ""
+}
+
+func (x *ChangeEntry) SetSeq(v uint64) {
+	x.xxx_hidden_Seq = v
+}
+
+func (x *ChangeEntry) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
+}
+
+func (x *ChangeEntry) SetKind(v string) {
+	x.xxx_hidden_Kind = v
+}
+
+func (x *ChangeEntry) SetOp(v string) {
+	x.xxx_hidden_Op = v
+}
+
+func (x *ChangeEntry) SetPathistFolder(v string) {
 	x.xxx_hidden_PathistFolder = v
 }
 
-type GetFilesRequest_builder struct {
+func (x *ChangeEntry) SetEntityId(v string) {
+	x.xxx_hidden_EntityId = v
+}
+
+func (x *ChangeEntry) HasTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Time != nil
+}
+
+func (x *ChangeEntry) ClearTime() {
+	x.xxx_hidden_Time = nil
+}
+
+type ChangeEntry_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	Seq           uint64
+	Time          *timestamppb.Timestamp
+	Kind          string
+	Op            string
 	PathistFolder string
+	EntityId      string
 }
 
-func (b0 GetFilesRequest_builder) Build() *GetFilesRequest {
-	m0 := &GetFilesRequest{}
+func (b0 ChangeEntry_builder) Build() *ChangeEntry {
+	m0 := &ChangeEntry{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_Seq = b.Seq
+	x.xxx_hidden_Time = b.Time
+	x.xxx_hidden_Kind = b.Kind
+	x.xxx_hidden_Op = b.Op
 	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_EntityId = b.EntityId
 	return m0
 }
 
-type GetFilesResponse struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Files *[]*File               `protobuf:"bytes,1,rep,name=files"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// FileTransfer represents a pair of source and destination relative paths
+type FileTransfer struct {
+	state          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Src string                 `protobuf:"bytes,1,opt,name=src"`
+	xxx_hidden_Dst string                 `protobuf:"bytes,2,opt,name=dst"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetFilesResponse) Reset() {
-	*x = GetFilesResponse{}
+func (x *FileTransfer) Reset() {
+	*x = FileTransfer{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilesResponse) String() string {
+func (x *FileTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilesResponse) ProtoMessage() {}
+func (*FileTransfer) ProtoMessage() {}
 
-func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
+func (x *FileTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,53 +1068,67 @@ func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetFilesResponse) GetFiles() []*File {
+func (x *FileTransfer) GetSrc() string {
 	if x != nil {
-		if x.xxx_hidden_Files != nil {
-			return *x.xxx_hidden_Files
-		}
+		return x.xxx_hidden_Src
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFilesResponse) SetFiles(v []*File) {
-	x.xxx_hidden_Files = &v
+func (x *FileTransfer) GetDst() string {
+	if x != nil {
+		return x.xxx_hidden_Dst
+	}
+	return ""
 }
 
-type GetFilesResponse_builder struct {
+func (x *FileTransfer) SetSrc(v string) {
+	x.xxx_hidden_Src = v
+}
+
+func (x *FileTransfer) SetDst(v string) {
+	x.xxx_hidden_Dst = v
+}
+
+type FileTransfer_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Files []*File
+	Src string
+	Dst string
 }
 
-func (b0 GetFilesResponse_builder) Build() *GetFilesResponse {
-	m0 := &GetFilesResponse{}
+func (b0 FileTransfer_builder) Build() *FileTransfer {
+	m0 := &FileTransfer{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Files = &b.Files
+	x.xxx_hidden_Src = b.Src
+	x.xxx_hidden_Dst = b.Dst
 	return m0
 }
 
-type GetFilePathistFolderRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// FileOperationError represents a structured error of a file operation
+type FileOperationError struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Code    string                 `protobuf:"bytes,1,opt,name=code"`
+	xxx_hidden_Message string                 `protobuf:"bytes,2,opt,name=message"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetFilePathistFolderRequest) Reset() {
-	*x = GetFilePathistFolderRequest{}
+func (x *FileOperationError) Reset() {
+	*x = FileOperationError{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilePathistFolderRequest) String() string {
+func (x *FileOperationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilePathistFolderRequest) ProtoMessage() {}
+func (*FileOperationError) ProtoMessage() {}
 
-func (x *GetFilePathistFolderRequest) ProtoReflect() protoreflect.Message {
+func (x *FileOperationError) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,39 +1140,70 @@ func (x *GetFilePathistFolderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type GetFilePathistFolderRequest_builder struct {
+func (x *FileOperationError) GetCode() string {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return ""
+}
+
+func (x *FileOperationError) GetMessage() string {
+	if x != nil {
+		return x.xxx_hidden_Message
+	}
+	return ""
+}
+
+func (x *FileOperationError) SetCode(v string) {
+	x.xxx_hidden_Code = v
+}
+
+func (x *FileOperationError) SetMessage(v string) {
+	x.xxx_hidden_Message = v
+}
+
+type FileOperationError_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	Code    string
+	Message string
 }
 
-func (b0 GetFilePathistFolderRequest_builder) Build() *GetFilePathistFolderRequest {
-	m0 := &GetFilePathistFolderRequest{}
+func (b0 FileOperationError_builder) Build() *FileOperationError {
+	m0 := &FileOperationError{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_Code = b.Code
+	x.xxx_hidden_Message = b.Message
 	return m0
 }
 
-type GetFilePathistFolderResponse struct {
-	state                    protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// FileOperationResult represents the result of a file operation for one item
+type FileOperationResult struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Src     string                 `protobuf:"bytes,1,opt,name=src"`
+	xxx_hidden_Dst     string                 `protobuf:"bytes,2,opt,name=dst"`
+	xxx_hidden_Ok      bool                   `protobuf:"varint,3,opt,name=ok"`
+	xxx_hidden_Skipped bool                   `protobuf:"varint,4,opt,name=skipped"`
+	xxx_hidden_Error   *FileOperationError    `protobuf:"bytes,5,opt,name=error"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetFilePathistFolderResponse) Reset() {
-	*x = GetFilePathistFolderResponse{}
+func (x *FileOperationResult) Reset() {
+	*x = FileOperationResult{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFilePathistFolderResponse) String() string {
+func (x *FileOperationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFilePathistFolderResponse) ProtoMessage() {}
+func (*FileOperationResult) ProtoMessage() {}
 
-func (x *GetFilePathistFolderResponse) ProtoReflect() protoreflect.Message {
+func (x *FileOperationResult) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,53 +1215,119 @@ func (x *GetFilePathistFolderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetFilePathistFolderResponse) GetPathistFolder() string {
+func (x *FileOperationResult) GetSrc() string {
 	if x != nil {
-		return x.xxx_hidden_PathistFolder
+		return x.xxx_hidden_Src
 	}
 	return ""
 }
 
-func (x *GetFilePathistFolderResponse) SetPathistFolder(v string) {
-	x.xxx_hidden_PathistFolder = v
+func (x *FileOperationResult) GetDst() string {
+	if x != nil {
+		return x.xxx_hidden_Dst
+	}
+	return ""
 }
 
-type GetFilePathistFolderResponse_builder struct {
+func (x *FileOperationResult) GetOk() bool {
+	if x != nil {
+		return x.xxx_hidden_Ok
+	}
+	return false
+}
+
+func (x *FileOperationResult) GetSkipped() bool {
+	if x != nil {
+		return x.xxx_hidden_Skipped
+	}
+	return false
+}
+
+func (x *FileOperationResult) GetError() *FileOperationError {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *FileOperationResult) SetSrc(v string) {
+	x.xxx_hidden_Src = v
+}
+
+func (x *FileOperationResult) SetDst(v string) {
+	x.xxx_hidden_Dst = v
+}
+
+func (x *FileOperationResult) SetOk(v bool) {
+	x.xxx_hidden_Ok = v
+}
+
+func (x *FileOperationResult) SetSkipped(v bool) {
+	x.xxx_hidden_Skipped = v
+}
+
+func (x *FileOperationResult) SetError(v *FileOperationError) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *FileOperationResult) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *FileOperationResult) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+type FileOperationResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	PathistFolder string
+	Src     string
+	Dst     string
+	Ok      bool
+	Skipped bool
+	Error   *FileOperationError
 }
 
-func (b0 GetFilePathistFolderResponse_builder) Build() *GetFilePathistFolderResponse {
-	m0 := &GetFilePathistFolderResponse{}
+func (b0 FileOperationResult_builder) Build() *FileOperationResult {
+	m0 := &FileOperationResult{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Src = b.Src
+	x.xxx_hidden_Dst = b.Dst
+	x.xxx_hidden_Ok = b.Ok
+	x.xxx_hidden_Skipped = b.Skipped
+	x.xxx_hidden_Error = b.Error
 	return m0
 }
 
-// CompanyService messages
-type GetCompaniesRequest struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Refresh bool                   `protobuf:"varint,1,opt,name=refresh"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// BatchOperation represents an operation in a batch plan
+// MOVE and COPY use src and dst, DELETE uses src and MKDIR uses dst
+type BatchOperation struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Kind BatchOperationKind     `protobuf:"varint,1,opt,name=kind,enum=grpc.v1.BatchOperationKind"`
+	xxx_hidden_Src  string                 `protobuf:"bytes,2,opt,name=src"`
+	xxx_hidden_Dst  string                 `protobuf:"bytes,3,opt,name=dst"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCompaniesRequest) Reset() {
-	*x = GetCompaniesRequest{}
+func (x *BatchOperation) Reset() {
+	*x = BatchOperation{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompaniesRequest) String() string {
+func (x *BatchOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompaniesRequest) ProtoMessage() {}
+func (*BatchOperation) ProtoMessage() {}
 
-func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchOperation) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,52 +1339,84 @@ func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCompaniesRequest) GetRefresh() bool {
+func (x *BatchOperation) GetKind() BatchOperationKind {
 	if x != nil {
-		return x.xxx_hidden_Refresh
+		return x.xxx_hidden_Kind
 	}
-	return false
+	return BatchOperationKind_BATCH_OPERATION_KIND_UNSPECIFIED
 }
 
-func (x *GetCompaniesRequest) SetRefresh(v bool) {
-	x.xxx_hidden_Refresh = v
+func (x *BatchOperation) GetSrc() string {
+	if x != nil {
+		return x.xxx_hidden_Src
+	}
+	return ""
 }
 
-type GetCompaniesRequest_builder struct {
+func (x *BatchOperation) GetDst() string {
+	if x != nil {
+		return x.xxx_hidden_Dst
+	}
+	return ""
+}
+
+func (x *BatchOperation) SetKind(v BatchOperationKind) {
+	x.xxx_hidden_Kind = v
+}
+
+func (x *BatchOperation) SetSrc(v string) {
+	x.xxx_hidden_Src = v
+}
+
+func (x *BatchOperation) SetDst(v string) {
+	x.xxx_hidden_Dst = v
+}
+
+type BatchOperation_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Refresh bool
+	Kind BatchOperationKind
+	Src  string
+	Dst  string
 }
 
-func (b0 GetCompaniesRequest_builder) Build() *GetCompaniesRequest {
-	m0 := &GetCompaniesRequest{}
+func (b0 BatchOperation_builder) Build() *BatchOperation {
+	m0 := &BatchOperation{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Refresh = b.Refresh
+	x.xxx_hidden_Kind = b.Kind
+	x.xxx_hidden_Src = b.Src
+	x.xxx_hidden_Dst = b.Dst
 	return m0
 }
 
-type GetCompaniesResponse struct {
-	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Companies map[string]*Company    `protobuf:"bytes,1,rep,name=companies" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// BatchOperationResult represents the result of an operation in a batch plan
+type BatchOperationResult struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Index      int32                  `protobuf:"varint,1,opt,name=index"`
+	xxx_hidden_Operation  *BatchOperation        `protobuf:"bytes,2,opt,name=operation"`
+	xxx_hidden_Ok         bool                   `protobuf:"varint,3,opt,name=ok"`
+	xxx_hidden_Skipped    bool                   `protobuf:"varint,4,opt,name=skipped"`
+	xxx_hidden_RolledBack bool                   `protobuf:"varint,5,opt,name=rolled_back,json=rolledBack"`
+	xxx_hidden_Error      *FileOperationError    `protobuf:"bytes,6,opt,name=error"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetCompaniesResponse) Reset() {
-	*x = GetCompaniesResponse{}
+func (x *BatchOperationResult) Reset() {
+	*x = BatchOperationResult{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompaniesResponse) String() string {
+func (x *BatchOperationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompaniesResponse) ProtoMessage() {}
+func (*BatchOperationResult) ProtoMessage() {}
 
-func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchOperationResult) ProtoReflect() protoreflect.Message {
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,53 +1428,6654 @@ func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCompaniesResponse) GetCompanies() map[string]*Company {
+func (x *BatchOperationResult) GetIndex() int32 {
 	if x != nil {
-		return x.xxx_hidden_Companies
+		return x.xxx_hidden_Index
+	}
+	return 0
+}
+
+func (x *BatchOperationResult) GetOperation() *BatchOperation {
+	if x != nil {
+		return x.xxx_hidden_Operation
 	}
 	return nil
 }
 
-func (x *GetCompaniesResponse) SetCompanies(v map[string]*Company) {
-	x.xxx_hidden_Companies = v
+func (x *BatchOperationResult) GetOk() bool {
+	if x != nil {
+		return x.xxx_hidden_Ok
+	}
+	return false
 }
 
-type GetCompaniesResponse_builder struct {
+func (x *BatchOperationResult) GetSkipped() bool {
+	if x != nil {
+		return x.xxx_hidden_Skipped
+	}
+	return false
+}
+
+func (x *BatchOperationResult) GetRolledBack() bool {
+	if x != nil {
+		return x.xxx_hidden_RolledBack
+	}
+	return false
+}
+
+func (x *BatchOperationResult) GetError() *FileOperationError {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *BatchOperationResult) SetIndex(v int32) {
+	x.xxx_hidden_Index = v
+}
+
+func (x *BatchOperationResult) SetOperation(v *BatchOperation) {
+	x.xxx_hidden_Operation = v
+}
+
+func (x *BatchOperationResult) SetOk(v bool) {
+	x.xxx_hidden_Ok = v
+}
+
+func (x *BatchOperationResult) SetSkipped(v bool) {
+	x.xxx_hidden_Skipped = v
+}
+
+func (x *BatchOperationResult) SetRolledBack(v bool) {
+	x.xxx_hidden_RolledBack = v
+}
+
+func (x *BatchOperationResult) SetError(v *FileOperationError) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *BatchOperationResult) HasOperation() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Operation != nil
+}
+
+func (x *BatchOperationResult) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *BatchOperationResult) ClearOperation() {
+	x.xxx_hidden_Operation = nil
+}
+
+func (x *BatchOperationResult) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+type BatchOperationResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Companies map[string]*Company
+	Index      int32
+	Operation  *BatchOperation
+	Ok         bool
+	Skipped    bool
+	RolledBack bool
+	Error      *FileOperationError
 }
 
-func (b0 GetCompaniesResponse_builder) Build() *GetCompaniesResponse {
-	m0 := &GetCompaniesResponse{}
+func (b0 BatchOperationResult_builder) Build() *BatchOperationResult {
+	m0 := &BatchOperationResult{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Companies = b.Companies
+	x.xxx_hidden_Index = b.Index
+	x.xxx_hidden_Operation = b.Operation
+	x.xxx_hidden_Ok = b.Ok
+	x.xxx_hidden_Skipped = b.Skipped
+	x.xxx_hidden_RolledBack = b.RolledBack
+	x.xxx_hidden_Error = b.Error
 	return m0
 }
 
-type GetCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// FolderNameIssue represents a folder whose name is not normalized (NFD, full-width digits or spaces)
+type FolderNameIssue struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RelativePath   string                 `protobuf:"bytes,1,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_Name           string                 `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_NormalizedName string                 `protobuf:"bytes,3,opt,name=normalized_name,json=normalizedName"`
+	xxx_hidden_Conflict       bool                   `protobuf:"varint,4,opt,name=conflict"`
+	xxx_hidden_Renamed        bool                   `protobuf:"varint,5,opt,name=renamed"`
+	xxx_hidden_Error          *FileOperationError    `protobuf:"bytes,6,opt,name=error"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
+func (x *FolderNameIssue) Reset() {
+	*x = FolderNameIssue{}
 	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *FolderNameIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderNameIssue) ProtoMessage() {}
+
+func (x *FolderNameIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FolderNameIssue) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *FolderNameIssue) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *FolderNameIssue) GetNormalizedName() string {
+	if x != nil {
+		return x.xxx_hidden_NormalizedName
+	}
+	return ""
+}
+
+func (x *FolderNameIssue) GetConflict() bool {
+	if x != nil {
+		return x.xxx_hidden_Conflict
+	}
+	return false
+}
+
+func (x *FolderNameIssue) GetRenamed() bool {
+	if x != nil {
+		return x.xxx_hidden_Renamed
+	}
+	return false
+}
+
+func (x *FolderNameIssue) GetError() *FileOperationError {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *FolderNameIssue) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *FolderNameIssue) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *FolderNameIssue) SetNormalizedName(v string) {
+	x.xxx_hidden_NormalizedName = v
+}
+
+func (x *FolderNameIssue) SetConflict(v bool) {
+	x.xxx_hidden_Conflict = v
+}
+
+func (x *FolderNameIssue) SetRenamed(v bool) {
+	x.xxx_hidden_Renamed = v
+}
+
+func (x *FolderNameIssue) SetError(v *FileOperationError) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *FolderNameIssue) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *FolderNameIssue) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+type FolderNameIssue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RelativePath   string
+	Name           string
+	NormalizedName string
+	Conflict       bool
+	Renamed        bool
+	Error          *FileOperationError
+}
+
+func (b0 FolderNameIssue_builder) Build() *FolderNameIssue {
+	m0 := &FolderNameIssue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_NormalizedName = b.NormalizedName
+	x.xxx_hidden_Conflict = b.Conflict
+	x.xxx_hidden_Renamed = b.Renamed
+	x.xxx_hidden_Error = b.Error
+	return m0
+}
+
+// TrashItem represents a file or directory moved into the recycle bin
+type TrashItem struct {
+	state                            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                    string                 `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_OriginalPathistFolder string                 `protobuf:"bytes,2,opt,name=original_pathist_folder,json=originalPathistFolder"`
+	xxx_hidden_DeletedBy             string                 `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy"`
+	xxx_hidden_DeletedTime           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_time,json=deletedTime"`
+	xxx_hidden_IsDir                 bool                   `protobuf:"varint,5,opt,name=is_dir,json=isDir"`
+	xxx_hidden_Size                  int64                  `protobuf:"varint,6,opt,name=size"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetOriginalPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_OriginalPathistFolder
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedBy() string {
+	if x != nil {
+		return x.xxx_hidden_DeletedBy
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DeletedTime
+	}
+	return nil
+}
+
+func (x *TrashItem) GetIsDir() bool {
+	if x != nil {
+		return x.xxx_hidden_IsDir
+	}
+	return false
+}
+
+func (x *TrashItem) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *TrashItem) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *TrashItem) SetOriginalPathistFolder(v string) {
+	x.xxx_hidden_OriginalPathistFolder = v
+}
+
+func (x *TrashItem) SetDeletedBy(v string) {
+	x.xxx_hidden_DeletedBy = v
+}
+
+func (x *TrashItem) SetDeletedTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DeletedTime = v
+}
+
+func (x *TrashItem) SetIsDir(v bool) {
+	x.xxx_hidden_IsDir = v
+}
+
+func (x *TrashItem) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+}
+
+func (x *TrashItem) HasDeletedTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeletedTime != nil
+}
+
+func (x *TrashItem) ClearDeletedTime() {
+	x.xxx_hidden_DeletedTime = nil
+}
+
+type TrashItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id                    string
+	OriginalPathistFolder string
+	DeletedBy             string
+	DeletedTime           *timestamppb.Timestamp
+	IsDir                 bool
+	Size                  int64
+}
+
+func (b0 TrashItem_builder) Build() *TrashItem {
+	m0 := &TrashItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_OriginalPathistFolder = b.OriginalPathistFolder
+	x.xxx_hidden_DeletedBy = b.DeletedBy
+	x.xxx_hidden_DeletedTime = b.DeletedTime
+	x.xxx_hidden_IsDir = b.IsDir
+	x.xxx_hidden_Size = b.Size
+	return m0
+}
+
+// FileVersion represents a previous version of a file saved before it was overwritten
+type FileVersion struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id           string                 `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_RelativePath string                 `protobuf:"bytes,2,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_Reason       string                 `protobuf:"bytes,3,opt,name=reason"`
+	xxx_hidden_SavedTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=saved_time,json=savedTime"`
+	xxx_hidden_ModifiedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=modified_time,json=modifiedTime"`
+	xxx_hidden_Size         int64                  `protobuf:"varint,6,opt,name=size"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *FileVersion) Reset() {
+	*x = FileVersion{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileVersion) ProtoMessage() {}
+
+func (x *FileVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FileVersion) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *FileVersion) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *FileVersion) GetReason() string {
+	if x != nil {
+		return x.xxx_hidden_Reason
+	}
+	return ""
+}
+
+func (x *FileVersion) GetSavedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_SavedTime
+	}
+	return nil
+}
+
+func (x *FileVersion) GetModifiedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ModifiedTime
+	}
+	return nil
+}
+
+func (x *FileVersion) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *FileVersion) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *FileVersion) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *FileVersion) SetReason(v string) {
+	x.xxx_hidden_Reason = v
+}
+
+func (x *FileVersion) SetSavedTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_SavedTime = v
+}
+
+func (x *FileVersion) SetModifiedTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ModifiedTime = v
+}
+
+func (x *FileVersion) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+}
+
+func (x *FileVersion) HasSavedTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_SavedTime != nil
+}
+
+func (x *FileVersion) HasModifiedTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ModifiedTime != nil
+}
+
+func (x *FileVersion) ClearSavedTime() {
+	x.xxx_hidden_SavedTime = nil
+}
+
+func (x *FileVersion) ClearModifiedTime() {
+	x.xxx_hidden_ModifiedTime = nil
+}
+
+type FileVersion_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id           string
+	RelativePath string
+	Reason       string
+	SavedTime    *timestamppb.Timestamp
+	ModifiedTime *timestamppb.Timestamp
+	Size         int64
+}
+
+func (b0 FileVersion_builder) Build() *FileVersion {
+	m0 := &FileVersion{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_Reason = b.Reason
+	x.xxx_hidden_SavedTime = b.SavedTime
+	x.xxx_hidden_ModifiedTime = b.ModifiedTime
+	x.xxx_hidden_Size = b.Size
+	return m0
+}
+
+// DuplicateGroup represents files with identical contents
+type DuplicateGroup struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Digest      string                 `protobuf:"bytes,1,opt,name=digest"`
+	xxx_hidden_Size        int64                  `protobuf:"varint,2,opt,name=size"`
+	xxx_hidden_Files       *[]*File               `protobuf:"bytes,3,rep,name=files"`
+	xxx_hidden_WastedBytes int64                  `protobuf:"varint,4,opt,name=wasted_bytes,json=wastedBytes"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DuplicateGroup) GetDigest() string {
+	if x != nil {
+		return x.xxx_hidden_Digest
+	}
+	return ""
+}
+
+func (x *DuplicateGroup) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *DuplicateGroup) GetFiles() []*File {
+	if x != nil {
+		if x.xxx_hidden_Files != nil {
+			return *x.xxx_hidden_Files
+		}
+	}
+	return nil
+}
+
+func (x *DuplicateGroup) GetWastedBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_WastedBytes
+	}
+	return 0
+}
+
+func (x *DuplicateGroup) SetDigest(v string) {
+	x.xxx_hidden_Digest = v
+}
+
+func (x *DuplicateGroup) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+}
+
+func (x *DuplicateGroup) SetFiles(v []*File) {
+	x.xxx_hidden_Files = &v
+}
+
+func (x *DuplicateGroup) SetWastedBytes(v int64) {
+	x.xxx_hidden_WastedBytes = v
+}
+
+type DuplicateGroup_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Digest      string
+	Size        int64
+	Files       []*File
+	WastedBytes int64
+}
+
+func (b0 DuplicateGroup_builder) Build() *DuplicateGroup {
+	m0 := &DuplicateGroup{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Digest = b.Digest
+	x.xxx_hidden_Size = b.Size
+	x.xxx_hidden_Files = &b.Files
+	x.xxx_hidden_WastedBytes = b.WastedBytes
+	return m0
+}
+
+// FileSearchHit represents a ranked result of SearchFiles
+type FileSearchHit struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_File         *File                  `protobuf:"bytes,1,opt,name=file"`
+	xxx_hidden_RelativePath string                 `protobuf:"bytes,2,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_Score        float64                `protobuf:"fixed64,3,opt,name=score"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *FileSearchHit) Reset() {
+	*x = FileSearchHit{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSearchHit) ProtoMessage() {}
+
+func (x *FileSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FileSearchHit) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *FileSearchHit) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *FileSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *FileSearchHit) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *FileSearchHit) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *FileSearchHit) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *FileSearchHit) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *FileSearchHit) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+type FileSearchHit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	File         *File
+	RelativePath string
+	Score        float64
+}
+
+func (b0 FileSearchHit_builder) Build() *FileSearchHit {
+	m0 := &FileSearchHit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_File = b.File
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_Score = b.Score
+	return m0
+}
+
+// WorkbookRow represents the values of a row in a worksheet
+type WorkbookRow struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Row    int32                  `protobuf:"varint,1,opt,name=row"`
+	xxx_hidden_Values []string               `protobuf:"bytes,2,rep,name=values"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkbookRow) Reset() {
+	*x = WorkbookRow{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkbookRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbookRow) ProtoMessage() {}
+
+func (x *WorkbookRow) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WorkbookRow) GetRow() int32 {
+	if x != nil {
+		return x.xxx_hidden_Row
+	}
+	return 0
+}
+
+func (x *WorkbookRow) GetValues() []string {
+	if x != nil {
+		return x.xxx_hidden_Values
+	}
+	return nil
+}
+
+func (x *WorkbookRow) SetRow(v int32) {
+	x.xxx_hidden_Row = v
+}
+
+func (x *WorkbookRow) SetValues(v []string) {
+	x.xxx_hidden_Values = v
+}
+
+type WorkbookRow_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Row    int32
+	Values []string
+}
+
+func (b0 WorkbookRow_builder) Build() *WorkbookRow {
+	m0 := &WorkbookRow{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Row = b.Row
+	x.xxx_hidden_Values = b.Values
+	return m0
+}
+
+// WorkbookSheetSummary represents a worksheet of an xlsx workbook
+type WorkbookSheetSummary struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        string                 `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Hidden      bool                   `protobuf:"varint,2,opt,name=hidden"`
+	xxx_hidden_RowCount    int32                  `protobuf:"varint,3,opt,name=row_count,json=rowCount"`
+	xxx_hidden_ColumnCount int32                  `protobuf:"varint,4,opt,name=column_count,json=columnCount"`
+	xxx_hidden_CellCount   int32                  `protobuf:"varint,5,opt,name=cell_count,json=cellCount"`
+	xxx_hidden_Preview     *[]*WorkbookRow        `protobuf:"bytes,6,rep,name=preview"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *WorkbookSheetSummary) Reset() {
+	*x = WorkbookSheetSummary{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkbookSheetSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbookSheetSummary) ProtoMessage() {}
+
+func (x *WorkbookSheetSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WorkbookSheetSummary) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *WorkbookSheetSummary) GetHidden() bool {
+	if x != nil {
+		return x.xxx_hidden_Hidden
+	}
+	return false
+}
+
+func (x *WorkbookSheetSummary) GetRowCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_RowCount
+	}
+	return 0
+}
+
+func (x *WorkbookSheetSummary) GetColumnCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_ColumnCount
+	}
+	return 0
+}
+
+func (x *WorkbookSheetSummary) GetCellCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_CellCount
+	}
+	return 0
+}
+
+func (x *WorkbookSheetSummary) GetPreview() []*WorkbookRow {
+	if x != nil {
+		if x.xxx_hidden_Preview != nil {
+			return *x.xxx_hidden_Preview
+		}
+	}
+	return nil
+}
+
+func (x *WorkbookSheetSummary) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *WorkbookSheetSummary) SetHidden(v bool) {
+	x.xxx_hidden_Hidden = v
+}
+
+func (x *WorkbookSheetSummary) SetRowCount(v int32) {
+	x.xxx_hidden_RowCount = v
+}
+
+func (x *WorkbookSheetSummary) SetColumnCount(v int32) {
+	x.xxx_hidden_ColumnCount = v
+}
+
+func (x *WorkbookSheetSummary) SetCellCount(v int32) {
+	x.xxx_hidden_CellCount = v
+}
+
+func (x *WorkbookSheetSummary) SetPreview(v []*WorkbookRow) {
+	x.xxx_hidden_Preview = &v
+}
+
+type WorkbookSheetSummary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name        string
+	Hidden      bool
+	RowCount    int32
+	ColumnCount int32
+	CellCount   int32
+	Preview     []*WorkbookRow
+}
+
+func (b0 WorkbookSheetSummary_builder) Build() *WorkbookSheetSummary {
+	m0 := &WorkbookSheetSummary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_Hidden = b.Hidden
+	x.xxx_hidden_RowCount = b.RowCount
+	x.xxx_hidden_ColumnCount = b.ColumnCount
+	x.xxx_hidden_CellCount = b.CellCount
+	x.xxx_hidden_Preview = &b.Preview
+	return m0
+}
+
+// WorkbookCellMatch represents a cell containing the search query
+type WorkbookCellMatch struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Sheet string                 `protobuf:"bytes,1,opt,name=sheet"`
+	xxx_hidden_Cell  string                 `protobuf:"bytes,2,opt,name=cell"`
+	xxx_hidden_Text  string                 `protobuf:"bytes,3,opt,name=text"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkbookCellMatch) Reset() {
+	*x = WorkbookCellMatch{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkbookCellMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbookCellMatch) ProtoMessage() {}
+
+func (x *WorkbookCellMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WorkbookCellMatch) GetSheet() string {
+	if x != nil {
+		return x.xxx_hidden_Sheet
+	}
+	return ""
+}
+
+func (x *WorkbookCellMatch) GetCell() string {
+	if x != nil {
+		return x.xxx_hidden_Cell
+	}
+	return ""
+}
+
+func (x *WorkbookCellMatch) GetText() string {
+	if x != nil {
+		return x.xxx_hidden_Text
+	}
+	return ""
+}
+
+func (x *WorkbookCellMatch) SetSheet(v string) {
+	x.xxx_hidden_Sheet = v
+}
+
+func (x *WorkbookCellMatch) SetCell(v string) {
+	x.xxx_hidden_Cell = v
+}
+
+func (x *WorkbookCellMatch) SetText(v string) {
+	x.xxx_hidden_Text = v
+}
+
+type WorkbookCellMatch_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Sheet string
+	Cell  string
+	Text  string
+}
+
+func (b0 WorkbookCellMatch_builder) Build() *WorkbookCellMatch {
+	m0 := &WorkbookCellMatch{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Sheet = b.Sheet
+	x.xxx_hidden_Cell = b.Cell
+	x.xxx_hidden_Text = b.Text
+	return m0
+}
+
+// WorkbookSearchHit represents a ranked result of SearchWorkbooks
+type WorkbookSearchHit struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_File         *File                  `protobuf:"bytes,1,opt,name=file"`
+	xxx_hidden_RelativePath string                 `protobuf:"bytes,2,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_Score        float64                `protobuf:"fixed64,3,opt,name=score"`
+	xxx_hidden_Matches      *[]*WorkbookCellMatch  `protobuf:"bytes,4,rep,name=matches"`
+	xxx_hidden_MatchCount   int32                  `protobuf:"varint,5,opt,name=match_count,json=matchCount"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *WorkbookSearchHit) Reset() {
+	*x = WorkbookSearchHit{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkbookSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkbookSearchHit) ProtoMessage() {}
+
+func (x *WorkbookSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WorkbookSearchHit) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *WorkbookSearchHit) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *WorkbookSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *WorkbookSearchHit) GetMatches() []*WorkbookCellMatch {
+	if x != nil {
+		if x.xxx_hidden_Matches != nil {
+			return *x.xxx_hidden_Matches
+		}
+	}
+	return nil
+}
+
+func (x *WorkbookSearchHit) GetMatchCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_MatchCount
+	}
+	return 0
+}
+
+func (x *WorkbookSearchHit) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *WorkbookSearchHit) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *WorkbookSearchHit) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+}
+
+func (x *WorkbookSearchHit) SetMatches(v []*WorkbookCellMatch) {
+	x.xxx_hidden_Matches = &v
+}
+
+func (x *WorkbookSearchHit) SetMatchCount(v int32) {
+	x.xxx_hidden_MatchCount = v
+}
+
+func (x *WorkbookSearchHit) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *WorkbookSearchHit) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+type WorkbookSearchHit_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	File         *File
+	RelativePath string
+	Score        float64
+	Matches      []*WorkbookCellMatch
+	MatchCount   int32
+}
+
+func (b0 WorkbookSearchHit_builder) Build() *WorkbookSearchHit {
+	m0 := &WorkbookSearchHit{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_File = b.File
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_Score = b.Score
+	x.xxx_hidden_Matches = &b.Matches
+	x.xxx_hidden_MatchCount = b.MatchCount
+	return m0
+}
+
+// DiskUsageEntry represents the disk usage of a file or folder directly under the target folder
+type DiskUsageEntry struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name         string                 `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_RelativePath string                 `protobuf:"bytes,2,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_IsDir        bool                   `protobuf:"varint,3,opt,name=is_dir,json=isDir"`
+	xxx_hidden_Size         int64                  `protobuf:"varint,4,opt,name=size"`
+	xxx_hidden_FileCount    int64                  `protobuf:"varint,5,opt,name=file_count,json=fileCount"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DiskUsageEntry) Reset() {
+	*x = DiskUsageEntry{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsageEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsageEntry) ProtoMessage() {}
+
+func (x *DiskUsageEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DiskUsageEntry) GetName() string {
+	if x != nil {
+		return x.xxx_hidden_Name
+	}
+	return ""
+}
+
+func (x *DiskUsageEntry) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *DiskUsageEntry) GetIsDir() bool {
+	if x != nil {
+		return x.xxx_hidden_IsDir
+	}
+	return false
+}
+
+func (x *DiskUsageEntry) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *DiskUsageEntry) GetFileCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_FileCount
+	}
+	return 0
+}
+
+func (x *DiskUsageEntry) SetName(v string) {
+	x.xxx_hidden_Name = v
+}
+
+func (x *DiskUsageEntry) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *DiskUsageEntry) SetIsDir(v bool) {
+	x.xxx_hidden_IsDir = v
+}
+
+func (x *DiskUsageEntry) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+}
+
+func (x *DiskUsageEntry) SetFileCount(v int64) {
+	x.xxx_hidden_FileCount = v
+}
+
+type DiskUsageEntry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name         string
+	RelativePath string
+	IsDir        bool
+	Size         int64
+	FileCount    int64
+}
+
+func (b0 DiskUsageEntry_builder) Build() *DiskUsageEntry {
+	m0 := &DiskUsageEntry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Name = b.Name
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_IsDir = b.IsDir
+	x.xxx_hidden_Size = b.Size
+	x.xxx_hidden_FileCount = b.FileCount
+	return m0
+}
+
+// MediaMetadata represents the metadata of an image file including EXIF
+type MediaMetadata struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_File        *File                  `protobuf:"bytes,1,opt,name=file"`
+	xxx_hidden_Width       int32                  `protobuf:"varint,2,opt,name=width"`
+	xxx_hidden_Height      int32                  `protobuf:"varint,3,opt,name=height"`
+	xxx_hidden_Orientation int32                  `protobuf:"varint,4,opt,name=orientation"`
+	xxx_hidden_CaptureTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=capture_time,json=captureTime"`
+	xxx_hidden_CameraMake  string                 `protobuf:"bytes,6,opt,name=camera_make,json=cameraMake"`
+	xxx_hidden_CameraModel string                 `protobuf:"bytes,7,opt,name=camera_model,json=cameraModel"`
+	xxx_hidden_HasLocation bool                   `protobuf:"varint,8,opt,name=has_location,json=hasLocation"`
+	xxx_hidden_Latitude    float64                `protobuf:"fixed64,9,opt,name=latitude"`
+	xxx_hidden_Longitude   float64                `protobuf:"fixed64,10,opt,name=longitude"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MediaMetadata) Reset() {
+	*x = MediaMetadata{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaMetadata) ProtoMessage() {}
+
+func (x *MediaMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MediaMetadata) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *MediaMetadata) GetWidth() int32 {
+	if x != nil {
+		return x.xxx_hidden_Width
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetHeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_Height
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetOrientation() int32 {
+	if x != nil {
+		return x.xxx_hidden_Orientation
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetCaptureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CaptureTime
+	}
+	return nil
+}
+
+func (x *MediaMetadata) GetCameraMake() string {
+	if x != nil {
+		return x.xxx_hidden_CameraMake
+	}
+	return ""
+}
+
+func (x *MediaMetadata) GetCameraModel() string {
+	if x != nil {
+		return x.xxx_hidden_CameraModel
+	}
+	return ""
+}
+
+func (x *MediaMetadata) GetHasLocation() bool {
+	if x != nil {
+		return x.xxx_hidden_HasLocation
+	}
+	return false
+}
+
+func (x *MediaMetadata) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *MediaMetadata) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *MediaMetadata) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *MediaMetadata) SetWidth(v int32) {
+	x.xxx_hidden_Width = v
+}
+
+func (x *MediaMetadata) SetHeight(v int32) {
+	x.xxx_hidden_Height = v
+}
+
+func (x *MediaMetadata) SetOrientation(v int32) {
+	x.xxx_hidden_Orientation = v
+}
+
+func (x *MediaMetadata) SetCaptureTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CaptureTime = v
+}
+
+func (x *MediaMetadata) SetCameraMake(v string) {
+	x.xxx_hidden_CameraMake = v
+}
+
+func (x *MediaMetadata) SetCameraModel(v string) {
+	x.xxx_hidden_CameraModel = v
+}
+
+func (x *MediaMetadata) SetHasLocation(v bool) {
+	x.xxx_hidden_HasLocation = v
+}
+
+func (x *MediaMetadata) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+}
+
+func (x *MediaMetadata) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+}
+
+func (x *MediaMetadata) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *MediaMetadata) HasCaptureTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CaptureTime != nil
+}
+
+func (x *MediaMetadata) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+func (x *MediaMetadata) ClearCaptureTime() {
+	x.xxx_hidden_CaptureTime = nil
+}
+
+type MediaMetadata_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	File        *File
+	Width       int32
+	Height      int32
+	Orientation int32
+	CaptureTime *timestamppb.Timestamp
+	CameraMake  string
+	CameraModel string
+	HasLocation bool
+	Latitude    float64
+	Longitude   float64
+}
+
+func (b0 MediaMetadata_builder) Build() *MediaMetadata {
+	m0 := &MediaMetadata{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_File = b.File
+	x.xxx_hidden_Width = b.Width
+	x.xxx_hidden_Height = b.Height
+	x.xxx_hidden_Orientation = b.Orientation
+	x.xxx_hidden_CaptureTime = b.CaptureTime
+	x.xxx_hidden_CameraMake = b.CameraMake
+	x.xxx_hidden_CameraModel = b.CameraModel
+	x.xxx_hidden_HasLocation = b.HasLocation
+	x.xxx_hidden_Latitude = b.Latitude
+	x.xxx_hidden_Longitude = b.Longitude
+	return m0
+}
+
+// PhotoAlbumItem represents a photo in a koji photo album
+type PhotoAlbumItem struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Metadata      *MediaMetadata         `protobuf:"bytes,1,opt,name=metadata"`
+	xxx_hidden_RelativePath  string                 `protobuf:"bytes,2,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_OutsidePeriod bool                   `protobuf:"varint,3,opt,name=outside_period,json=outsidePeriod"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PhotoAlbumItem) Reset() {
+	*x = PhotoAlbumItem{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoAlbumItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoAlbumItem) ProtoMessage() {}
+
+func (x *PhotoAlbumItem) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PhotoAlbumItem) GetMetadata() *MediaMetadata {
+	if x != nil {
+		return x.xxx_hidden_Metadata
+	}
+	return nil
+}
+
+func (x *PhotoAlbumItem) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *PhotoAlbumItem) GetOutsidePeriod() bool {
+	if x != nil {
+		return x.xxx_hidden_OutsidePeriod
+	}
+	return false
+}
+
+func (x *PhotoAlbumItem) SetMetadata(v *MediaMetadata) {
+	x.xxx_hidden_Metadata = v
+}
+
+func (x *PhotoAlbumItem) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *PhotoAlbumItem) SetOutsidePeriod(v bool) {
+	x.xxx_hidden_OutsidePeriod = v
+}
+
+func (x *PhotoAlbumItem) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *PhotoAlbumItem) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+type PhotoAlbumItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Metadata      *MediaMetadata
+	RelativePath  string
+	OutsidePeriod bool
+}
+
+func (b0 PhotoAlbumItem_builder) Build() *PhotoAlbumItem {
+	m0 := &PhotoAlbumItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Metadata = b.Metadata
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_OutsidePeriod = b.OutsidePeriod
+	return m0
+}
+
+// PhotoAlbumDay represents photos taken on the same day
+type PhotoAlbumDay struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Date          string                 `protobuf:"bytes,1,opt,name=date"`
+	xxx_hidden_Photos        *[]*PhotoAlbumItem     `protobuf:"bytes,2,rep,name=photos"`
+	xxx_hidden_OutsidePeriod bool                   `protobuf:"varint,3,opt,name=outside_period,json=outsidePeriod"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PhotoAlbumDay) Reset() {
+	*x = PhotoAlbumDay{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoAlbumDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoAlbumDay) ProtoMessage() {}
+
+func (x *PhotoAlbumDay) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PhotoAlbumDay) GetDate() string {
+	if x != nil {
+		return x.xxx_hidden_Date
+	}
+	return ""
+}
+
+func (x *PhotoAlbumDay) GetPhotos() []*PhotoAlbumItem {
+	if x != nil {
+		if x.xxx_hidden_Photos != nil {
+			return *x.xxx_hidden_Photos
+		}
+	}
+	return nil
+}
+
+func (x *PhotoAlbumDay) GetOutsidePeriod() bool {
+	if x != nil {
+		return x.xxx_hidden_OutsidePeriod
+	}
+	return false
+}
+
+func (x *PhotoAlbumDay) SetDate(v string) {
+	x.xxx_hidden_Date = v
+}
+
+func (x *PhotoAlbumDay) SetPhotos(v []*PhotoAlbumItem) {
+	x.xxx_hidden_Photos = &v
+}
+
+func (x *PhotoAlbumDay) SetOutsidePeriod(v bool) {
+	x.xxx_hidden_OutsidePeriod = v
+}
+
+type PhotoAlbumDay_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Date          string
+	Photos        []*PhotoAlbumItem
+	OutsidePeriod bool
+}
+
+func (b0 PhotoAlbumDay_builder) Build() *PhotoAlbumDay {
+	m0 := &PhotoAlbumDay{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Date = b.Date
+	x.xxx_hidden_Photos = &b.Photos
+	x.xxx_hidden_OutsidePeriod = b.OutsidePeriod
+	return m0
+}
+
+// FileService messages
+// GetFilesRequest lists entries under pathist_folder
+// depth: 0 or 1 lists one level, a larger value recurses, a negative value recurses without limit
+// page_size: 0 uses the server default, page_token continues from next_page_token
+// page_token: the first page walks, filters and sorts the folder once and the server keeps that listing,
+// later pages are cut from it, so changes made after the first page are not listed and removed entries are skipped
+// page_token is only valid with the same request fields except page_size and page_token,
+// and is rejected with INVALID_ARGUMENT after the listing is dropped (10 minutes unused, or evicted by newer listings);
+// request the first page again without page_token in that case
+type GetFilesRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder  string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Depth          int32                  `protobuf:"varint,2,opt,name=depth"`
+	xxx_hidden_Globs          []string               `protobuf:"bytes,3,rep,name=globs"`
+	xxx_hidden_Extensions     []string               `protobuf:"bytes,4,rep,name=extensions"`
+	xxx_hidden_MinSize        int64                  `protobuf:"varint,5,opt,name=min_size,json=minSize"`
+	xxx_hidden_MaxSize        int64                  `protobuf:"varint,6,opt,name=max_size,json=maxSize"`
+	xxx_hidden_ModifiedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=modified_after,json=modifiedAfter"`
+	xxx_hidden_ModifiedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=modified_before,json=modifiedBefore"`
+	xxx_hidden_SortKey        FileSortKey            `protobuf:"varint,9,opt,name=sort_key,json=sortKey,enum=grpc.v1.FileSortKey"`
+	xxx_hidden_Descending     bool                   `protobuf:"varint,10,opt,name=descending"`
+	xxx_hidden_FoldersFirst   bool                   `protobuf:"varint,11,opt,name=folders_first,json=foldersFirst"`
+	xxx_hidden_PageSize       int32                  `protobuf:"varint,12,opt,name=page_size,json=pageSize"`
+	xxx_hidden_PageToken      string                 `protobuf:"bytes,13,opt,name=page_token,json=pageToken"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetFilesRequest) Reset() {
+	*x = GetFilesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesRequest) ProtoMessage() {}
+
+func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetFilesRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetDepth() int32 {
+	if x != nil {
+		return x.xxx_hidden_Depth
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetGlobs() []string {
+	if x != nil {
+		return x.xxx_hidden_Globs
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetExtensions() []string {
+	if x != nil {
+		return x.xxx_hidden_Extensions
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_MinSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetMaxSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetModifiedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ModifiedAfter
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetModifiedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ModifiedBefore
+	}
+	return nil
+}
+
+func (x *GetFilesRequest) GetSortKey() FileSortKey {
+	if x != nil {
+		return x.xxx_hidden_SortKey
+	}
+	return FileSortKey_FILE_SORT_KEY_UNSPECIFIED
+}
+
+func (x *GetFilesRequest) GetDescending() bool {
+	if x != nil {
+		return x.xxx_hidden_Descending
+	}
+	return false
+}
+
+func (x *GetFilesRequest) GetFoldersFirst() bool {
+	if x != nil {
+		return x.xxx_hidden_FoldersFirst
+	}
+	return false
+}
+
+func (x *GetFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *GetFilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *GetFilesRequest) SetDepth(v int32) {
+	x.xxx_hidden_Depth = v
+}
+
+func (x *GetFilesRequest) SetGlobs(v []string) {
+	x.xxx_hidden_Globs = v
+}
+
+func (x *GetFilesRequest) SetExtensions(v []string) {
+	x.xxx_hidden_Extensions = v
+}
+
+func (x *GetFilesRequest) SetMinSize(v int64) {
+	x.xxx_hidden_MinSize = v
+}
+
+func (x *GetFilesRequest) SetMaxSize(v int64) {
+	x.xxx_hidden_MaxSize = v
+}
+
+func (x *GetFilesRequest) SetModifiedAfter(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ModifiedAfter = v
+}
+
+func (x *GetFilesRequest) SetModifiedBefore(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ModifiedBefore = v
+}
+
+func (x *GetFilesRequest) SetSortKey(v FileSortKey) {
+	x.xxx_hidden_SortKey = v
+}
+
+func (x *GetFilesRequest) SetDescending(v bool) {
+	x.xxx_hidden_Descending = v
+}
+
+func (x *GetFilesRequest) SetFoldersFirst(v bool) {
+	x.xxx_hidden_FoldersFirst = v
+}
+
+func (x *GetFilesRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *GetFilesRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+func (x *GetFilesRequest) HasModifiedAfter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ModifiedAfter != nil
+}
+
+func (x *GetFilesRequest) HasModifiedBefore() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ModifiedBefore != nil
+}
+
+func (x *GetFilesRequest) ClearModifiedAfter() {
+	x.xxx_hidden_ModifiedAfter = nil
+}
+
+func (x *GetFilesRequest) ClearModifiedBefore() {
+	x.xxx_hidden_ModifiedBefore = nil
+}
+
+type GetFilesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder  string
+	Depth          int32
+	Globs          []string
+	Extensions     []string
+	MinSize        int64
+	MaxSize        int64
+	ModifiedAfter  *timestamppb.Timestamp
+	ModifiedBefore *timestamppb.Timestamp
+	SortKey        FileSortKey
+	Descending     bool
+	FoldersFirst   bool
+	PageSize       int32
+	PageToken      string
+}
+
+func (b0 GetFilesRequest_builder) Build() *GetFilesRequest {
+	m0 := &GetFilesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Depth = b.Depth
+	x.xxx_hidden_Globs = b.Globs
+	x.xxx_hidden_Extensions = b.Extensions
+	x.xxx_hidden_MinSize = b.MinSize
+	x.xxx_hidden_MaxSize = b.MaxSize
+	x.xxx_hidden_ModifiedAfter = b.ModifiedAfter
+	x.xxx_hidden_ModifiedBefore = b.ModifiedBefore
+	x.xxx_hidden_SortKey = b.SortKey
+	x.xxx_hidden_Descending = b.Descending
+	x.xxx_hidden_FoldersFirst = b.FoldersFirst
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	return m0
+}
+
+type GetFilesResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Files         *[]*File               `protobuf:"bytes,1,rep,name=files"`
+	xxx_hidden_NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	xxx_hidden_TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetFilesResponse) Reset() {
+	*x = GetFilesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesResponse) ProtoMessage() {}
+
+func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetFilesResponse) GetFiles() []*File {
+	if x != nil {
+		if x.xxx_hidden_Files != nil {
+			return *x.xxx_hidden_Files
+		}
+	}
+	return nil
+}
+
+func (x *GetFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *GetFilesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalCount
+	}
+	return 0
+}
+
+func (x *GetFilesResponse) SetFiles(v []*File) {
+	x.xxx_hidden_Files = &v
+}
+
+func (x *GetFilesResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+func (x *GetFilesResponse) SetTotalCount(v int32) {
+	x.xxx_hidden_TotalCount = v
+}
+
+type GetFilesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Files         []*File
+	NextPageToken string
+	TotalCount    int32
+}
+
+func (b0 GetFilesResponse_builder) Build() *GetFilesResponse {
+	m0 := &GetFilesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Files = &b.Files
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	x.xxx_hidden_TotalCount = b.TotalCount
+	return m0
+}
+
+type GetFilePathistFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilePathistFolderRequest) Reset() {
+	*x = GetFilePathistFolderRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilePathistFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilePathistFolderRequest) ProtoMessage() {}
+
+func (x *GetFilePathistFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetFilePathistFolderRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetFilePathistFolderRequest_builder) Build() *GetFilePathistFolderRequest {
+	m0 := &GetFilePathistFolderRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetFilePathistFolderResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetFilePathistFolderResponse) Reset() {
+	*x = GetFilePathistFolderResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilePathistFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilePathistFolderResponse) ProtoMessage() {}
+
+func (x *GetFilePathistFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetFilePathistFolderResponse) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetFilePathistFolderResponse) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+type GetFilePathistFolderResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+}
+
+func (b0 GetFilePathistFolderResponse_builder) Build() *GetFilePathistFolderResponse {
+	m0 := &GetFilePathistFolderResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	return m0
+}
+
+type CopyFilesRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items           *[]*FileTransfer       `protobuf:"bytes,1,rep,name=items"`
+	xxx_hidden_OverwritePolicy OverwritePolicy        `protobuf:"varint,2,opt,name=overwrite_policy,json=overwritePolicy,enum=grpc.v1.OverwritePolicy"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CopyFilesRequest) Reset() {
+	*x = CopyFilesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFilesRequest) ProtoMessage() {}
+
+func (x *CopyFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CopyFilesRequest) GetItems() []*FileTransfer {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *CopyFilesRequest) GetOverwritePolicy() OverwritePolicy {
+	if x != nil {
+		return x.xxx_hidden_OverwritePolicy
+	}
+	return OverwritePolicy_OVERWRITE_POLICY_UNSPECIFIED
+}
+
+func (x *CopyFilesRequest) SetItems(v []*FileTransfer) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *CopyFilesRequest) SetOverwritePolicy(v OverwritePolicy) {
+	x.xxx_hidden_OverwritePolicy = v
+}
+
+type CopyFilesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items           []*FileTransfer
+	OverwritePolicy OverwritePolicy
+}
+
+func (b0 CopyFilesRequest_builder) Build() *CopyFilesRequest {
+	m0 := &CopyFilesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	x.xxx_hidden_OverwritePolicy = b.OverwritePolicy
+	return m0
+}
+
+type CopyFilesResponse struct {
+	state              protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*FileOperationResult `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CopyFilesResponse) Reset() {
+	*x = CopyFilesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFilesResponse) ProtoMessage() {}
+
+func (x *CopyFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CopyFilesResponse) GetResults() []*FileOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *CopyFilesResponse) SetResults(v []*FileOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+type CopyFilesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*FileOperationResult
+}
+
+func (b0 CopyFilesResponse_builder) Build() *CopyFilesResponse {
+	m0 := &CopyFilesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	return m0
+}
+
+type MoveFilesRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items           *[]*FileTransfer       `protobuf:"bytes,1,rep,name=items"`
+	xxx_hidden_OverwritePolicy OverwritePolicy        `protobuf:"varint,2,opt,name=overwrite_policy,json=overwritePolicy,enum=grpc.v1.OverwritePolicy"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *MoveFilesRequest) Reset() {
+	*x = MoveFilesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFilesRequest) ProtoMessage() {}
+
+func (x *MoveFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MoveFilesRequest) GetItems() []*FileTransfer {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *MoveFilesRequest) GetOverwritePolicy() OverwritePolicy {
+	if x != nil {
+		return x.xxx_hidden_OverwritePolicy
+	}
+	return OverwritePolicy_OVERWRITE_POLICY_UNSPECIFIED
+}
+
+func (x *MoveFilesRequest) SetItems(v []*FileTransfer) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *MoveFilesRequest) SetOverwritePolicy(v OverwritePolicy) {
+	x.xxx_hidden_OverwritePolicy = v
+}
+
+type MoveFilesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items           []*FileTransfer
+	OverwritePolicy OverwritePolicy
+}
+
+func (b0 MoveFilesRequest_builder) Build() *MoveFilesRequest {
+	m0 := &MoveFilesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	x.xxx_hidden_OverwritePolicy = b.OverwritePolicy
+	return m0
+}
+
+type MoveFilesResponse struct {
+	state              protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*FileOperationResult `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MoveFilesResponse) Reset() {
+	*x = MoveFilesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFilesResponse) ProtoMessage() {}
+
+func (x *MoveFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MoveFilesResponse) GetResults() []*FileOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *MoveFilesResponse) SetResults(v []*FileOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+type MoveFilesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*FileOperationResult
+}
+
+func (b0 MoveFilesResponse_builder) Build() *MoveFilesResponse {
+	m0 := &MoveFilesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	return m0
+}
+
+type DeleteFilesRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolders []string               `protobuf:"bytes,1,rep,name=pathist_folders,json=pathistFolders"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *DeleteFilesRequest) Reset() {
+	*x = DeleteFilesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesRequest) ProtoMessage() {}
+
+func (x *DeleteFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteFilesRequest) GetPathistFolders() []string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolders
+	}
+	return nil
+}
+
+func (x *DeleteFilesRequest) SetPathistFolders(v []string) {
+	x.xxx_hidden_PathistFolders = v
+}
+
+type DeleteFilesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolders []string
+}
+
+func (b0 DeleteFilesRequest_builder) Build() *DeleteFilesRequest {
+	m0 := &DeleteFilesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolders = b.PathistFolders
+	return m0
+}
+
+type DeleteFilesResponse struct {
+	state              protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*FileOperationResult `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteFilesResponse) Reset() {
+	*x = DeleteFilesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesResponse) ProtoMessage() {}
+
+func (x *DeleteFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteFilesResponse) GetResults() []*FileOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *DeleteFilesResponse) SetResults(v []*FileOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+type DeleteFilesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*FileOperationResult
+}
+
+func (b0 DeleteFilesResponse_builder) Build() *DeleteFilesResponse {
+	m0 := &DeleteFilesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	return m0
+}
+
+type CreateFolderRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Parents       bool                   `protobuf:"varint,2,opt,name=parents"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateFolderRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *CreateFolderRequest) GetParents() bool {
+	if x != nil {
+		return x.xxx_hidden_Parents
+	}
+	return false
+}
+
+func (x *CreateFolderRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *CreateFolderRequest) SetParents(v bool) {
+	x.xxx_hidden_Parents = v
+}
+
+type CreateFolderRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	Parents       bool
+}
+
+func (b0 CreateFolderRequest_builder) Build() *CreateFolderRequest {
+	m0 := &CreateFolderRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Parents = b.Parents
+	return m0
+}
+
+type CreateFolderResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Folder *File                  `protobuf:"bytes,1,opt,name=folder"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderResponse) ProtoMessage() {}
+
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateFolderResponse) GetFolder() *File {
+	if x != nil {
+		return x.xxx_hidden_Folder
+	}
+	return nil
+}
+
+func (x *CreateFolderResponse) SetFolder(v *File) {
+	x.xxx_hidden_Folder = v
+}
+
+func (x *CreateFolderResponse) HasFolder() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Folder != nil
+}
+
+func (x *CreateFolderResponse) ClearFolder() {
+	x.xxx_hidden_Folder = nil
+}
+
+type CreateFolderResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Folder *File
+}
+
+func (b0 CreateFolderResponse_builder) Build() *CreateFolderResponse {
+	m0 := &CreateFolderResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Folder = b.Folder
+	return m0
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListTrashRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListTrashRequest_builder) Build() *ListTrashRequest {
+	m0 := &ListTrashRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListTrashResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items *[]*TrashItem          `protobuf:"bytes,1,rep,name=items"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) SetItems(v []*TrashItem) {
+	x.xxx_hidden_Items = &v
+}
+
+type ListTrashResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items []*TrashItem
+}
+
+func (b0 ListTrashResponse_builder) Build() *ListTrashResponse {
+	m0 := &ListTrashResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type RestoreFromTrashRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ids             []string               `protobuf:"bytes,1,rep,name=ids"`
+	xxx_hidden_OverwritePolicy OverwritePolicy        `protobuf:"varint,2,opt,name=overwrite_policy,json=overwritePolicy,enum=grpc.v1.OverwritePolicy"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *RestoreFromTrashRequest) Reset() {
+	*x = RestoreFromTrashRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFromTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashRequest) ProtoMessage() {}
+
+func (x *RestoreFromTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreFromTrashRequest) GetIds() []string {
+	if x != nil {
+		return x.xxx_hidden_Ids
+	}
+	return nil
+}
+
+func (x *RestoreFromTrashRequest) GetOverwritePolicy() OverwritePolicy {
+	if x != nil {
+		return x.xxx_hidden_OverwritePolicy
+	}
+	return OverwritePolicy_OVERWRITE_POLICY_UNSPECIFIED
+}
+
+func (x *RestoreFromTrashRequest) SetIds(v []string) {
+	x.xxx_hidden_Ids = v
+}
+
+func (x *RestoreFromTrashRequest) SetOverwritePolicy(v OverwritePolicy) {
+	x.xxx_hidden_OverwritePolicy = v
+}
+
+type RestoreFromTrashRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Ids             []string
+	OverwritePolicy OverwritePolicy
+}
+
+func (b0 RestoreFromTrashRequest_builder) Build() *RestoreFromTrashRequest {
+	m0 := &RestoreFromTrashRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ids = b.Ids
+	x.xxx_hidden_OverwritePolicy = b.OverwritePolicy
+	return m0
+}
+
+type RestoreFromTrashResponse struct {
+	state              protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*FileOperationResult `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RestoreFromTrashResponse) Reset() {
+	*x = RestoreFromTrashResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFromTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashResponse) ProtoMessage() {}
+
+func (x *RestoreFromTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreFromTrashResponse) GetResults() []*FileOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *RestoreFromTrashResponse) SetResults(v []*FileOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+type RestoreFromTrashResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*FileOperationResult
+}
+
+func (b0 RestoreFromTrashResponse_builder) Build() *RestoreFromTrashResponse {
+	m0 := &RestoreFromTrashResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	return m0
+}
+
+type PurgeTrashRequest struct {
+	state          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ids []string               `protobuf:"bytes,1,rep,name=ids"`
+	xxx_hidden_All bool                   `protobuf:"varint,2,opt,name=all"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PurgeTrashRequest) GetIds() []string {
+	if x != nil {
+		return x.xxx_hidden_Ids
+	}
+	return nil
+}
+
+func (x *PurgeTrashRequest) GetAll() bool {
+	if x != nil {
+		return x.xxx_hidden_All
+	}
+	return false
+}
+
+func (x *PurgeTrashRequest) SetIds(v []string) {
+	x.xxx_hidden_Ids = v
+}
+
+func (x *PurgeTrashRequest) SetAll(v bool) {
+	x.xxx_hidden_All = v
+}
+
+type PurgeTrashRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Ids []string
+	All bool
+}
+
+func (b0 PurgeTrashRequest_builder) Build() *PurgeTrashRequest {
+	m0 := &PurgeTrashRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ids = b.Ids
+	x.xxx_hidden_All = b.All
+	return m0
+}
+
+type PurgeTrashResponse struct {
+	state              protoimpl.MessageState  `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*FileOperationResult `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PurgeTrashResponse) GetResults() []*FileOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *PurgeTrashResponse) SetResults(v []*FileOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+type PurgeTrashResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results []*FileOperationResult
+}
+
+func (b0 PurgeTrashResponse_builder) Build() *PurgeTrashResponse {
+	m0 := &PurgeTrashResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	return m0
+}
+
+type DownloadFileRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Offset        int64                  `protobuf:"varint,2,opt,name=offset"`
+	xxx_hidden_Length        int64                  `protobuf:"varint,3,opt,name=length"`
+	xxx_hidden_ChunkSize     int32                  `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRequest) ProtoMessage() {}
+
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadFileRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetLength() int64 {
+	if x != nil {
+		return x.xxx_hidden_Length
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_ChunkSize
+	}
+	return 0
+}
+
+func (x *DownloadFileRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *DownloadFileRequest) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+}
+
+func (x *DownloadFileRequest) SetLength(v int64) {
+	x.xxx_hidden_Length = v
+}
+
+func (x *DownloadFileRequest) SetChunkSize(v int32) {
+	x.xxx_hidden_ChunkSize = v
+}
+
+type DownloadFileRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	Offset        int64
+	Length        int64
+	ChunkSize     int32
+}
+
+func (b0 DownloadFileRequest_builder) Build() *DownloadFileRequest {
+	m0 := &DownloadFileRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Offset = b.Offset
+	x.xxx_hidden_Length = b.Length
+	x.xxx_hidden_ChunkSize = b.ChunkSize
+	return m0
+}
+
+type DownloadFileResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data         []byte                 `protobuf:"bytes,1,opt,name=data"`
+	xxx_hidden_Offset       int64                  `protobuf:"varint,2,opt,name=offset"`
+	xxx_hidden_TotalSize    int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize"`
+	xxx_hidden_ModifiedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=modified_time,json=modifiedTime"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DownloadFileResponse) Reset() {
+	*x = DownloadFileResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileResponse) ProtoMessage() {}
+
+func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadFileResponse) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalSize
+	}
+	return 0
+}
+
+func (x *DownloadFileResponse) GetModifiedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ModifiedTime
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+}
+
+func (x *DownloadFileResponse) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+}
+
+func (x *DownloadFileResponse) SetTotalSize(v int64) {
+	x.xxx_hidden_TotalSize = v
+}
+
+func (x *DownloadFileResponse) SetModifiedTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ModifiedTime = v
+}
+
+func (x *DownloadFileResponse) HasModifiedTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ModifiedTime != nil
+}
+
+func (x *DownloadFileResponse) ClearModifiedTime() {
+	x.xxx_hidden_ModifiedTime = nil
+}
+
+type DownloadFileResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Data         []byte
+	Offset       int64
+	TotalSize    int64
+	ModifiedTime *timestamppb.Timestamp
+}
+
+func (b0 DownloadFileResponse_builder) Build() *DownloadFileResponse {
+	m0 := &DownloadFileResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	x.xxx_hidden_Offset = b.Offset
+	x.xxx_hidden_TotalSize = b.TotalSize
+	x.xxx_hidden_ModifiedTime = b.ModifiedTime
+	return m0
+}
+
+// UploadFileRequest carries the upload header in the first message and data chunks in all messages
+type UploadFileRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder   string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_UploadId        string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId"`
+	xxx_hidden_TotalSize       int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize"`
+	xxx_hidden_ChecksumBlake2B string                 `protobuf:"bytes,4,opt,name=checksum_blake2b,json=checksumBlake2b"`
+	xxx_hidden_OverwritePolicy OverwritePolicy        `protobuf:"varint,5,opt,name=overwrite_policy,json=overwritePolicy,enum=grpc.v1.OverwritePolicy"`
+	xxx_hidden_Offset          int64                  `protobuf:"varint,6,opt,name=offset"`
+	xxx_hidden_Data            []byte                 `protobuf:"bytes,7,opt,name=data"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest) ProtoMessage() {}
+
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadFileRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetUploadId() string {
+	if x != nil {
+		return x.xxx_hidden_UploadId
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetTotalSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalSize
+	}
+	return 0
+}
+
+func (x *UploadFileRequest) GetChecksumBlake2B() string {
+	if x != nil {
+		return x.xxx_hidden_ChecksumBlake2B
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetOverwritePolicy() OverwritePolicy {
+	if x != nil {
+		return x.xxx_hidden_OverwritePolicy
+	}
+	return OverwritePolicy_OVERWRITE_POLICY_UNSPECIFIED
+}
+
+func (x *UploadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *UploadFileRequest) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *UploadFileRequest) SetUploadId(v string) {
+	x.xxx_hidden_UploadId = v
+}
+
+func (x *UploadFileRequest) SetTotalSize(v int64) {
+	x.xxx_hidden_TotalSize = v
+}
+
+func (x *UploadFileRequest) SetChecksumBlake2B(v string) {
+	x.xxx_hidden_ChecksumBlake2B = v
+}
+
+func (x *UploadFileRequest) SetOverwritePolicy(v OverwritePolicy) {
+	x.xxx_hidden_OverwritePolicy = v
+}
+
+func (x *UploadFileRequest) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+}
+
+func (x *UploadFileRequest) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+}
+
+type UploadFileRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder   string
+	UploadId        string
+	TotalSize       int64
+	ChecksumBlake2B string
+	OverwritePolicy OverwritePolicy
+	Offset          int64
+	Data            []byte
+}
+
+func (b0 UploadFileRequest_builder) Build() *UploadFileRequest {
+	m0 := &UploadFileRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_UploadId = b.UploadId
+	x.xxx_hidden_TotalSize = b.TotalSize
+	x.xxx_hidden_ChecksumBlake2B = b.ChecksumBlake2B
+	x.xxx_hidden_OverwritePolicy = b.OverwritePolicy
+	x.xxx_hidden_Offset = b.Offset
+	x.xxx_hidden_Data = b.Data
+	return m0
+}
+
+type UploadFileResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UploadId     string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId"`
+	xxx_hidden_ReceivedSize int64                  `protobuf:"varint,2,opt,name=received_size,json=receivedSize"`
+	xxx_hidden_Completed    bool                   `protobuf:"varint,3,opt,name=completed"`
+	xxx_hidden_File         *File                  `protobuf:"bytes,4,opt,name=file"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UploadFileResponse) Reset() {
+	*x = UploadFileResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileResponse) ProtoMessage() {}
+
+func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadFileResponse) GetUploadId() string {
+	if x != nil {
+		return x.xxx_hidden_UploadId
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetReceivedSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_ReceivedSize
+	}
+	return 0
+}
+
+func (x *UploadFileResponse) GetCompleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Completed
+	}
+	return false
+}
+
+func (x *UploadFileResponse) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *UploadFileResponse) SetUploadId(v string) {
+	x.xxx_hidden_UploadId = v
+}
+
+func (x *UploadFileResponse) SetReceivedSize(v int64) {
+	x.xxx_hidden_ReceivedSize = v
+}
+
+func (x *UploadFileResponse) SetCompleted(v bool) {
+	x.xxx_hidden_Completed = v
+}
+
+func (x *UploadFileResponse) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *UploadFileResponse) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *UploadFileResponse) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+type UploadFileResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UploadId     string
+	ReceivedSize int64
+	Completed    bool
+	File         *File
+}
+
+func (b0 UploadFileResponse_builder) Build() *UploadFileResponse {
+	m0 := &UploadFileResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_UploadId = b.UploadId
+	x.xxx_hidden_ReceivedSize = b.ReceivedSize
+	x.xxx_hidden_Completed = b.Completed
+	x.xxx_hidden_File = b.File
+	return m0
+}
+
+type FindDuplicatesRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_MinSize       int64                  `protobuf:"varint,2,opt,name=min_size,json=minSize"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindDuplicatesRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *FindDuplicatesRequest) GetMinSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_MinSize
+	}
+	return 0
+}
+
+func (x *FindDuplicatesRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *FindDuplicatesRequest) SetMinSize(v int64) {
+	x.xxx_hidden_MinSize = v
+}
+
+type FindDuplicatesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	MinSize       int64
+}
+
+func (b0 FindDuplicatesRequest_builder) Build() *FindDuplicatesRequest {
+	m0 := &FindDuplicatesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_MinSize = b.MinSize
+	return m0
+}
+
+type FindDuplicatesResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Groups       *[]*DuplicateGroup     `protobuf:"bytes,1,rep,name=groups"`
+	xxx_hidden_WastedBytes  int64                  `protobuf:"varint,2,opt,name=wasted_bytes,json=wastedBytes"`
+	xxx_hidden_ScannedCount int32                  `protobuf:"varint,3,opt,name=scanned_count,json=scannedCount"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesResponse) Reset() {
+	*x = FindDuplicatesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesResponse) ProtoMessage() {}
+
+func (x *FindDuplicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindDuplicatesResponse) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		if x.xxx_hidden_Groups != nil {
+			return *x.xxx_hidden_Groups
+		}
+	}
+	return nil
+}
+
+func (x *FindDuplicatesResponse) GetWastedBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_WastedBytes
+	}
+	return 0
+}
+
+func (x *FindDuplicatesResponse) GetScannedCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_ScannedCount
+	}
+	return 0
+}
+
+func (x *FindDuplicatesResponse) SetGroups(v []*DuplicateGroup) {
+	x.xxx_hidden_Groups = &v
+}
+
+func (x *FindDuplicatesResponse) SetWastedBytes(v int64) {
+	x.xxx_hidden_WastedBytes = v
+}
+
+func (x *FindDuplicatesResponse) SetScannedCount(v int32) {
+	x.xxx_hidden_ScannedCount = v
+}
+
+type FindDuplicatesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Groups       []*DuplicateGroup
+	WastedBytes  int64
+	ScannedCount int32
+}
+
+func (b0 FindDuplicatesResponse_builder) Build() *FindDuplicatesResponse {
+	m0 := &FindDuplicatesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Groups = &b.Groups
+	x.xxx_hidden_WastedBytes = b.WastedBytes
+	x.xxx_hidden_ScannedCount = b.ScannedCount
+	return m0
+}
+
+type SearchFilesRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Query         string                 `protobuf:"bytes,1,opt,name=query"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,2,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Limit         int32                  `protobuf:"varint,3,opt,name=limit"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SearchFilesRequest) Reset() {
+	*x = SearchFilesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesRequest) ProtoMessage() {}
+
+func (x *SearchFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchFilesRequest) GetQuery() string {
+	if x != nil {
+		return x.xxx_hidden_Query
+	}
+	return ""
+}
+
+func (x *SearchFilesRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *SearchFilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SearchFilesRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = v
+}
+
+func (x *SearchFilesRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *SearchFilesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type SearchFilesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Query         string
+	PathistFolder string
+	Limit         int32
+}
+
+func (b0 SearchFilesRequest_builder) Build() *SearchFilesRequest {
+	m0 := &SearchFilesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Query = b.Query
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type SearchFilesResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hits       *[]*FileSearchHit      `protobuf:"bytes,1,rep,name=hits"`
+	xxx_hidden_TotalCount int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount"`
+	xxx_hidden_IndexReady bool                   `protobuf:"varint,3,opt,name=index_ready,json=indexReady"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchFilesResponse) Reset() {
+	*x = SearchFilesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilesResponse) ProtoMessage() {}
+
+func (x *SearchFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchFilesResponse) GetHits() []*FileSearchHit {
+	if x != nil {
+		if x.xxx_hidden_Hits != nil {
+			return *x.xxx_hidden_Hits
+		}
+	}
+	return nil
+}
+
+func (x *SearchFilesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalCount
+	}
+	return 0
+}
+
+func (x *SearchFilesResponse) GetIndexReady() bool {
+	if x != nil {
+		return x.xxx_hidden_IndexReady
+	}
+	return false
+}
+
+func (x *SearchFilesResponse) SetHits(v []*FileSearchHit) {
+	x.xxx_hidden_Hits = &v
+}
+
+func (x *SearchFilesResponse) SetTotalCount(v int32) {
+	x.xxx_hidden_TotalCount = v
+}
+
+func (x *SearchFilesResponse) SetIndexReady(v bool) {
+	x.xxx_hidden_IndexReady = v
+}
+
+type SearchFilesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hits       []*FileSearchHit
+	TotalCount int32
+	IndexReady bool
+}
+
+func (b0 SearchFilesResponse_builder) Build() *SearchFilesResponse {
+	m0 := &SearchFilesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hits = &b.Hits
+	x.xxx_hidden_TotalCount = b.TotalCount
+	x.xxx_hidden_IndexReady = b.IndexReady
+	return m0
+}
+
+type GetWorkbookSummaryRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_PreviewRows   int32                  `protobuf:"varint,2,opt,name=preview_rows,json=previewRows"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetWorkbookSummaryRequest) Reset() {
+	*x = GetWorkbookSummaryRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkbookSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbookSummaryRequest) ProtoMessage() {}
+
+func (x *GetWorkbookSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetWorkbookSummaryRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetWorkbookSummaryRequest) GetPreviewRows() int32 {
+	if x != nil {
+		return x.xxx_hidden_PreviewRows
+	}
+	return 0
+}
+
+func (x *GetWorkbookSummaryRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *GetWorkbookSummaryRequest) SetPreviewRows(v int32) {
+	x.xxx_hidden_PreviewRows = v
+}
+
+type GetWorkbookSummaryRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	PreviewRows   int32
+}
+
+func (b0 GetWorkbookSummaryRequest_builder) Build() *GetWorkbookSummaryRequest {
+	m0 := &GetWorkbookSummaryRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_PreviewRows = b.PreviewRows
+	return m0
+}
+
+type GetWorkbookSummaryResponse struct {
+	state                protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_File      *File                    `protobuf:"bytes,1,opt,name=file"`
+	xxx_hidden_Sheets    *[]*WorkbookSheetSummary `protobuf:"bytes,2,rep,name=sheets"`
+	xxx_hidden_Truncated bool                     `protobuf:"varint,3,opt,name=truncated"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetWorkbookSummaryResponse) Reset() {
+	*x = GetWorkbookSummaryResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkbookSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbookSummaryResponse) ProtoMessage() {}
+
+func (x *GetWorkbookSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetWorkbookSummaryResponse) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *GetWorkbookSummaryResponse) GetSheets() []*WorkbookSheetSummary {
+	if x != nil {
+		if x.xxx_hidden_Sheets != nil {
+			return *x.xxx_hidden_Sheets
+		}
+	}
+	return nil
+}
+
+func (x *GetWorkbookSummaryResponse) GetTruncated() bool {
+	if x != nil {
+		return x.xxx_hidden_Truncated
+	}
+	return false
+}
+
+func (x *GetWorkbookSummaryResponse) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *GetWorkbookSummaryResponse) SetSheets(v []*WorkbookSheetSummary) {
+	x.xxx_hidden_Sheets = &v
+}
+
+func (x *GetWorkbookSummaryResponse) SetTruncated(v bool) {
+	x.xxx_hidden_Truncated = v
+}
+
+func (x *GetWorkbookSummaryResponse) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *GetWorkbookSummaryResponse) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+type GetWorkbookSummaryResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	File      *File
+	Sheets    []*WorkbookSheetSummary
+	Truncated bool
+}
+
+func (b0 GetWorkbookSummaryResponse_builder) Build() *GetWorkbookSummaryResponse {
+	m0 := &GetWorkbookSummaryResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_File = b.File
+	x.xxx_hidden_Sheets = &b.Sheets
+	x.xxx_hidden_Truncated = b.Truncated
+	return m0
+}
+
+type SearchWorkbooksRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Query         string                 `protobuf:"bytes,1,opt,name=query"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,2,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Limit         int32                  `protobuf:"varint,3,opt,name=limit"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SearchWorkbooksRequest) Reset() {
+	*x = SearchWorkbooksRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorkbooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkbooksRequest) ProtoMessage() {}
+
+func (x *SearchWorkbooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchWorkbooksRequest) GetQuery() string {
+	if x != nil {
+		return x.xxx_hidden_Query
+	}
+	return ""
+}
+
+func (x *SearchWorkbooksRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *SearchWorkbooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SearchWorkbooksRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = v
+}
+
+func (x *SearchWorkbooksRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *SearchWorkbooksRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+}
+
+type SearchWorkbooksRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Query         string
+	PathistFolder string
+	Limit         int32
+}
+
+func (b0 SearchWorkbooksRequest_builder) Build() *SearchWorkbooksRequest {
+	m0 := &SearchWorkbooksRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Query = b.Query
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Limit = b.Limit
+	return m0
+}
+
+type SearchWorkbooksResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Hits       *[]*WorkbookSearchHit  `protobuf:"bytes,1,rep,name=hits"`
+	xxx_hidden_TotalCount int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount"`
+	xxx_hidden_IndexReady bool                   `protobuf:"varint,3,opt,name=index_ready,json=indexReady"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchWorkbooksResponse) Reset() {
+	*x = SearchWorkbooksResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWorkbooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkbooksResponse) ProtoMessage() {}
+
+func (x *SearchWorkbooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchWorkbooksResponse) GetHits() []*WorkbookSearchHit {
+	if x != nil {
+		if x.xxx_hidden_Hits != nil {
+			return *x.xxx_hidden_Hits
+		}
+	}
+	return nil
+}
+
+func (x *SearchWorkbooksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalCount
+	}
+	return 0
+}
+
+func (x *SearchWorkbooksResponse) GetIndexReady() bool {
+	if x != nil {
+		return x.xxx_hidden_IndexReady
+	}
+	return false
+}
+
+func (x *SearchWorkbooksResponse) SetHits(v []*WorkbookSearchHit) {
+	x.xxx_hidden_Hits = &v
+}
+
+func (x *SearchWorkbooksResponse) SetTotalCount(v int32) {
+	x.xxx_hidden_TotalCount = v
+}
+
+func (x *SearchWorkbooksResponse) SetIndexReady(v bool) {
+	x.xxx_hidden_IndexReady = v
+}
+
+type SearchWorkbooksResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Hits       []*WorkbookSearchHit
+	TotalCount int32
+	IndexReady bool
+}
+
+func (b0 SearchWorkbooksResponse_builder) Build() *SearchWorkbooksResponse {
+	m0 := &SearchWorkbooksResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Hits = &b.Hits
+	x.xxx_hidden_TotalCount = b.TotalCount
+	x.xxx_hidden_IndexReady = b.IndexReady
+	return m0
+}
+
+type ExportArchiveRequest struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolders []string               `protobuf:"bytes,1,rep,name=pathist_folders,json=pathistFolders"`
+	xxx_hidden_KojiId         string                 `protobuf:"bytes,2,opt,name=koji_id,json=kojiId"`
+	xxx_hidden_CompanyId      string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId"`
+	xxx_hidden_ArchiveName    string                 `protobuf:"bytes,4,opt,name=archive_name,json=archiveName"`
+	xxx_hidden_ChunkSize      int32                  `protobuf:"varint,5,opt,name=chunk_size,json=chunkSize"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ExportArchiveRequest) Reset() {
+	*x = ExportArchiveRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveRequest) ProtoMessage() {}
+
+func (x *ExportArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportArchiveRequest) GetPathistFolders() []string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolders
+	}
+	return nil
+}
+
+func (x *ExportArchiveRequest) GetKojiId() string {
+	if x != nil {
+		return x.xxx_hidden_KojiId
+	}
+	return ""
+}
+
+func (x *ExportArchiveRequest) GetCompanyId() string {
+	if x != nil {
+		return x.xxx_hidden_CompanyId
+	}
+	return ""
+}
+
+func (x *ExportArchiveRequest) GetArchiveName() string {
+	if x != nil {
+		return x.xxx_hidden_ArchiveName
+	}
+	return ""
+}
+
+func (x *ExportArchiveRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_ChunkSize
+	}
+	return 0
+}
+
+func (x *ExportArchiveRequest) SetPathistFolders(v []string) {
+	x.xxx_hidden_PathistFolders = v
+}
+
+func (x *ExportArchiveRequest) SetKojiId(v string) {
+	x.xxx_hidden_KojiId = v
+}
+
+func (x *ExportArchiveRequest) SetCompanyId(v string) {
+	x.xxx_hidden_CompanyId = v
+}
+
+func (x *ExportArchiveRequest) SetArchiveName(v string) {
+	x.xxx_hidden_ArchiveName = v
+}
+
+func (x *ExportArchiveRequest) SetChunkSize(v int32) {
+	x.xxx_hidden_ChunkSize = v
+}
+
+type ExportArchiveRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolders []string
+	KojiId         string
+	CompanyId      string
+	ArchiveName    string
+	ChunkSize      int32
+}
+
+func (b0 ExportArchiveRequest_builder) Build() *ExportArchiveRequest {
+	m0 := &ExportArchiveRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolders = b.PathistFolders
+	x.xxx_hidden_KojiId = b.KojiId
+	x.xxx_hidden_CompanyId = b.CompanyId
+	x.xxx_hidden_ArchiveName = b.ArchiveName
+	x.xxx_hidden_ChunkSize = b.ChunkSize
+	return m0
+}
+
+// ExportArchiveResponse carries the archive name in the first message and the summary in the last message
+type ExportArchiveResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data         []byte                 `protobuf:"bytes,1,opt,name=data"`
+	xxx_hidden_ArchiveName  string                 `protobuf:"bytes,2,opt,name=archive_name,json=archiveName"`
+	xxx_hidden_Done         bool                   `protobuf:"varint,3,opt,name=done"`
+	xxx_hidden_FileCount    int32                  `protobuf:"varint,4,opt,name=file_count,json=fileCount"`
+	xxx_hidden_SkippedCount int32                  `protobuf:"varint,5,opt,name=skipped_count,json=skippedCount"`
+	xxx_hidden_TotalBytes   int64                  `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ExportArchiveResponse) Reset() {
+	*x = ExportArchiveResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveResponse) ProtoMessage() {}
+
+func (x *ExportArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExportArchiveResponse) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *ExportArchiveResponse) GetArchiveName() string {
+	if x != nil {
+		return x.xxx_hidden_ArchiveName
+	}
+	return ""
+}
+
+func (x *ExportArchiveResponse) GetDone() bool {
+	if x != nil {
+		return x.xxx_hidden_Done
+	}
+	return false
+}
+
+func (x *ExportArchiveResponse) GetFileCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_FileCount
+	}
+	return 0
+}
+
+func (x *ExportArchiveResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_SkippedCount
+	}
+	return 0
+}
+
+func (x *ExportArchiveResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_TotalBytes
+	}
+	return 0
+}
+
+func (x *ExportArchiveResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+}
+
+func (x *ExportArchiveResponse) SetArchiveName(v string) {
+	x.xxx_hidden_ArchiveName = v
+}
+
+func (x *ExportArchiveResponse) SetDone(v bool) {
+	x.xxx_hidden_Done = v
+}
+
+func (x *ExportArchiveResponse) SetFileCount(v int32) {
+	x.xxx_hidden_FileCount = v
+}
+
+func (x *ExportArchiveResponse) SetSkippedCount(v int32) {
+	x.xxx_hidden_SkippedCount = v
+}
+
+func (x *ExportArchiveResponse) SetTotalBytes(v int64) {
+	x.xxx_hidden_TotalBytes = v
+}
+
+type ExportArchiveResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Data         []byte
+	ArchiveName  string
+	Done         bool
+	FileCount    int32
+	SkippedCount int32
+	TotalBytes   int64
+}
+
+func (b0 ExportArchiveResponse_builder) Build() *ExportArchiveResponse {
+	m0 := &ExportArchiveResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Data = b.Data
+	x.xxx_hidden_ArchiveName = b.ArchiveName
+	x.xxx_hidden_Done = b.Done
+	x.xxx_hidden_FileCount = b.FileCount
+	x.xxx_hidden_SkippedCount = b.SkippedCount
+	x.xxx_hidden_TotalBytes = b.TotalBytes
+	return m0
+}
+
+type GetDiskUsageRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_KojiId        string                 `protobuf:"bytes,2,opt,name=koji_id,json=kojiId"`
+	xxx_hidden_CompanyId     string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId"`
+	xxx_hidden_TopChildren   int32                  `protobuf:"varint,4,opt,name=top_children,json=topChildren"`
+	xxx_hidden_Refresh       bool                   `protobuf:"varint,5,opt,name=refresh"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetDiskUsageRequest) Reset() {
+	*x = GetDiskUsageRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiskUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskUsageRequest) ProtoMessage() {}
+
+func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDiskUsageRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetDiskUsageRequest) GetKojiId() string {
+	if x != nil {
+		return x.xxx_hidden_KojiId
+	}
+	return ""
+}
+
+func (x *GetDiskUsageRequest) GetCompanyId() string {
+	if x != nil {
+		return x.xxx_hidden_CompanyId
+	}
+	return ""
+}
+
+func (x *GetDiskUsageRequest) GetTopChildren() int32 {
+	if x != nil {
+		return x.xxx_hidden_TopChildren
+	}
+	return 0
+}
+
+func (x *GetDiskUsageRequest) GetRefresh() bool {
+	if x != nil {
+		return x.xxx_hidden_Refresh
+	}
+	return false
+}
+
+func (x *GetDiskUsageRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *GetDiskUsageRequest) SetKojiId(v string) {
+	x.xxx_hidden_KojiId = v
+}
+
+func (x *GetDiskUsageRequest) SetCompanyId(v string) {
+	x.xxx_hidden_CompanyId = v
+}
+
+func (x *GetDiskUsageRequest) SetTopChildren(v int32) {
+	x.xxx_hidden_TopChildren = v
+}
+
+func (x *GetDiskUsageRequest) SetRefresh(v bool) {
+	x.xxx_hidden_Refresh = v
+}
+
+type GetDiskUsageRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	KojiId        string
+	CompanyId     string
+	TopChildren   int32
+	Refresh       bool
+}
+
+func (b0 GetDiskUsageRequest_builder) Build() *GetDiskUsageRequest {
+	m0 := &GetDiskUsageRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_KojiId = b.KojiId
+	x.xxx_hidden_CompanyId = b.CompanyId
+	x.xxx_hidden_TopChildren = b.TopChildren
+	x.xxx_hidden_Refresh = b.Refresh
+	return m0
+}
+
+type GetDiskUsageResponse struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_RelativePath    string                 `protobuf:"bytes,1,opt,name=relative_path,json=relativePath"`
+	xxx_hidden_Size            int64                  `protobuf:"varint,2,opt,name=size"`
+	xxx_hidden_FileCount       int64                  `protobuf:"varint,3,opt,name=file_count,json=fileCount"`
+	xxx_hidden_FolderCount     int64                  `protobuf:"varint,4,opt,name=folder_count,json=folderCount"`
+	xxx_hidden_SkippedCount    int64                  `protobuf:"varint,5,opt,name=skipped_count,json=skippedCount"`
+	xxx_hidden_LargestChildren *[]*DiskUsageEntry     `protobuf:"bytes,6,rep,name=largest_children,json=largestChildren"`
+	xxx_hidden_ChildrenCount   int32                  `protobuf:"varint,7,opt,name=children_count,json=childrenCount"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *GetDiskUsageResponse) Reset() {
+	*x = GetDiskUsageResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiskUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskUsageResponse) ProtoMessage() {}
+
+func (x *GetDiskUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetDiskUsageResponse) GetRelativePath() string {
+	if x != nil {
+		return x.xxx_hidden_RelativePath
+	}
+	return ""
+}
+
+func (x *GetDiskUsageResponse) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) GetFileCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_FileCount
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) GetFolderCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_FolderCount
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_SkippedCount
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) GetLargestChildren() []*DiskUsageEntry {
+	if x != nil {
+		if x.xxx_hidden_LargestChildren != nil {
+			return *x.xxx_hidden_LargestChildren
+		}
+	}
+	return nil
+}
+
+func (x *GetDiskUsageResponse) GetChildrenCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_ChildrenCount
+	}
+	return 0
+}
+
+func (x *GetDiskUsageResponse) SetRelativePath(v string) {
+	x.xxx_hidden_RelativePath = v
+}
+
+func (x *GetDiskUsageResponse) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+}
+
+func (x *GetDiskUsageResponse) SetFileCount(v int64) {
+	x.xxx_hidden_FileCount = v
+}
+
+func (x *GetDiskUsageResponse) SetFolderCount(v int64) {
+	x.xxx_hidden_FolderCount = v
+}
+
+func (x *GetDiskUsageResponse) SetSkippedCount(v int64) {
+	x.xxx_hidden_SkippedCount = v
+}
+
+func (x *GetDiskUsageResponse) SetLargestChildren(v []*DiskUsageEntry) {
+	x.xxx_hidden_LargestChildren = &v
+}
+
+func (x *GetDiskUsageResponse) SetChildrenCount(v int32) {
+	x.xxx_hidden_ChildrenCount = v
+}
+
+type GetDiskUsageResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	RelativePath    string
+	Size            int64
+	FileCount       int64
+	FolderCount     int64
+	SkippedCount    int64
+	LargestChildren []*DiskUsageEntry
+	ChildrenCount   int32
+}
+
+func (b0 GetDiskUsageResponse_builder) Build() *GetDiskUsageResponse {
+	m0 := &GetDiskUsageResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_RelativePath = b.RelativePath
+	x.xxx_hidden_Size = b.Size
+	x.xxx_hidden_FileCount = b.FileCount
+	x.xxx_hidden_FolderCount = b.FolderCount
+	x.xxx_hidden_SkippedCount = b.SkippedCount
+	x.xxx_hidden_LargestChildren = &b.LargestChildren
+	x.xxx_hidden_ChildrenCount = b.ChildrenCount
+	return m0
+}
+
+type ListFileVersionsRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsRequest) Reset() {
+	*x = ListFileVersionsRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsRequest) ProtoMessage() {}
+
+func (x *ListFileVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListFileVersionsRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *ListFileVersionsRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+type ListFileVersionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+}
+
+func (b0 ListFileVersionsRequest_builder) Build() *ListFileVersionsRequest {
+	m0 := &ListFileVersionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	return m0
+}
+
+type ListFileVersionsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Versions *[]*FileVersion        `protobuf:"bytes,1,rep,name=versions"`
+	xxx_hidden_Current  *File                  `protobuf:"bytes,2,opt,name=current"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsResponse) Reset() {
+	*x = ListFileVersionsResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsResponse) ProtoMessage() {}
+
+func (x *ListFileVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListFileVersionsResponse) GetVersions() []*FileVersion {
+	if x != nil {
+		if x.xxx_hidden_Versions != nil {
+			return *x.xxx_hidden_Versions
+		}
+	}
+	return nil
+}
+
+func (x *ListFileVersionsResponse) GetCurrent() *File {
+	if x != nil {
+		return x.xxx_hidden_Current
+	}
+	return nil
+}
+
+func (x *ListFileVersionsResponse) SetVersions(v []*FileVersion) {
+	x.xxx_hidden_Versions = &v
+}
+
+func (x *ListFileVersionsResponse) SetCurrent(v *File) {
+	x.xxx_hidden_Current = v
+}
+
+func (x *ListFileVersionsResponse) HasCurrent() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Current != nil
+}
+
+func (x *ListFileVersionsResponse) ClearCurrent() {
+	x.xxx_hidden_Current = nil
+}
+
+type ListFileVersionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Versions []*FileVersion
+	Current  *File
+}
+
+func (b0 ListFileVersionsResponse_builder) Build() *ListFileVersionsResponse {
+	m0 := &ListFileVersionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Versions = &b.Versions
+	x.xxx_hidden_Current = b.Current
+	return m0
+}
+
+type RestoreFileVersionRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RestoreFileVersionRequest) Reset() {
+	*x = RestoreFileVersionRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFileVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileVersionRequest) ProtoMessage() {}
+
+func (x *RestoreFileVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreFileVersionRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *RestoreFileVersionRequest) GetVersionId() string {
+	if x != nil {
+		return x.xxx_hidden_VersionId
+	}
+	return ""
+}
+
+func (x *RestoreFileVersionRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *RestoreFileVersionRequest) SetVersionId(v string) {
+	x.xxx_hidden_VersionId = v
+}
+
+type RestoreFileVersionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	VersionId     string
+}
+
+func (b0 RestoreFileVersionRequest_builder) Build() *RestoreFileVersionRequest {
+	m0 := &RestoreFileVersionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_VersionId = b.VersionId
+	return m0
+}
+
+type RestoreFileVersionResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_File           *File                  `protobuf:"bytes,1,opt,name=file"`
+	xxx_hidden_SavedVersionId string                 `protobuf:"bytes,2,opt,name=saved_version_id,json=savedVersionId"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RestoreFileVersionResponse) Reset() {
+	*x = RestoreFileVersionResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreFileVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileVersionResponse) ProtoMessage() {}
+
+func (x *RestoreFileVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreFileVersionResponse) GetFile() *File {
+	if x != nil {
+		return x.xxx_hidden_File
+	}
+	return nil
+}
+
+func (x *RestoreFileVersionResponse) GetSavedVersionId() string {
+	if x != nil {
+		return x.xxx_hidden_SavedVersionId
+	}
+	return ""
+}
+
+func (x *RestoreFileVersionResponse) SetFile(v *File) {
+	x.xxx_hidden_File = v
+}
+
+func (x *RestoreFileVersionResponse) SetSavedVersionId(v string) {
+	x.xxx_hidden_SavedVersionId = v
+}
+
+func (x *RestoreFileVersionResponse) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_File != nil
+}
+
+func (x *RestoreFileVersionResponse) ClearFile() {
+	x.xxx_hidden_File = nil
+}
+
+type RestoreFileVersionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	File           *File
+	SavedVersionId string
+}
+
+func (b0 RestoreFileVersionResponse_builder) Build() *RestoreFileVersionResponse {
+	m0 := &RestoreFileVersionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_File = b.File
+	x.xxx_hidden_SavedVersionId = b.SavedVersionId
+	return m0
+}
+
+type BatchPlanRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Operations *[]*BatchOperation     `protobuf:"bytes,1,rep,name=operations"`
+	xxx_hidden_DryRun     bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BatchPlanRequest) Reset() {
+	*x = BatchPlanRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPlanRequest) ProtoMessage() {}
+
+func (x *BatchPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BatchPlanRequest) GetOperations() []*BatchOperation {
+	if x != nil {
+		if x.xxx_hidden_Operations != nil {
+			return *x.xxx_hidden_Operations
+		}
+	}
+	return nil
+}
+
+func (x *BatchPlanRequest) GetDryRun() bool {
+	if x != nil {
+		return x.xxx_hidden_DryRun
+	}
+	return false
+}
+
+func (x *BatchPlanRequest) SetOperations(v []*BatchOperation) {
+	x.xxx_hidden_Operations = &v
+}
+
+func (x *BatchPlanRequest) SetDryRun(v bool) {
+	x.xxx_hidden_DryRun = v
+}
+
+type BatchPlanRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Operations []*BatchOperation
+	DryRun     bool
+}
+
+func (b0 BatchPlanRequest_builder) Build() *BatchPlanRequest {
+	m0 := &BatchPlanRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Operations = &b.Operations
+	x.xxx_hidden_DryRun = b.DryRun
+	return m0
+}
+
+type BatchPlanResponse struct {
+	state                 protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Results    *[]*BatchOperationResult `protobuf:"bytes,1,rep,name=results"`
+	xxx_hidden_Ok         bool                     `protobuf:"varint,2,opt,name=ok"`
+	xxx_hidden_Executed   bool                     `protobuf:"varint,3,opt,name=executed"`
+	xxx_hidden_RolledBack bool                     `protobuf:"varint,4,opt,name=rolled_back,json=rolledBack"`
+	xxx_hidden_JournalId  string                   `protobuf:"bytes,5,opt,name=journal_id,json=journalId"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BatchPlanResponse) Reset() {
+	*x = BatchPlanResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPlanResponse) ProtoMessage() {}
+
+func (x *BatchPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BatchPlanResponse) GetResults() []*BatchOperationResult {
+	if x != nil {
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
+		}
+	}
+	return nil
+}
+
+func (x *BatchPlanResponse) GetOk() bool {
+	if x != nil {
+		return x.xxx_hidden_Ok
+	}
+	return false
+}
+
+func (x *BatchPlanResponse) GetExecuted() bool {
+	if x != nil {
+		return x.xxx_hidden_Executed
+	}
+	return false
+}
+
+func (x *BatchPlanResponse) GetRolledBack() bool {
+	if x != nil {
+		return x.xxx_hidden_RolledBack
+	}
+	return false
+}
+
+func (x *BatchPlanResponse) GetJournalId() string {
+	if x != nil {
+		return x.xxx_hidden_JournalId
+	}
+	return ""
+}
+
+func (x *BatchPlanResponse) SetResults(v []*BatchOperationResult) {
+	x.xxx_hidden_Results = &v
+}
+
+func (x *BatchPlanResponse) SetOk(v bool) {
+	x.xxx_hidden_Ok = v
+}
+
+func (x *BatchPlanResponse) SetExecuted(v bool) {
+	x.xxx_hidden_Executed = v
+}
+
+func (x *BatchPlanResponse) SetRolledBack(v bool) {
+	x.xxx_hidden_RolledBack = v
+}
+
+func (x *BatchPlanResponse) SetJournalId(v string) {
+	x.xxx_hidden_JournalId = v
+}
+
+type BatchPlanResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results    []*BatchOperationResult
+	Ok         bool
+	Executed   bool
+	RolledBack bool
+	JournalId  string
+}
+
+func (b0 BatchPlanResponse_builder) Build() *BatchPlanResponse {
+	m0 := &BatchPlanResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Results = &b.Results
+	x.xxx_hidden_Ok = b.Ok
+	x.xxx_hidden_Executed = b.Executed
+	x.xxx_hidden_RolledBack = b.RolledBack
+	x.xxx_hidden_JournalId = b.JournalId
+	return m0
+}
+
+type NormalizeFolderNamesRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *NormalizeFolderNamesRequest) Reset() {
+	*x = NormalizeFolderNamesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeFolderNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeFolderNamesRequest) ProtoMessage() {}
+
+func (x *NormalizeFolderNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NormalizeFolderNamesRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *NormalizeFolderNamesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.xxx_hidden_DryRun
+	}
+	return false
+}
+
+func (x *NormalizeFolderNamesRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *NormalizeFolderNamesRequest) SetDryRun(v bool) {
+	x.xxx_hidden_DryRun = v
+}
+
+type NormalizeFolderNamesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PathistFolder string
+	DryRun        bool
+}
+
+func (b0 NormalizeFolderNamesRequest_builder) Build() *NormalizeFolderNamesRequest {
+	m0 := &NormalizeFolderNamesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_DryRun = b.DryRun
+	return m0
+}
+
+type NormalizeFolderNamesResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Issues       *[]*FolderNameIssue    `protobuf:"bytes,1,rep,name=issues"`
+	xxx_hidden_RenamedCount int32                  `protobuf:"varint,2,opt,name=renamed_count,json=renamedCount"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *NormalizeFolderNamesResponse) Reset() {
+	*x = NormalizeFolderNamesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeFolderNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeFolderNamesResponse) ProtoMessage() {}
+
+func (x *NormalizeFolderNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NormalizeFolderNamesResponse) GetIssues() []*FolderNameIssue {
+	if x != nil {
+		if x.xxx_hidden_Issues != nil {
+			return *x.xxx_hidden_Issues
+		}
+	}
+	return nil
+}
+
+func (x *NormalizeFolderNamesResponse) GetRenamedCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_RenamedCount
+	}
+	return 0
+}
+
+func (x *NormalizeFolderNamesResponse) SetIssues(v []*FolderNameIssue) {
+	x.xxx_hidden_Issues = &v
+}
+
+func (x *NormalizeFolderNamesResponse) SetRenamedCount(v int32) {
+	x.xxx_hidden_RenamedCount = v
+}
+
+type NormalizeFolderNamesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Issues       []*FolderNameIssue
+	RenamedCount int32
+}
+
+func (b0 NormalizeFolderNamesResponse_builder) Build() *NormalizeFolderNamesResponse {
+	m0 := &NormalizeFolderNamesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Issues = &b.Issues
+	x.xxx_hidden_RenamedCount = b.RenamedCount
+	return m0
+}
+
+// CompanyService messages
+// GetCompaniesRequest lists companies
+// query: space separated terms matched against short_name, persist_reading, persist_long_name, persist_address and persist_tel
+// empty_fields, non_empty_fields: Company field names such as "persist_tel"
+// page_size: 0 uses the server default, page_token continues from next_page_token
+// page_token is rejected once the companies have changed since it was issued, and refresh is ignored with page_token
+type GetCompaniesRequest struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Refresh            bool                   `protobuf:"varint,1,opt,name=refresh"`
+	xxx_hidden_IncludeFolderSizes bool                   `protobuf:"varint,2,opt,name=include_folder_sizes,json=includeFolderSizes"`
+	xxx_hidden_Archived           bool                   `protobuf:"varint,3,opt,name=archived"`
+	xxx_hidden_CategoryIndexes    []int32                `protobuf:"varint,4,rep,packed,name=category_indexes,json=categoryIndexes"`
+	xxx_hidden_Query              string                 `protobuf:"bytes,5,opt,name=query"`
+	xxx_hidden_EmptyFields        []string               `protobuf:"bytes,6,rep,name=empty_fields,json=emptyFields"`
+	xxx_hidden_NonEmptyFields     []string               `protobuf:"bytes,7,rep,name=non_empty_fields,json=nonEmptyFields"`
+	xxx_hidden_SortKey            CompanySortKey         `protobuf:"varint,8,opt,name=sort_key,json=sortKey,enum=grpc.v1.CompanySortKey"`
+	xxx_hidden_Descending         bool                   `protobuf:"varint,9,opt,name=descending"`
+	xxx_hidden_PageSize           int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize"`
+	xxx_hidden_PageToken          string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *GetCompaniesRequest) Reset() {
+	*x = GetCompaniesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompaniesRequest) ProtoMessage() {}
+
+func (x *GetCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCompaniesRequest) GetRefresh() bool {
+	if x != nil {
+		return x.xxx_hidden_Refresh
+	}
+	return false
+}
+
+func (x *GetCompaniesRequest) GetIncludeFolderSizes() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeFolderSizes
+	}
+	return false
+}
+
+func (x *GetCompaniesRequest) GetArchived() bool {
+	if x != nil {
+		return x.xxx_hidden_Archived
+	}
+	return false
+}
+
+func (x *GetCompaniesRequest) GetCategoryIndexes() []int32 {
+	if x != nil {
+		return x.xxx_hidden_CategoryIndexes
+	}
+	return nil
+}
+
+func (x *GetCompaniesRequest) GetQuery() string {
+	if x != nil {
+		return x.xxx_hidden_Query
+	}
+	return ""
+}
+
+func (x *GetCompaniesRequest) GetEmptyFields() []string {
+	if x != nil {
+		return x.xxx_hidden_EmptyFields
+	}
+	return nil
+}
+
+func (x *GetCompaniesRequest) GetNonEmptyFields() []string {
+	if x != nil {
+		return x.xxx_hidden_NonEmptyFields
+	}
+	return nil
+}
+
+func (x *GetCompaniesRequest) GetSortKey() CompanySortKey {
+	if x != nil {
+		return x.xxx_hidden_SortKey
+	}
+	return CompanySortKey_COMPANY_SORT_KEY_UNSPECIFIED
+}
+
+func (x *GetCompaniesRequest) GetDescending() bool {
+	if x != nil {
+		return x.xxx_hidden_Descending
+	}
+	return false
+}
+
+func (x *GetCompaniesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *GetCompaniesRequest) GetPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_PageToken
+	}
+	return ""
+}
+
+func (x *GetCompaniesRequest) SetRefresh(v bool) {
+	x.xxx_hidden_Refresh = v
+}
+
+func (x *GetCompaniesRequest) SetIncludeFolderSizes(v bool) {
+	x.xxx_hidden_IncludeFolderSizes = v
+}
+
+func (x *GetCompaniesRequest) SetArchived(v bool) {
+	x.xxx_hidden_Archived = v
+}
+
+func (x *GetCompaniesRequest) SetCategoryIndexes(v []int32) {
+	x.xxx_hidden_CategoryIndexes = v
+}
+
+func (x *GetCompaniesRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = v
+}
+
+func (x *GetCompaniesRequest) SetEmptyFields(v []string) {
+	x.xxx_hidden_EmptyFields = v
+}
+
+func (x *GetCompaniesRequest) SetNonEmptyFields(v []string) {
+	x.xxx_hidden_NonEmptyFields = v
+}
+
+func (x *GetCompaniesRequest) SetSortKey(v CompanySortKey) {
+	x.xxx_hidden_SortKey = v
+}
+
+func (x *GetCompaniesRequest) SetDescending(v bool) {
+	x.xxx_hidden_Descending = v
+}
+
+func (x *GetCompaniesRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+}
+
+func (x *GetCompaniesRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = v
+}
+
+type GetCompaniesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Refresh            bool
+	IncludeFolderSizes bool
+	Archived           bool
+	CategoryIndexes    []int32
+	Query              string
+	EmptyFields        []string
+	NonEmptyFields     []string
+	SortKey            CompanySortKey
+	Descending         bool
+	PageSize           int32
+	PageToken          string
+}
+
+func (b0 GetCompaniesRequest_builder) Build() *GetCompaniesRequest {
+	m0 := &GetCompaniesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Refresh = b.Refresh
+	x.xxx_hidden_IncludeFolderSizes = b.IncludeFolderSizes
+	x.xxx_hidden_Archived = b.Archived
+	x.xxx_hidden_CategoryIndexes = b.CategoryIndexes
+	x.xxx_hidden_Query = b.Query
+	x.xxx_hidden_EmptyFields = b.EmptyFields
+	x.xxx_hidden_NonEmptyFields = b.NonEmptyFields
+	x.xxx_hidden_SortKey = b.SortKey
+	x.xxx_hidden_Descending = b.Descending
+	x.xxx_hidden_PageSize = b.PageSize
+	x.xxx_hidden_PageToken = b.PageToken
+	return m0
+}
+
+// GetCompaniesResponse returns one page of the matching companies in ordered_companies
+// companies and folder_sizes hold all matches when neither page_size nor page_token is given, otherwise the same page
+type GetCompaniesResponse struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Companies        map[string]*Company    `protobuf:"bytes,1,rep,name=companies" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Generation       uint64                 `protobuf:"varint,2,opt,name=generation"`
+	xxx_hidden_FolderSizes      map[string]int64       `protobuf:"bytes,3,rep,name=folder_sizes,json=folderSizes" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	xxx_hidden_OrderedCompanies *[]*Company            `protobuf:"bytes,4,rep,name=ordered_companies,json=orderedCompanies"`
+	xxx_hidden_NextPageToken    string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken"`
+	xxx_hidden_TotalCount       int32                  `protobuf:"varint,6,opt,name=total_count,json=totalCount"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *GetCompaniesResponse) Reset() {
+	*x = GetCompaniesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompaniesResponse) ProtoMessage() {}
+
+func (x *GetCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCompaniesResponse) GetCompanies() map[string]*Company {
+	if x != nil {
+		return x.xxx_hidden_Companies
+	}
+	return nil
+}
+
+func (x *GetCompaniesResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Generation
+	}
+	return 0
+}
+
+func (x *GetCompaniesResponse) GetFolderSizes() map[string]int64 {
+	if x != nil {
+		return x.xxx_hidden_FolderSizes
+	}
+	return nil
+}
+
+func (x *GetCompaniesResponse) GetOrderedCompanies() []*Company {
+	if x != nil {
+		if x.xxx_hidden_OrderedCompanies != nil {
+			return *x.xxx_hidden_OrderedCompanies
+		}
+	}
+	return nil
+}
+
+func (x *GetCompaniesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.xxx_hidden_NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCompaniesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TotalCount
+	}
+	return 0
+}
+
+func (x *GetCompaniesResponse) SetCompanies(v map[string]*Company) {
+	x.xxx_hidden_Companies = v
+}
+
+func (x *GetCompaniesResponse) SetGeneration(v uint64) {
+	x.xxx_hidden_Generation = v
+}
+
+func (x *GetCompaniesResponse) SetFolderSizes(v map[string]int64) {
+	x.xxx_hidden_FolderSizes = v
+}
+
+func (x *GetCompaniesResponse) SetOrderedCompanies(v []*Company) {
+	x.xxx_hidden_OrderedCompanies = &v
+}
+
+func (x *GetCompaniesResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = v
+}
+
+func (x *GetCompaniesResponse) SetTotalCount(v int32) {
+	x.xxx_hidden_TotalCount = v
+}
+
+type GetCompaniesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Companies        map[string]*Company
+	Generation       uint64
+	FolderSizes      map[string]int64
+	OrderedCompanies []*Company
+	NextPageToken    string
+	TotalCount       int32
+}
+
+func (b0 GetCompaniesResponse_builder) Build() *GetCompaniesResponse {
+	m0 := &GetCompaniesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Companies = b.Companies
+	x.xxx_hidden_Generation = b.Generation
+	x.xxx_hidden_FolderSizes = b.FolderSizes
+	x.xxx_hidden_OrderedCompanies = &b.OrderedCompanies
+	x.xxx_hidden_NextPageToken = b.NextPageToken
+	x.xxx_hidden_TotalCount = b.TotalCount
+	return m0
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCompanyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetCompanyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetCompanyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetCompanyRequest_builder) Build() *GetCompanyRequest {
+	m0 := &GetCompanyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetCompanyResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Company *Company               `protobuf:"bytes,1,opt,name=company"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyResponse) ProtoMessage() {}
+
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_Company
+	}
+	return nil
+}
+
+func (x *GetCompanyResponse) SetCompany(v *Company) {
+	x.xxx_hidden_Company = v
+}
+
+func (x *GetCompanyResponse) HasCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Company != nil
+}
+
+func (x *GetCompanyResponse) ClearCompany() {
+	x.xxx_hidden_Company = nil
+}
+
+type GetCompanyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Company *Company
+}
+
+func (b0 GetCompanyResponse_builder) Build() *GetCompanyResponse {
+	m0 := &GetCompanyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Company = b.Company
+	return m0
+}
+
+type UpdateCompanyRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PrevId     string                 `protobuf:"bytes,1,opt,name=prev_id,json=prevId"`
+	xxx_hidden_NewCompany *Company               `protobuf:"bytes,2,opt,name=new_company,json=newCompany"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateCompanyRequest) GetPrevId() string {
+	if x != nil {
+		return x.xxx_hidden_PrevId
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetNewCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_NewCompany
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) SetPrevId(v string) {
+	x.xxx_hidden_PrevId = v
+}
+
+func (x *UpdateCompanyRequest) SetNewCompany(v *Company) {
+	x.xxx_hidden_NewCompany = v
+}
+
+func (x *UpdateCompanyRequest) HasNewCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NewCompany != nil
+}
+
+func (x *UpdateCompanyRequest) ClearNewCompany() {
+	x.xxx_hidden_NewCompany = nil
+}
+
+type UpdateCompanyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PrevId     string
+	NewCompany *Company
+}
+
+func (b0 UpdateCompanyRequest_builder) Build() *UpdateCompanyRequest {
+	m0 := &UpdateCompanyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PrevId = b.PrevId
+	x.xxx_hidden_NewCompany = b.NewCompany
+	return m0
+}
+
+type UpdateCompanyResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PrevCompany *Company               `protobuf:"bytes,1,opt,name=prev_company,json=prevCompany"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyResponse) Reset() {
+	*x = UpdateCompanyResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyResponse) ProtoMessage() {}
+
+func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateCompanyResponse) GetPrevCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_PrevCompany
+	}
+	return nil
+}
+
+func (x *UpdateCompanyResponse) SetPrevCompany(v *Company) {
+	x.xxx_hidden_PrevCompany = v
+}
+
+func (x *UpdateCompanyResponse) HasPrevCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_PrevCompany != nil
+}
+
+func (x *UpdateCompanyResponse) ClearPrevCompany() {
+	x.xxx_hidden_PrevCompany = nil
+}
+
+type UpdateCompanyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PrevCompany *Company
+}
+
+func (b0 UpdateCompanyResponse_builder) Build() *UpdateCompanyResponse {
+	m0 := &UpdateCompanyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_PrevCompany = b.PrevCompany
+	return m0
+}
+
+type GetCompanyCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyCategoriesRequest) Reset() {
+	*x = GetCompanyCategoriesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCompanyCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetCompanyCategoriesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetCompanyCategoriesRequest_builder) Build() *GetCompanyCategoriesRequest {
+	m0 := &GetCompanyCategoriesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetCompanyCategoriesResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Categories *[]*CompanyCategory    `protobuf:"bytes,1,rep,name=categories"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetCompanyCategoriesResponse) Reset() {
+	*x = GetCompanyCategoriesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCompanyCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCompanyCategoriesResponse) GetCategories() []*CompanyCategory {
+	if x != nil {
+		if x.xxx_hidden_Categories != nil {
+			return *x.xxx_hidden_Categories
+		}
+	}
+	return nil
+}
+
+func (x *GetCompanyCategoriesResponse) SetCategories(v []*CompanyCategory) {
+	x.xxx_hidden_Categories = &v
+}
+
+type GetCompanyCategoriesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Categories []*CompanyCategory
+}
+
+func (b0 GetCompanyCategoriesResponse_builder) Build() *GetCompanyCategoriesResponse {
+	m0 := &GetCompanyCategoriesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Categories = &b.Categories
+	return m0
+}
+
+type CreateCompanyRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NewCompany *Company               `protobuf:"bytes,1,opt,name=new_company,json=newCompany"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateCompanyRequest) GetNewCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_NewCompany
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) SetNewCompany(v *Company) {
+	x.xxx_hidden_NewCompany = v
+}
+
+func (x *CreateCompanyRequest) HasNewCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NewCompany != nil
+}
+
+func (x *CreateCompanyRequest) ClearNewCompany() {
+	x.xxx_hidden_NewCompany = nil
+}
+
+type CreateCompanyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	NewCompany *Company
+}
+
+func (b0 CreateCompanyRequest_builder) Build() *CreateCompanyRequest {
+	m0 := &CreateCompanyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_NewCompany = b.NewCompany
+	return m0
+}
+
+type CreateCompanyResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Company           *Company               `protobuf:"bytes,1,opt,name=company"`
+	xxx_hidden_TemplateFileCount int32                  `protobuf:"varint,2,opt,name=template_file_count,json=templateFileCount"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CreateCompanyResponse) Reset() {
+	*x = CreateCompanyResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyResponse) ProtoMessage() {}
+
+func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_Company
+	}
+	return nil
+}
+
+func (x *CreateCompanyResponse) GetTemplateFileCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TemplateFileCount
+	}
+	return 0
+}
+
+func (x *CreateCompanyResponse) SetCompany(v *Company) {
+	x.xxx_hidden_Company = v
+}
+
+func (x *CreateCompanyResponse) SetTemplateFileCount(v int32) {
+	x.xxx_hidden_TemplateFileCount = v
+}
+
+func (x *CreateCompanyResponse) HasCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Company != nil
+}
+
+func (x *CreateCompanyResponse) ClearCompany() {
+	x.xxx_hidden_Company = nil
+}
+
+type CreateCompanyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Company           *Company
+	TemplateFileCount int32
+}
+
+func (b0 CreateCompanyResponse_builder) Build() *CreateCompanyResponse {
+	m0 := &CreateCompanyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Company = b.Company
+	x.xxx_hidden_TemplateFileCount = b.TemplateFileCount
+	return m0
+}
+
+type ArchiveCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCompanyRequest) Reset() {
+	*x = ArchiveCompanyRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCompanyRequest) ProtoMessage() {}
+
+func (x *ArchiveCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ArchiveCompanyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *ArchiveCompanyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type ArchiveCompanyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 ArchiveCompanyRequest_builder) Build() *ArchiveCompanyRequest {
+	m0 := &ArchiveCompanyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type ArchiveCompanyResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Company *Company               `protobuf:"bytes,1,opt,name=company"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ArchiveCompanyResponse) Reset() {
+	*x = ArchiveCompanyResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCompanyResponse) ProtoMessage() {}
+
+func (x *ArchiveCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ArchiveCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_Company
+	}
+	return nil
+}
+
+func (x *ArchiveCompanyResponse) SetCompany(v *Company) {
+	x.xxx_hidden_Company = v
+}
+
+func (x *ArchiveCompanyResponse) HasCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Company != nil
+}
+
+func (x *ArchiveCompanyResponse) ClearCompany() {
+	x.xxx_hidden_Company = nil
+}
+
+type ArchiveCompanyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Company *Company
+}
+
+func (b0 ArchiveCompanyResponse_builder) Build() *ArchiveCompanyResponse {
+	m0 := &ArchiveCompanyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Company = b.Company
+	return m0
+}
+
+type RestoreCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCompanyRequest) Reset() {
+	*x = RestoreCompanyRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCompanyRequest) ProtoMessage() {}
+
+func (x *RestoreCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreCompanyRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *RestoreCompanyRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type RestoreCompanyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 RestoreCompanyRequest_builder) Build() *RestoreCompanyRequest {
+	m0 := &RestoreCompanyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type RestoreCompanyResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Company *Company               `protobuf:"bytes,1,opt,name=company"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RestoreCompanyResponse) Reset() {
+	*x = RestoreCompanyResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCompanyResponse) ProtoMessage() {}
+
+func (x *RestoreCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RestoreCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.xxx_hidden_Company
+	}
+	return nil
+}
+
+func (x *RestoreCompanyResponse) SetCompany(v *Company) {
+	x.xxx_hidden_Company = v
+}
+
+func (x *RestoreCompanyResponse) HasCompany() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Company != nil
+}
+
+func (x *RestoreCompanyResponse) ClearCompany() {
+	x.xxx_hidden_Company = nil
+}
+
+type RestoreCompanyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Company *Company
+}
+
+func (b0 RestoreCompanyResponse_builder) Build() *RestoreCompanyResponse {
+	m0 := &RestoreCompanyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Company = b.Company
+	return m0
+}
+
+// KojiService messages
+type GetKojiesRequest struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_IncludeFolderSizes bool                   `protobuf:"varint,1,opt,name=include_folder_sizes,json=includeFolderSizes"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *GetKojiesRequest) Reset() {
+	*x = GetKojiesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKojiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKojiesRequest) ProtoMessage() {}
+
+func (x *GetKojiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetKojiesRequest) GetIncludeFolderSizes() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeFolderSizes
+	}
+	return false
+}
+
+func (x *GetKojiesRequest) SetIncludeFolderSizes(v bool) {
+	x.xxx_hidden_IncludeFolderSizes = v
+}
+
+type GetKojiesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	IncludeFolderSizes bool
+}
+
+func (b0 GetKojiesRequest_builder) Build() *GetKojiesRequest {
+	m0 := &GetKojiesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_IncludeFolderSizes = b.IncludeFolderSizes
+	return m0
+}
+
+type GetKojiesResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Kojies      map[string]*Koji       `protobuf:"bytes,1,rep,name=kojies" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Generation  uint64                 `protobuf:"varint,2,opt,name=generation"`
+	xxx_hidden_FolderSizes map[string]int64       `protobuf:"bytes,3,rep,name=folder_sizes,json=folderSizes" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetKojiesResponse) Reset() {
+	*x = GetKojiesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKojiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKojiesResponse) ProtoMessage() {}
+
+func (x *GetKojiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetKojiesResponse) GetKojies() map[string]*Koji {
+	if x != nil {
+		return x.xxx_hidden_Kojies
+	}
+	return nil
+}
+
+func (x *GetKojiesResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Generation
+	}
+	return 0
+}
+
+func (x *GetKojiesResponse) GetFolderSizes() map[string]int64 {
+	if x != nil {
+		return x.xxx_hidden_FolderSizes
+	}
+	return nil
+}
+
+func (x *GetKojiesResponse) SetKojies(v map[string]*Koji) {
+	x.xxx_hidden_Kojies = v
+}
+
+func (x *GetKojiesResponse) SetGeneration(v uint64) {
+	x.xxx_hidden_Generation = v
+}
+
+func (x *GetKojiesResponse) SetFolderSizes(v map[string]int64) {
+	x.xxx_hidden_FolderSizes = v
+}
+
+type GetKojiesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Kojies      map[string]*Koji
+	Generation  uint64
+	FolderSizes map[string]int64
+}
+
+func (b0 GetKojiesResponse_builder) Build() *GetKojiesResponse {
+	m0 := &GetKojiesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Kojies = b.Kojies
+	x.xxx_hidden_Generation = b.Generation
+	x.xxx_hidden_FolderSizes = b.FolderSizes
+	return m0
+}
+
+type GetKojiRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKojiRequest) Reset() {
+	*x = GetKojiRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKojiRequest) ProtoMessage() {}
+
+func (x *GetKojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetKojiRequest) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *GetKojiRequest) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+type GetKojiRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 GetKojiRequest_builder) Build() *GetKojiRequest {
+	m0 := &GetKojiRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	return m0
+}
+
+type GetKojiResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Koji *Koji                  `protobuf:"bytes,1,opt,name=koji"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetKojiResponse) Reset() {
+	*x = GetKojiResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKojiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKojiResponse) ProtoMessage() {}
+
+func (x *GetKojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetKojiResponse) GetKoji() *Koji {
+	if x != nil {
+		return x.xxx_hidden_Koji
+	}
+	return nil
+}
+
+func (x *GetKojiResponse) SetKoji(v *Koji) {
+	x.xxx_hidden_Koji = v
+}
+
+func (x *GetKojiResponse) HasKoji() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Koji != nil
+}
+
+func (x *GetKojiResponse) ClearKoji() {
+	x.xxx_hidden_Koji = nil
+}
+
+type GetKojiResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Koji *Koji
+}
+
+func (b0 GetKojiResponse_builder) Build() *GetKojiResponse {
+	m0 := &GetKojiResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Koji = b.Koji
+	return m0
+}
+
+type CreateKojiRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NewKoji *Koji                  `protobuf:"bytes,1,opt,name=new_koji,json=newKoji"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateKojiRequest) Reset() {
+	*x = CreateKojiRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKojiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*CreateKojiRequest) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[10]
+func (x *CreateKojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,53 +8086,65 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCompanyRequest) GetId() string {
+func (x *CreateKojiRequest) GetNewKoji() *Koji {
 	if x != nil {
-		return x.xxx_hidden_Id
+		return x.xxx_hidden_NewKoji
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCompanyRequest) SetId(v string) {
-	x.xxx_hidden_Id = v
+func (x *CreateKojiRequest) SetNewKoji(v *Koji) {
+	x.xxx_hidden_NewKoji = v
 }
 
-type GetCompanyRequest_builder struct {
+func (x *CreateKojiRequest) HasNewKoji() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_NewKoji != nil
+}
+
+func (x *CreateKojiRequest) ClearNewKoji() {
+	x.xxx_hidden_NewKoji = nil
+}
+
+type CreateKojiRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id string
+	NewKoji *Koji
 }
 
-func (b0 GetCompanyRequest_builder) Build() *GetCompanyRequest {
-	m0 := &GetCompanyRequest{}
+func (b0 CreateKojiRequest_builder) Build() *CreateKojiRequest {
+	m0 := &CreateKojiRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_NewKoji = b.NewKoji
 	return m0
 }
 
-type GetCompanyResponse struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Company *Company               `protobuf:"bytes,1,opt,name=company"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreateKojiResponse struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Koji              *Koji                  `protobuf:"bytes,1,opt,name=koji"`
+	xxx_hidden_TemplateFileCount int32                  `protobuf:"varint,2,opt,name=template_file_count,json=templateFileCount"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
-func (x *GetCompanyResponse) Reset() {
-	*x = GetCompanyResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[11]
+func (x *CreateKojiResponse) Reset() {
+	*x = CreateKojiResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) String() string {
+func (x *CreateKojiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyResponse) ProtoMessage() {}
+func (*CreateKojiResponse) ProtoMessage() {}
 
-func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[11]
+func (x *CreateKojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,65 +8155,77 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCompanyResponse) GetCompany() *Company {
+func (x *CreateKojiResponse) GetKoji() *Koji {
 	if x != nil {
-		return x.xxx_hidden_Company
+		return x.xxx_hidden_Koji
 	}
 	return nil
 }
 
-func (x *GetCompanyResponse) SetCompany(v *Company) {
-	x.xxx_hidden_Company = v
+func (x *CreateKojiResponse) GetTemplateFileCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_TemplateFileCount
+	}
+	return 0
 }
 
-func (x *GetCompanyResponse) HasCompany() bool {
+func (x *CreateKojiResponse) SetKoji(v *Koji) {
+	x.xxx_hidden_Koji = v
+}
+
+func (x *CreateKojiResponse) SetTemplateFileCount(v int32) {
+	x.xxx_hidden_TemplateFileCount = v
+}
+
+func (x *CreateKojiResponse) HasKoji() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Company != nil
+	return x.xxx_hidden_Koji != nil
 }
 
-func (x *GetCompanyResponse) ClearCompany() {
-	x.xxx_hidden_Company = nil
+func (x *CreateKojiResponse) ClearKoji() {
+	x.xxx_hidden_Koji = nil
 }
 
-type GetCompanyResponse_builder struct {
+type CreateKojiResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Company *Company
+	Koji              *Koji
+	TemplateFileCount int32
 }
 
-func (b0 GetCompanyResponse_builder) Build() *GetCompanyResponse {
-	m0 := &GetCompanyResponse{}
+func (b0 CreateKojiResponse_builder) Build() *CreateKojiResponse {
+	m0 := &CreateKojiResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Company = b.Company
+	x.xxx_hidden_Koji = b.Koji
+	x.xxx_hidden_TemplateFileCount = b.TemplateFileCount
 	return m0
 }
 
-type UpdateCompanyRequest struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PrevId     string                 `protobuf:"bytes,1,opt,name=prev_id,json=prevId"`
-	xxx_hidden_NewCompany *Company               `protobuf:"bytes,2,opt,name=new_company,json=newCompany"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type UpdateKojiRequest struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_NewKoji *Koji                  `protobuf:"bytes,1,opt,name=new_koji,json=newKoji"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) Reset() {
-	*x = UpdateCompanyRequest{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[12]
+func (x *UpdateKojiRequest) Reset() {
+	*x = UpdateKojiRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) String() string {
+func (x *UpdateKojiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyRequest) ProtoMessage() {}
+func (*UpdateKojiRequest) ProtoMessage() {}
 
-func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[12]
+func (x *UpdateKojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,77 +8236,64 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateCompanyRequest) GetPrevId() string {
-	if x != nil {
-		return x.xxx_hidden_PrevId
-	}
-	return ""
-}
-
-func (x *UpdateCompanyRequest) GetNewCompany() *Company {
+func (x *UpdateKojiRequest) GetNewKoji() *Koji {
 	if x != nil {
-		return x.xxx_hidden_NewCompany
+		return x.xxx_hidden_NewKoji
 	}
 	return nil
 }
 
-func (x *UpdateCompanyRequest) SetPrevId(v string) {
-	x.xxx_hidden_PrevId = v
-}
-
-func (x *UpdateCompanyRequest) SetNewCompany(v *Company) {
-	x.xxx_hidden_NewCompany = v
+func (x *UpdateKojiRequest) SetNewKoji(v *Koji) {
+	x.xxx_hidden_NewKoji = v
 }
 
-func (x *UpdateCompanyRequest) HasNewCompany() bool {
+func (x *UpdateKojiRequest) HasNewKoji() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_NewCompany != nil
+	return x.xxx_hidden_NewKoji != nil
 }
 
-func (x *UpdateCompanyRequest) ClearNewCompany() {
-	x.xxx_hidden_NewCompany = nil
+func (x *UpdateKojiRequest) ClearNewKoji() {
+	x.xxx_hidden_NewKoji = nil
 }
 
-type UpdateCompanyRequest_builder struct {
+type UpdateKojiRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	PrevId     string
-	NewCompany *Company
+	NewKoji *Koji
 }
 
-func (b0 UpdateCompanyRequest_builder) Build() *UpdateCompanyRequest {
-	m0 := &UpdateCompanyRequest{}
+func (b0 UpdateKojiRequest_builder) Build() *UpdateKojiRequest {
+	m0 := &UpdateKojiRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_PrevId = b.PrevId
-	x.xxx_hidden_NewCompany = b.NewCompany
+	x.xxx_hidden_NewKoji = b.NewKoji
 	return m0
 }
 
-type UpdateCompanyResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PrevCompany *Company               `protobuf:"bytes,1,opt,name=prev_company,json=prevCompany"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type UpdateKojiResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PrevKoji *Koji                  `protobuf:"bytes,1,opt,name=prev_koji,json=prevKoji"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyResponse) Reset() {
-	*x = UpdateCompanyResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[13]
+func (x *UpdateKojiResponse) Reset() {
+	*x = UpdateKojiResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyResponse) String() string {
+func (x *UpdateKojiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyResponse) ProtoMessage() {}
+func (*UpdateKojiResponse) ProtoMessage() {}
 
-func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[13]
+func (x *UpdateKojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,63 +8304,66 @@ func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateCompanyResponse) GetPrevCompany() *Company {
+func (x *UpdateKojiResponse) GetPrevKoji() *Koji {
 	if x != nil {
-		return x.xxx_hidden_PrevCompany
+		return x.xxx_hidden_PrevKoji
 	}
 	return nil
 }
 
-func (x *UpdateCompanyResponse) SetPrevCompany(v *Company) {
-	x.xxx_hidden_PrevCompany = v
+func (x *UpdateKojiResponse) SetPrevKoji(v *Koji) {
+	x.xxx_hidden_PrevKoji = v
 }
 
-func (x *UpdateCompanyResponse) HasPrevCompany() bool {
+func (x *UpdateKojiResponse) HasPrevKoji() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_PrevCompany != nil
+	return x.xxx_hidden_PrevKoji != nil
 }
 
-func (x *UpdateCompanyResponse) ClearPrevCompany() {
-	x.xxx_hidden_PrevCompany = nil
+func (x *UpdateKojiResponse) ClearPrevKoji() {
+	x.xxx_hidden_PrevKoji = nil
 }
 
-type UpdateCompanyResponse_builder struct {
+type UpdateKojiResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	PrevCompany *Company
+	PrevKoji *Koji
 }
 
-func (b0 UpdateCompanyResponse_builder) Build() *UpdateCompanyResponse {
-	m0 := &UpdateCompanyResponse{}
+func (b0 UpdateKojiResponse_builder) Build() *UpdateKojiResponse {
+	m0 := &UpdateKojiResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_PrevCompany = b.PrevCompany
+	x.xxx_hidden_PrevKoji = b.PrevKoji
 	return m0
 }
 
-type GetCompanyCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// MultiMediaService messages
+type GetThumbnailRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	xxx_hidden_Size          int32                  `protobuf:"varint,2,opt,name=size"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetCompanyCategoriesRequest) Reset() {
-	*x = GetCompanyCategoriesRequest{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[14]
+func (x *GetThumbnailRequest) Reset() {
+	*x = GetThumbnailRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyCategoriesRequest) String() string {
+func (x *GetThumbnailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyCategoriesRequest) ProtoMessage() {}
+func (*GetThumbnailRequest) ProtoMessage() {}
 
-func (x *GetCompanyCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[14]
+func (x *GetThumbnailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,40 +8374,70 @@ func (x *GetCompanyCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type GetCompanyCategoriesRequest_builder struct {
+func (x *GetThumbnailRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetThumbnailRequest) GetSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *GetThumbnailRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+func (x *GetThumbnailRequest) SetSize(v int32) {
+	x.xxx_hidden_Size = v
+}
+
+type GetThumbnailRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	PathistFolder string
+	Size          int32
 }
 
-func (b0 GetCompanyCategoriesRequest_builder) Build() *GetCompanyCategoriesRequest {
-	m0 := &GetCompanyCategoriesRequest{}
+func (b0 GetThumbnailRequest_builder) Build() *GetThumbnailRequest {
+	m0 := &GetThumbnailRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
+	x.xxx_hidden_Size = b.Size
 	return m0
 }
 
-type GetCompanyCategoriesResponse struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Categories *[]*CompanyCategory    `protobuf:"bytes,1,rep,name=categories"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetThumbnailResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data     []byte                 `protobuf:"bytes,1,opt,name=data"`
+	xxx_hidden_MimeType string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType"`
+	xxx_hidden_Width    int32                  `protobuf:"varint,3,opt,name=width"`
+	xxx_hidden_Height   int32                  `protobuf:"varint,4,opt,name=height"`
+	xxx_hidden_Digest   string                 `protobuf:"bytes,5,opt,name=digest"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetCompanyCategoriesResponse) Reset() {
-	*x = GetCompanyCategoriesResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[15]
+func (x *GetThumbnailResponse) Reset() {
+	*x = GetThumbnailResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyCategoriesResponse) String() string {
+func (x *GetThumbnailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyCategoriesResponse) ProtoMessage() {}
+func (*GetThumbnailResponse) ProtoMessage() {}
 
-func (x *GetCompanyCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[15]
+func (x *GetThumbnailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,58 +8445,111 @@ func (x *GetCompanyCategoriesResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+func (x *GetThumbnailResponse) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *GetThumbnailResponse) GetMimeType() string {
+	if x != nil {
+		return x.xxx_hidden_MimeType
+	}
+	return ""
+}
+
+func (x *GetThumbnailResponse) GetWidth() int32 {
+	if x != nil {
+		return x.xxx_hidden_Width
+	}
+	return 0
+}
+
+func (x *GetThumbnailResponse) GetHeight() int32 {
+	if x != nil {
+		return x.xxx_hidden_Height
+	}
+	return 0
+}
+
+func (x *GetThumbnailResponse) GetDigest() string {
+	if x != nil {
+		return x.xxx_hidden_Digest
+	}
+	return ""
 }
 
-func (x *GetCompanyCategoriesResponse) GetCategories() []*CompanyCategory {
-	if x != nil {
-		if x.xxx_hidden_Categories != nil {
-			return *x.xxx_hidden_Categories
-		}
+func (x *GetThumbnailResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
 	}
-	return nil
+	x.xxx_hidden_Data = v
 }
 
-func (x *GetCompanyCategoriesResponse) SetCategories(v []*CompanyCategory) {
-	x.xxx_hidden_Categories = &v
+func (x *GetThumbnailResponse) SetMimeType(v string) {
+	x.xxx_hidden_MimeType = v
 }
 
-type GetCompanyCategoriesResponse_builder struct {
+func (x *GetThumbnailResponse) SetWidth(v int32) {
+	x.xxx_hidden_Width = v
+}
+
+func (x *GetThumbnailResponse) SetHeight(v int32) {
+	x.xxx_hidden_Height = v
+}
+
+func (x *GetThumbnailResponse) SetDigest(v string) {
+	x.xxx_hidden_Digest = v
+}
+
+type GetThumbnailResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Categories []*CompanyCategory
+	Data     []byte
+	MimeType string
+	Width    int32
+	Height   int32
+	Digest   string
 }
 
-func (b0 GetCompanyCategoriesResponse_builder) Build() *GetCompanyCategoriesResponse {
-	m0 := &GetCompanyCategoriesResponse{}
+func (b0 GetThumbnailResponse_builder) Build() *GetThumbnailResponse {
+	m0 := &GetThumbnailResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Categories = &b.Categories
+	x.xxx_hidden_Data = b.Data
+	x.xxx_hidden_MimeType = b.MimeType
+	x.xxx_hidden_Width = b.Width
+	x.xxx_hidden_Height = b.Height
+	x.xxx_hidden_Digest = b.Digest
 	return m0
 }
 
-// KojiService messages
-type GetKojiesRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetMediaMetadataRequest struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PathistFolder string                 `protobuf:"bytes,1,opt,name=pathist_folder,json=pathistFolder"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetKojiesRequest) Reset() {
-	*x = GetKojiesRequest{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[16]
+func (x *GetMediaMetadataRequest) Reset() {
+	*x = GetMediaMetadataRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKojiesRequest) String() string {
+func (x *GetMediaMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKojiesRequest) ProtoMessage() {}
+func (*GetMediaMetadataRequest) ProtoMessage() {}
 
-func (x *GetKojiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[16]
+func (x *GetMediaMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,40 +8560,53 @@ func (x *GetKojiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-type GetKojiesRequest_builder struct {
+func (x *GetMediaMetadataRequest) GetPathistFolder() string {
+	if x != nil {
+		return x.xxx_hidden_PathistFolder
+	}
+	return ""
+}
+
+func (x *GetMediaMetadataRequest) SetPathistFolder(v string) {
+	x.xxx_hidden_PathistFolder = v
+}
+
+type GetMediaMetadataRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	PathistFolder string
 }
 
-func (b0 GetKojiesRequest_builder) Build() *GetKojiesRequest {
-	m0 := &GetKojiesRequest{}
+func (b0 GetMediaMetadataRequest_builder) Build() *GetMediaMetadataRequest {
+	m0 := &GetMediaMetadataRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_PathistFolder = b.PathistFolder
 	return m0
 }
 
-type GetKojiesResponse struct {
-	state             protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Kojies map[string]*Koji       `protobuf:"bytes,1,rep,name=kojies" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetMediaMetadataResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Metadata *MediaMetadata         `protobuf:"bytes,1,opt,name=metadata"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetKojiesResponse) Reset() {
-	*x = GetKojiesResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[17]
+func (x *GetMediaMetadataResponse) Reset() {
+	*x = GetMediaMetadataResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKojiesResponse) String() string {
+func (x *GetMediaMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKojiesResponse) ProtoMessage() {}
+func (*GetMediaMetadataResponse) ProtoMessage() {}
 
-func (x *GetKojiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[17]
+func (x *GetMediaMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,53 +8617,64 @@ func (x *GetKojiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetKojiesResponse) GetKojies() map[string]*Koji {
+func (x *GetMediaMetadataResponse) GetMetadata() *MediaMetadata {
 	if x != nil {
-		return x.xxx_hidden_Kojies
+		return x.xxx_hidden_Metadata
 	}
 	return nil
 }
 
-func (x *GetKojiesResponse) SetKojies(v map[string]*Koji) {
-	x.xxx_hidden_Kojies = v
+func (x *GetMediaMetadataResponse) SetMetadata(v *MediaMetadata) {
+	x.xxx_hidden_Metadata = v
 }
 
-type GetKojiesResponse_builder struct {
+func (x *GetMediaMetadataResponse) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *GetMediaMetadataResponse) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+type GetMediaMetadataResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Kojies map[string]*Koji
+	Metadata *MediaMetadata
 }
 
-func (b0 GetKojiesResponse_builder) Build() *GetKojiesResponse {
-	m0 := &GetKojiesResponse{}
+func (b0 GetMediaMetadataResponse_builder) Build() *GetMediaMetadataResponse {
+	m0 := &GetMediaMetadataResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Kojies = b.Kojies
+	x.xxx_hidden_Metadata = b.Metadata
 	return m0
 }
 
-type GetKojiRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id string                 `protobuf:"bytes,1,opt,name=id"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetKojiPhotoAlbumRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_KojiId string                 `protobuf:"bytes,1,opt,name=koji_id,json=kojiId"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetKojiRequest) Reset() {
-	*x = GetKojiRequest{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[18]
+func (x *GetKojiPhotoAlbumRequest) Reset() {
+	*x = GetKojiPhotoAlbumRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKojiRequest) String() string {
+func (x *GetKojiPhotoAlbumRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKojiRequest) ProtoMessage() {}
+func (*GetKojiPhotoAlbumRequest) ProtoMessage() {}
 
-func (x *GetKojiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[18]
+func (x *GetKojiPhotoAlbumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,53 +8685,57 @@ func (x *GetKojiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetKojiRequest) GetId() string {
+func (x *GetKojiPhotoAlbumRequest) GetKojiId() string {
 	if x != nil {
-		return x.xxx_hidden_Id
+		return x.xxx_hidden_KojiId
 	}
 	return ""
 }
 
-func (x *GetKojiRequest) SetId(v string) {
-	x.xxx_hidden_Id = v
+func (x *GetKojiPhotoAlbumRequest) SetKojiId(v string) {
+	x.xxx_hidden_KojiId = v
 }
 
-type GetKojiRequest_builder struct {
+type GetKojiPhotoAlbumRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id string
+	KojiId string
 }
 
-func (b0 GetKojiRequest_builder) Build() *GetKojiRequest {
-	m0 := &GetKojiRequest{}
+func (b0 GetKojiPhotoAlbumRequest_builder) Build() *GetKojiPhotoAlbumRequest {
+	m0 := &GetKojiPhotoAlbumRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_KojiId = b.KojiId
 	return m0
 }
 
-type GetKojiResponse struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Koji *Koji                  `protobuf:"bytes,1,opt,name=koji"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetKojiPhotoAlbumResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Koji         *Koji                  `protobuf:"bytes,1,opt,name=koji"`
+	xxx_hidden_Days         *[]*PhotoAlbumDay      `protobuf:"bytes,2,rep,name=days"`
+	xxx_hidden_Undated      *[]*PhotoAlbumItem     `protobuf:"bytes,3,rep,name=undated"`
+	xxx_hidden_PhotoCount   int32                  `protobuf:"varint,4,opt,name=photo_count,json=photoCount"`
+	xxx_hidden_OutsideCount int32                  `protobuf:"varint,5,opt,name=outside_count,json=outsideCount"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetKojiResponse) Reset() {
-	*x = GetKojiResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[19]
+func (x *GetKojiPhotoAlbumResponse) Reset() {
+	*x = GetKojiPhotoAlbumResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetKojiResponse) String() string {
+func (x *GetKojiPhotoAlbumResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKojiResponse) ProtoMessage() {}
+func (*GetKojiPhotoAlbumResponse) ProtoMessage() {}
 
-func (x *GetKojiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[19]
+func (x *GetKojiPhotoAlbumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,64 +8746,122 @@ func (x *GetKojiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetKojiResponse) GetKoji() *Koji {
+func (x *GetKojiPhotoAlbumResponse) GetKoji() *Koji {
 	if x != nil {
 		return x.xxx_hidden_Koji
 	}
 	return nil
 }
 
-func (x *GetKojiResponse) SetKoji(v *Koji) {
+func (x *GetKojiPhotoAlbumResponse) GetDays() []*PhotoAlbumDay {
+	if x != nil {
+		if x.xxx_hidden_Days != nil {
+			return *x.xxx_hidden_Days
+		}
+	}
+	return nil
+}
+
+func (x *GetKojiPhotoAlbumResponse) GetUndated() []*PhotoAlbumItem {
+	if x != nil {
+		if x.xxx_hidden_Undated != nil {
+			return *x.xxx_hidden_Undated
+		}
+	}
+	return nil
+}
+
+func (x *GetKojiPhotoAlbumResponse) GetPhotoCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_PhotoCount
+	}
+	return 0
+}
+
+func (x *GetKojiPhotoAlbumResponse) GetOutsideCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_OutsideCount
+	}
+	return 0
+}
+
+func (x *GetKojiPhotoAlbumResponse) SetKoji(v *Koji) {
 	x.xxx_hidden_Koji = v
 }
 
-func (x *GetKojiResponse) HasKoji() bool {
+func (x *GetKojiPhotoAlbumResponse) SetDays(v []*PhotoAlbumDay) {
+	x.xxx_hidden_Days = &v
+}
+
+func (x *GetKojiPhotoAlbumResponse) SetUndated(v []*PhotoAlbumItem) {
+	x.xxx_hidden_Undated = &v
+}
+
+func (x *GetKojiPhotoAlbumResponse) SetPhotoCount(v int32) {
+	x.xxx_hidden_PhotoCount = v
+}
+
+func (x *GetKojiPhotoAlbumResponse) SetOutsideCount(v int32) {
+	x.xxx_hidden_OutsideCount = v
+}
+
+func (x *GetKojiPhotoAlbumResponse) HasKoji() bool {
 	if x == nil {
 		return false
 	}
 	return x.xxx_hidden_Koji != nil
 }
 
-func (x *GetKojiResponse) ClearKoji() {
+func (x *GetKojiPhotoAlbumResponse) ClearKoji() {
 	x.xxx_hidden_Koji = nil
 }
 
-type GetKojiResponse_builder struct {
+type GetKojiPhotoAlbumResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Koji *Koji
+	Koji         *Koji
+	Days         []*PhotoAlbumDay
+	Undated      []*PhotoAlbumItem
+	PhotoCount   int32
+	OutsideCount int32
 }
 
-func (b0 GetKojiResponse_builder) Build() *GetKojiResponse {
-	m0 := &GetKojiResponse{}
+func (b0 GetKojiPhotoAlbumResponse_builder) Build() *GetKojiPhotoAlbumResponse {
+	m0 := &GetKojiPhotoAlbumResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Koji = b.Koji
+	x.xxx_hidden_Days = &b.Days
+	x.xxx_hidden_Undated = &b.Undated
+	x.xxx_hidden_PhotoCount = b.PhotoCount
+	x.xxx_hidden_OutsideCount = b.OutsideCount
 	return m0
 }
 
-type UpdateKojiRequest struct {
-	state              protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_NewKoji *Koji                  `protobuf:"bytes,1,opt,name=new_koji,json=newKoji"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// ChangeService messages
+type GetChangesRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SinceCursor string                 `protobuf:"bytes,1,opt,name=since_cursor,json=sinceCursor"`
+	xxx_hidden_Limit       int32                  `protobuf:"varint,2,opt,name=limit"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpdateKojiRequest) Reset() {
-	*x = UpdateKojiRequest{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[20]
+func (x *GetChangesRequest) Reset() {
+	*x = GetChangesRequest{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKojiRequest) String() string {
+func (x *GetChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKojiRequest) ProtoMessage() {}
+func (*GetChangesRequest) ProtoMessage() {}
 
-func (x *UpdateKojiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[20]
+func (x *GetChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,64 +8872,68 @@ func (x *UpdateKojiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateKojiRequest) GetNewKoji() *Koji {
+func (x *GetChangesRequest) GetSinceCursor() string {
 	if x != nil {
-		return x.xxx_hidden_NewKoji
+		return x.xxx_hidden_SinceCursor
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateKojiRequest) SetNewKoji(v *Koji) {
-	x.xxx_hidden_NewKoji = v
+func (x *GetChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
 }
 
-func (x *UpdateKojiRequest) HasNewKoji() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_NewKoji != nil
+func (x *GetChangesRequest) SetSinceCursor(v string) {
+	x.xxx_hidden_SinceCursor = v
 }
 
-func (x *UpdateKojiRequest) ClearNewKoji() {
-	x.xxx_hidden_NewKoji = nil
+func (x *GetChangesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
 }
 
-type UpdateKojiRequest_builder struct {
+type GetChangesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	NewKoji *Koji
+	SinceCursor string
+	Limit       int32
 }
 
-func (b0 UpdateKojiRequest_builder) Build() *UpdateKojiRequest {
-	m0 := &UpdateKojiRequest{}
+func (b0 GetChangesRequest_builder) Build() *GetChangesRequest {
+	m0 := &GetChangesRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_NewKoji = b.NewKoji
+	x.xxx_hidden_SinceCursor = b.SinceCursor
+	x.xxx_hidden_Limit = b.Limit
 	return m0
 }
 
-type UpdateKojiResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_PrevKoji *Koji                  `protobuf:"bytes,1,opt,name=prev_koji,json=prevKoji"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetChangesResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Changes       *[]*ChangeEntry        `protobuf:"bytes,1,rep,name=changes"`
+	xxx_hidden_NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor"`
+	xxx_hidden_ResetRequired bool                   `protobuf:"varint,3,opt,name=reset_required,json=resetRequired"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *UpdateKojiResponse) Reset() {
-	*x = UpdateKojiResponse{}
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[21]
+func (x *GetChangesResponse) Reset() {
+	*x = GetChangesResponse{}
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKojiResponse) String() string {
+func (x *GetChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKojiResponse) ProtoMessage() {}
+func (*GetChangesResponse) ProtoMessage() {}
 
-func (x *UpdateKojiResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[21]
+func (x *GetChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_v1_toyotachikuro_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,39 +8944,56 @@ func (x *UpdateKojiResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateKojiResponse) GetPrevKoji() *Koji {
+func (x *GetChangesResponse) GetChanges() []*ChangeEntry {
 	if x != nil {
-		return x.xxx_hidden_PrevKoji
+		if x.xxx_hidden_Changes != nil {
+			return *x.xxx_hidden_Changes
+		}
 	}
 	return nil
 }
 
-func (x *UpdateKojiResponse) SetPrevKoji(v *Koji) {
-	x.xxx_hidden_PrevKoji = v
+func (x *GetChangesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.xxx_hidden_NextCursor
+	}
+	return ""
 }
 
-func (x *UpdateKojiResponse) HasPrevKoji() bool {
-	if x == nil {
-		return false
+func (x *GetChangesResponse) GetResetRequired() bool {
+	if x != nil {
+		return x.xxx_hidden_ResetRequired
 	}
-	return x.xxx_hidden_PrevKoji != nil
+	return false
 }
 
-func (x *UpdateKojiResponse) ClearPrevKoji() {
-	x.xxx_hidden_PrevKoji = nil
+func (x *GetChangesResponse) SetChanges(v []*ChangeEntry) {
+	x.xxx_hidden_Changes = &v
 }
 
-type UpdateKojiResponse_builder struct {
+func (x *GetChangesResponse) SetNextCursor(v string) {
+	x.xxx_hidden_NextCursor = v
+}
+
+func (x *GetChangesResponse) SetResetRequired(v bool) {
+	x.xxx_hidden_ResetRequired = v
+}
+
+type GetChangesResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	PrevKoji *Koji
+	Changes       []*ChangeEntry
+	NextCursor    string
+	ResetRequired bool
 }
 
-func (b0 UpdateKojiResponse_builder) Build() *UpdateKojiResponse {
-	m0 := &UpdateKojiResponse{}
+func (b0 GetChangesResponse_builder) Build() *GetChangesResponse {
+	m0 := &GetChangesResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_PrevKoji = b.PrevKoji
+	x.xxx_hidden_Changes = &b.Changes
+	x.xxx_hidden_NextCursor = b.NextCursor
+	x.xxx_hidden_ResetRequired = b.ResetRequired
 	return m0
 }
 
@@ -1642,12 +9001,22 @@ var File_grpc_v1_toyotachikuro_proto protoreflect.FileDescriptor
 
 const file_grpc_v1_toyotachikuro_proto_rawDesc = "" +
 	"\n" +
-	"\x1bgrpc/v1/toyotachikuro.proto\x12\agrpc.v1\x1a!google/protobuf/go_features.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x01\n" +
+	"\x1bgrpc/v1/toyotachikuro.proto\x12\agrpc.v1\x1a!google/protobuf/go_features.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x02\n" +
 	"\x04File\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0epathist_folder\x18\x02 \x01(\tR\rpathistFolder\x12\x12\n" +
 	"\x04size\x18\x03 \x01(\x03R\x04size\x12?\n" +
-	"\rmodified_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fmodifiedTime\"\x9b\x03\n" +
+	"\rmodified_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fmodifiedTime\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1c\n" +
+	"\textension\x18\x06 \x01(\tR\textension\x12\x15\n" +
+	"\x06is_dir\x18\a \x01(\bR\x05isDir\x12%\n" +
+	"\x0esymlink_target\x18\b \x01(\tR\rsymlinkTarget\x12\x1b\n" +
+	"\tmime_type\x18\t \x01(\tR\bmimeType\x12\x16\n" +
+	"\x06hidden\x18\n" +
+	" \x01(\bR\x06hidden\x12\x16\n" +
+	"\x06system\x18\v \x01(\bR\x06system\x12\x1f\n" +
+	"\vchild_count\x18\f \x01(\x05R\n" +
+	"childCount\"\xc4\x03\n" +
 	"\aCompany\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0epathist_folder\x18\x02 \x01(\tR\rpathistFolder\x12\x1d\n" +
@@ -1663,7 +9032,8 @@ const file_grpc_v1_toyotachikuro_proto_rawDesc = "" +
 	"persistFax\x12#\n" +
 	"\rpersist_email\x18\n" +
 	" \x01(\tR\fpersistEmail\x12'\n" +
-	"\x0fpersist_website\x18\v \x01(\tR\x0epersistWebsite\"=\n" +
+	"\x0fpersist_website\x18\v \x01(\tR\x0epersistWebsite\x12'\n" +
+	"\x0fpersist_reading\x18\f \x01(\tR\x0epersistReading\"=\n" +
 	"\x0fCompanyCategory\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\"\x8c\x02\n" +
@@ -1675,21 +9045,336 @@ const file_grpc_v1_toyotachikuro_proto_rawDesc = "" +
 	"\fcompany_name\x18\x05 \x01(\tR\vcompanyName\x12#\n" +
 	"\rlocation_name\x18\x06 \x01(\tR\flocationName\x12;\n" +
 	"\vpersist_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"persistEnd\"8\n" +
+	"persistEnd\"\xb7\x01\n" +
+	"\vChangeEntry\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02op\x18\x04 \x01(\tR\x02op\x12%\n" +
+	"\x0epathist_folder\x18\x05 \x01(\tR\rpathistFolder\x12\x1b\n" +
+	"\tentity_id\x18\x06 \x01(\tR\bentityId\"2\n" +
+	"\fFileTransfer\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\tR\x03src\x12\x10\n" +
+	"\x03dst\x18\x02 \x01(\tR\x03dst\"B\n" +
+	"\x12FileOperationError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x13FileOperationResult\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\tR\x03src\x12\x10\n" +
+	"\x03dst\x18\x02 \x01(\tR\x03dst\x12\x0e\n" +
+	"\x02ok\x18\x03 \x01(\bR\x02ok\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\bR\askipped\x121\n" +
+	"\x05error\x18\x05 \x01(\v2\x1b.grpc.v1.FileOperationErrorR\x05error\"e\n" +
+	"\x0eBatchOperation\x12/\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1b.grpc.v1.BatchOperationKindR\x04kind\x12\x10\n" +
+	"\x03src\x18\x02 \x01(\tR\x03src\x12\x10\n" +
+	"\x03dst\x18\x03 \x01(\tR\x03dst\"\xe1\x01\n" +
+	"\x14BatchOperationResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x125\n" +
+	"\toperation\x18\x02 \x01(\v2\x17.grpc.v1.BatchOperationR\toperation\x12\x0e\n" +
+	"\x02ok\x18\x03 \x01(\bR\x02ok\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\bR\askipped\x12\x1f\n" +
+	"\vrolled_back\x18\x05 \x01(\bR\n" +
+	"rolledBack\x121\n" +
+	"\x05error\x18\x06 \x01(\v2\x1b.grpc.v1.FileOperationErrorR\x05error\"\xdc\x01\n" +
+	"\x0fFolderNameIssue\x12#\n" +
+	"\rrelative_path\x18\x01 \x01(\tR\frelativePath\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fnormalized_name\x18\x03 \x01(\tR\x0enormalizedName\x12\x1a\n" +
+	"\bconflict\x18\x04 \x01(\bR\bconflict\x12\x18\n" +
+	"\arenamed\x18\x05 \x01(\bR\arenamed\x121\n" +
+	"\x05error\x18\x06 \x01(\v2\x1b.grpc.v1.FileOperationErrorR\x05error\"\xdc\x01\n" +
+	"\tTrashItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x17original_pathist_folder\x18\x02 \x01(\tR\x15originalPathistFolder\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\x12=\n" +
+	"\fdeleted_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdeletedTime\x12\x15\n" +
+	"\x06is_dir\x18\x05 \x01(\bR\x05isDir\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"\xea\x01\n" +
+	"\vFileVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rrelative_path\x18\x02 \x01(\tR\frelativePath\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"saved_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tsavedTime\x12?\n" +
+	"\rmodified_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fmodifiedTime\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"\x84\x01\n" +
+	"\x0eDuplicateGroup\x12\x16\n" +
+	"\x06digest\x18\x01 \x01(\tR\x06digest\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12#\n" +
+	"\x05files\x18\x03 \x03(\v2\r.grpc.v1.FileR\x05files\x12!\n" +
+	"\fwasted_bytes\x18\x04 \x01(\x03R\vwastedBytes\"m\n" +
+	"\rFileSearchHit\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.grpc.v1.FileR\x04file\x12#\n" +
+	"\rrelative_path\x18\x02 \x01(\tR\frelativePath\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"7\n" +
+	"\vWorkbookRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"\xd1\x01\n" +
+	"\x14WorkbookSheetSummary\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06hidden\x18\x02 \x01(\bR\x06hidden\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x05R\browCount\x12!\n" +
+	"\fcolumn_count\x18\x04 \x01(\x05R\vcolumnCount\x12\x1d\n" +
+	"\n" +
+	"cell_count\x18\x05 \x01(\x05R\tcellCount\x12.\n" +
+	"\apreview\x18\x06 \x03(\v2\x14.grpc.v1.WorkbookRowR\apreview\"Q\n" +
+	"\x11WorkbookCellMatch\x12\x14\n" +
+	"\x05sheet\x18\x01 \x01(\tR\x05sheet\x12\x12\n" +
+	"\x04cell\x18\x02 \x01(\tR\x04cell\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xc8\x01\n" +
+	"\x11WorkbookSearchHit\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.grpc.v1.FileR\x04file\x12#\n" +
+	"\rrelative_path\x18\x02 \x01(\tR\frelativePath\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x124\n" +
+	"\amatches\x18\x04 \x03(\v2\x1a.grpc.v1.WorkbookCellMatchR\amatches\x12\x1f\n" +
+	"\vmatch_count\x18\x05 \x01(\x05R\n" +
+	"matchCount\"\x93\x01\n" +
+	"\x0eDiskUsageEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rrelative_path\x18\x02 \x01(\tR\frelativePath\x12\x15\n" +
+	"\x06is_dir\x18\x03 \x01(\bR\x05isDir\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x05 \x01(\x03R\tfileCount\"\xe2\x02\n" +
+	"\rMediaMetadata\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.grpc.v1.FileR\x04file\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\x12 \n" +
+	"\vorientation\x18\x04 \x01(\x05R\vorientation\x12=\n" +
+	"\fcapture_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcaptureTime\x12\x1f\n" +
+	"\vcamera_make\x18\x06 \x01(\tR\n" +
+	"cameraMake\x12!\n" +
+	"\fcamera_model\x18\a \x01(\tR\vcameraModel\x12!\n" +
+	"\fhas_location\x18\b \x01(\bR\vhasLocation\x12\x1a\n" +
+	"\blatitude\x18\t \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\x01R\tlongitude\"\x90\x01\n" +
+	"\x0ePhotoAlbumItem\x122\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x16.grpc.v1.MediaMetadataR\bmetadata\x12#\n" +
+	"\rrelative_path\x18\x02 \x01(\tR\frelativePath\x12%\n" +
+	"\x0eoutside_period\x18\x03 \x01(\bR\routsidePeriod\"{\n" +
+	"\rPhotoAlbumDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12/\n" +
+	"\x06photos\x18\x02 \x03(\v2\x17.grpc.v1.PhotoAlbumItemR\x06photos\x12%\n" +
+	"\x0eoutside_period\x18\x03 \x01(\bR\routsidePeriod\"\xf4\x03\n" +
 	"\x0fGetFilesRequest\x12%\n" +
-	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\"7\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12\x14\n" +
+	"\x05globs\x18\x03 \x03(\tR\x05globs\x12\x1e\n" +
+	"\n" +
+	"extensions\x18\x04 \x03(\tR\n" +
+	"extensions\x12\x19\n" +
+	"\bmin_size\x18\x05 \x01(\x03R\aminSize\x12\x19\n" +
+	"\bmax_size\x18\x06 \x01(\x03R\amaxSize\x12A\n" +
+	"\x0emodified_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rmodifiedAfter\x12C\n" +
+	"\x0fmodified_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0emodifiedBefore\x12/\n" +
+	"\bsort_key\x18\t \x01(\x0e2\x14.grpc.v1.FileSortKeyR\asortKey\x12\x1e\n" +
+	"\n" +
+	"descending\x18\n" +
+	" \x01(\bR\n" +
+	"descending\x12#\n" +
+	"\rfolders_first\x18\v \x01(\bR\ffoldersFirst\x12\x1b\n" +
+	"\tpage_size\x18\f \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\"\x80\x01\n" +
 	"\x10GetFilesResponse\x12#\n" +
-	"\x05files\x18\x01 \x03(\v2\r.grpc.v1.FileR\x05files\"\x1d\n" +
+	"\x05files\x18\x01 \x03(\v2\r.grpc.v1.FileR\x05files\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x1d\n" +
 	"\x1bGetFilePathistFolderRequest\"E\n" +
 	"\x1cGetFilePathistFolderResponse\x12%\n" +
-	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\"/\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\"\x84\x01\n" +
+	"\x10CopyFilesRequest\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.grpc.v1.FileTransferR\x05items\x12C\n" +
+	"\x10overwrite_policy\x18\x02 \x01(\x0e2\x18.grpc.v1.OverwritePolicyR\x0foverwritePolicy\"K\n" +
+	"\x11CopyFilesResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.grpc.v1.FileOperationResultR\aresults\"\x84\x01\n" +
+	"\x10MoveFilesRequest\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.grpc.v1.FileTransferR\x05items\x12C\n" +
+	"\x10overwrite_policy\x18\x02 \x01(\x0e2\x18.grpc.v1.OverwritePolicyR\x0foverwritePolicy\"K\n" +
+	"\x11MoveFilesResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.grpc.v1.FileOperationResultR\aresults\"=\n" +
+	"\x12DeleteFilesRequest\x12'\n" +
+	"\x0fpathist_folders\x18\x01 \x03(\tR\x0epathistFolders\"M\n" +
+	"\x13DeleteFilesResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.grpc.v1.FileOperationResultR\aresults\"V\n" +
+	"\x13CreateFolderRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x18\n" +
+	"\aparents\x18\x02 \x01(\bR\aparents\"=\n" +
+	"\x14CreateFolderResponse\x12%\n" +
+	"\x06folder\x18\x01 \x01(\v2\r.grpc.v1.FileR\x06folder\"\x12\n" +
+	"\x10ListTrashRequest\"=\n" +
+	"\x11ListTrashResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.grpc.v1.TrashItemR\x05items\"p\n" +
+	"\x17RestoreFromTrashRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12C\n" +
+	"\x10overwrite_policy\x18\x02 \x01(\x0e2\x18.grpc.v1.OverwritePolicyR\x0foverwritePolicy\"R\n" +
+	"\x18RestoreFromTrashResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.grpc.v1.FileOperationResultR\aresults\"7\n" +
+	"\x11PurgeTrashRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"L\n" +
+	"\x12PurgeTrashResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.grpc.v1.FileOperationResultR\aresults\"\x8b\x01\n" +
+	"\x13DownloadFileRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x04 \x01(\x05R\tchunkSize\"\xa2\x01\n" +
+	"\x14DownloadFileResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12?\n" +
+	"\rmodified_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fmodifiedTime\"\x92\x02\n" +
+	"\x11UploadFileRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12)\n" +
+	"\x10checksum_blake2b\x18\x04 \x01(\tR\x0fchecksumBlake2b\x12C\n" +
+	"\x10overwrite_policy\x18\x05 \x01(\x0e2\x18.grpc.v1.OverwritePolicyR\x0foverwritePolicy\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\a \x01(\fR\x04data\"\x97\x01\n" +
+	"\x12UploadFileResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12#\n" +
+	"\rreceived_size\x18\x02 \x01(\x03R\freceivedSize\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\x12!\n" +
+	"\x04file\x18\x04 \x01(\v2\r.grpc.v1.FileR\x04file\"Y\n" +
+	"\x15FindDuplicatesRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x19\n" +
+	"\bmin_size\x18\x02 \x01(\x03R\aminSize\"\x91\x01\n" +
+	"\x16FindDuplicatesResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.grpc.v1.DuplicateGroupR\x06groups\x12!\n" +
+	"\fwasted_bytes\x18\x02 \x01(\x03R\vwastedBytes\x12#\n" +
+	"\rscanned_count\x18\x03 \x01(\x05R\fscannedCount\"g\n" +
+	"\x12SearchFilesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12%\n" +
+	"\x0epathist_folder\x18\x02 \x01(\tR\rpathistFolder\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x83\x01\n" +
+	"\x13SearchFilesResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.grpc.v1.FileSearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vindex_ready\x18\x03 \x01(\bR\n" +
+	"indexReady\"e\n" +
+	"\x19GetWorkbookSummaryRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12!\n" +
+	"\fpreview_rows\x18\x02 \x01(\x05R\vpreviewRows\"\x94\x01\n" +
+	"\x1aGetWorkbookSummaryResponse\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.grpc.v1.FileR\x04file\x125\n" +
+	"\x06sheets\x18\x02 \x03(\v2\x1d.grpc.v1.WorkbookSheetSummaryR\x06sheets\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"k\n" +
+	"\x16SearchWorkbooksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12%\n" +
+	"\x0epathist_folder\x18\x02 \x01(\tR\rpathistFolder\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x8b\x01\n" +
+	"\x17SearchWorkbooksResponse\x12.\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1a.grpc.v1.WorkbookSearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vindex_ready\x18\x03 \x01(\bR\n" +
+	"indexReady\"\xb9\x01\n" +
+	"\x14ExportArchiveRequest\x12'\n" +
+	"\x0fpathist_folders\x18\x01 \x03(\tR\x0epathistFolders\x12\x17\n" +
+	"\akoji_id\x18\x02 \x01(\tR\x06kojiId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\tR\tcompanyId\x12!\n" +
+	"\farchive_name\x18\x04 \x01(\tR\varchiveName\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x05 \x01(\x05R\tchunkSize\"\xc7\x01\n" +
+	"\x15ExportArchiveResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\farchive_name\x18\x02 \x01(\tR\varchiveName\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x04 \x01(\x05R\tfileCount\x12#\n" +
+	"\rskipped_count\x18\x05 \x01(\x05R\fskippedCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x06 \x01(\x03R\n" +
+	"totalBytes\"\xb1\x01\n" +
+	"\x13GetDiskUsageRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x17\n" +
+	"\akoji_id\x18\x02 \x01(\tR\x06kojiId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\tR\tcompanyId\x12!\n" +
+	"\ftop_children\x18\x04 \x01(\x05R\vtopChildren\x12\x18\n" +
+	"\arefresh\x18\x05 \x01(\bR\arefresh\"\xa1\x02\n" +
+	"\x14GetDiskUsageResponse\x12#\n" +
+	"\rrelative_path\x18\x01 \x01(\tR\frelativePath\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x03 \x01(\x03R\tfileCount\x12!\n" +
+	"\ffolder_count\x18\x04 \x01(\x03R\vfolderCount\x12#\n" +
+	"\rskipped_count\x18\x05 \x01(\x03R\fskippedCount\x12B\n" +
+	"\x10largest_children\x18\x06 \x03(\v2\x17.grpc.v1.DiskUsageEntryR\x0flargestChildren\x12%\n" +
+	"\x0echildren_count\x18\a \x01(\x05R\rchildrenCount\"@\n" +
+	"\x17ListFileVersionsRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\"u\n" +
+	"\x18ListFileVersionsResponse\x120\n" +
+	"\bversions\x18\x01 \x03(\v2\x14.grpc.v1.FileVersionR\bversions\x12'\n" +
+	"\acurrent\x18\x02 \x01(\v2\r.grpc.v1.FileR\acurrent\"a\n" +
+	"\x19RestoreFileVersionRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"i\n" +
+	"\x1aRestoreFileVersionResponse\x12!\n" +
+	"\x04file\x18\x01 \x01(\v2\r.grpc.v1.FileR\x04file\x12(\n" +
+	"\x10saved_version_id\x18\x02 \x01(\tR\x0esavedVersionId\"d\n" +
+	"\x10BatchPlanRequest\x127\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\x17.grpc.v1.BatchOperationR\n" +
+	"operations\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xb8\x01\n" +
+	"\x11BatchPlanResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.grpc.v1.BatchOperationResultR\aresults\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x1a\n" +
+	"\bexecuted\x18\x03 \x01(\bR\bexecuted\x12\x1f\n" +
+	"\vrolled_back\x18\x04 \x01(\bR\n" +
+	"rolledBack\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x05 \x01(\tR\tjournalId\"]\n" +
+	"\x1bNormalizeFolderNamesRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"u\n" +
+	"\x1cNormalizeFolderNamesResponse\x120\n" +
+	"\x06issues\x18\x01 \x03(\v2\x18.grpc.v1.FolderNameIssueR\x06issues\x12#\n" +
+	"\rrenamed_count\x18\x02 \x01(\x05R\frenamedCount\"\x9b\x03\n" +
 	"\x13GetCompaniesRequest\x12\x18\n" +
-	"\arefresh\x18\x01 \x01(\bR\arefresh\"\xb2\x01\n" +
+	"\arefresh\x18\x01 \x01(\bR\arefresh\x120\n" +
+	"\x14include_folder_sizes\x18\x02 \x01(\bR\x12includeFolderSizes\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\x12)\n" +
+	"\x10category_indexes\x18\x04 \x03(\x05R\x0fcategoryIndexes\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12!\n" +
+	"\fempty_fields\x18\x06 \x03(\tR\vemptyFields\x12(\n" +
+	"\x10non_empty_fields\x18\a \x03(\tR\x0enonEmptyFields\x122\n" +
+	"\bsort_key\x18\b \x01(\x0e2\x17.grpc.v1.CompanySortKeyR\asortKey\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\n" +
+	" \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\v \x01(\tR\tpageToken\"\xed\x03\n" +
 	"\x14GetCompaniesResponse\x12J\n" +
-	"\tcompanies\x18\x01 \x03(\v2,.grpc.v1.GetCompaniesResponse.CompaniesEntryR\tcompanies\x1aN\n" +
+	"\tcompanies\x18\x01 \x03(\v2,.grpc.v1.GetCompaniesResponse.CompaniesEntryR\tcompanies\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x02 \x01(\x04R\n" +
+	"generation\x12Q\n" +
+	"\ffolder_sizes\x18\x03 \x03(\v2..grpc.v1.GetCompaniesResponse.FolderSizesEntryR\vfolderSizes\x12=\n" +
+	"\x11ordered_companies\x18\x04 \x03(\v2\x10.grpc.v1.CompanyR\x10orderedCompanies\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x06 \x01(\x05R\n" +
+	"totalCount\x1aN\n" +
 	"\x0eCompaniesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
-	"\x05value\x18\x02 \x01(\v2\x10.grpc.v1.CompanyR\x05value:\x028\x01\"#\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.grpc.v1.CompanyR\x05value:\x028\x01\x1a>\n" +
+	"\x10FolderSizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"#\n" +
 	"\x11GetCompanyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"\x12GetCompanyResponse\x12*\n" +
@@ -1704,104 +9389,414 @@ const file_grpc_v1_toyotachikuro_proto_rawDesc = "" +
 	"\x1cGetCompanyCategoriesResponse\x128\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x18.grpc.v1.CompanyCategoryR\n" +
-	"categories\"\x12\n" +
-	"\x10GetKojiesRequest\"\x9d\x01\n" +
+	"categories\"I\n" +
+	"\x14CreateCompanyRequest\x121\n" +
+	"\vnew_company\x18\x01 \x01(\v2\x10.grpc.v1.CompanyR\n" +
+	"newCompany\"s\n" +
+	"\x15CreateCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.grpc.v1.CompanyR\acompany\x12.\n" +
+	"\x13template_file_count\x18\x02 \x01(\x05R\x11templateFileCount\"'\n" +
+	"\x15ArchiveCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x16ArchiveCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.grpc.v1.CompanyR\acompany\"'\n" +
+	"\x15RestoreCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x16RestoreCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.grpc.v1.CompanyR\acompany\"D\n" +
+	"\x10GetKojiesRequest\x120\n" +
+	"\x14include_folder_sizes\x18\x01 \x01(\bR\x12includeFolderSizes\"\xcd\x02\n" +
 	"\x11GetKojiesResponse\x12>\n" +
-	"\x06kojies\x18\x01 \x03(\v2&.grpc.v1.GetKojiesResponse.KojiesEntryR\x06kojies\x1aH\n" +
+	"\x06kojies\x18\x01 \x03(\v2&.grpc.v1.GetKojiesResponse.KojiesEntryR\x06kojies\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x02 \x01(\x04R\n" +
+	"generation\x12N\n" +
+	"\ffolder_sizes\x18\x03 \x03(\v2+.grpc.v1.GetKojiesResponse.FolderSizesEntryR\vfolderSizes\x1aH\n" +
 	"\vKojiesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
-	"\x05value\x18\x02 \x01(\v2\r.grpc.v1.KojiR\x05value:\x028\x01\" \n" +
+	"\x05value\x18\x02 \x01(\v2\r.grpc.v1.KojiR\x05value:\x028\x01\x1a>\n" +
+	"\x10FolderSizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\" \n" +
 	"\x0eGetKojiRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x0fGetKojiResponse\x12!\n" +
 	"\x04koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\x04koji\"=\n" +
+	"\x11CreateKojiRequest\x12(\n" +
+	"\bnew_koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\anewKoji\"g\n" +
+	"\x12CreateKojiResponse\x12!\n" +
+	"\x04koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\x04koji\x12.\n" +
+	"\x13template_file_count\x18\x02 \x01(\x05R\x11templateFileCount\"=\n" +
 	"\x11UpdateKojiRequest\x12(\n" +
 	"\bnew_koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\anewKoji\"@\n" +
 	"\x12UpdateKojiResponse\x12*\n" +
-	"\tprev_koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\bprevKoji2\xb3\x01\n" +
+	"\tprev_koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\bprevKoji\"P\n" +
+	"\x13GetThumbnailRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"\x8d\x01\n" +
+	"\x14GetThumbnailResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06digest\x18\x05 \x01(\tR\x06digest\"@\n" +
+	"\x17GetMediaMetadataRequest\x12%\n" +
+	"\x0epathist_folder\x18\x01 \x01(\tR\rpathistFolder\"N\n" +
+	"\x18GetMediaMetadataResponse\x122\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x16.grpc.v1.MediaMetadataR\bmetadata\"3\n" +
+	"\x18GetKojiPhotoAlbumRequest\x12\x17\n" +
+	"\akoji_id\x18\x01 \x01(\tR\x06kojiId\"\xe3\x01\n" +
+	"\x19GetKojiPhotoAlbumResponse\x12!\n" +
+	"\x04koji\x18\x01 \x01(\v2\r.grpc.v1.KojiR\x04koji\x12*\n" +
+	"\x04days\x18\x02 \x03(\v2\x16.grpc.v1.PhotoAlbumDayR\x04days\x121\n" +
+	"\aundated\x18\x03 \x03(\v2\x17.grpc.v1.PhotoAlbumItemR\aundated\x12\x1f\n" +
+	"\vphoto_count\x18\x04 \x01(\x05R\n" +
+	"photoCount\x12#\n" +
+	"\routside_count\x18\x05 \x01(\x05R\foutsideCount\"L\n" +
+	"\x11GetChangesRequest\x12!\n" +
+	"\fsince_cursor\x18\x01 \x01(\tR\vsinceCursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8c\x01\n" +
+	"\x12GetChangesResponse\x12.\n" +
+	"\achanges\x18\x01 \x03(\v2\x14.grpc.v1.ChangeEntryR\achanges\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12%\n" +
+	"\x0ereset_required\x18\x03 \x01(\bR\rresetRequired*\xa6\x01\n" +
+	"\x0fOverwritePolicy\x12 \n" +
+	"\x1cOVERWRITE_POLICY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15OVERWRITE_POLICY_FAIL\x10\x01\x12\x19\n" +
+	"\x15OVERWRITE_POLICY_SKIP\x10\x02\x12\x1e\n" +
+	"\x1aOVERWRITE_POLICY_OVERWRITE\x10\x03\x12\x1b\n" +
+	"\x17OVERWRITE_POLICY_RENAME\x10\x04*\x95\x01\n" +
+	"\vFileSortKey\x12\x1d\n" +
+	"\x19FILE_SORT_KEY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FILE_SORT_KEY_NAME\x10\x01\x12\x16\n" +
+	"\x12FILE_SORT_KEY_PATH\x10\x02\x12\x16\n" +
+	"\x12FILE_SORT_KEY_SIZE\x10\x03\x12\x1f\n" +
+	"\x1bFILE_SORT_KEY_MODIFIED_TIME\x10\x04*\x8a\x01\n" +
+	"\x0eCompanySortKey\x12 \n" +
+	"\x1cCOMPANY_SORT_KEY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15COMPANY_SORT_KEY_NAME\x10\x01\x12\x1d\n" +
+	"\x19COMPANY_SORT_KEY_CATEGORY\x10\x02\x12\x1c\n" +
+	"\x18COMPANY_SORT_KEY_READING\x10\x03*\xb9\x01\n" +
+	"\x12BatchOperationKind\x12$\n" +
+	" BATCH_OPERATION_KIND_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BATCH_OPERATION_KIND_MOVE\x10\x01\x12\x1d\n" +
+	"\x19BATCH_OPERATION_KIND_COPY\x10\x02\x12\x1f\n" +
+	"\x1bBATCH_OPERATION_KIND_DELETE\x10\x03\x12\x1e\n" +
+	"\x1aBATCH_OPERATION_KIND_MKDIR\x10\x042\xa0\r\n" +
 	"\vFileService\x12?\n" +
 	"\bGetFiles\x12\x18.grpc.v1.GetFilesRequest\x1a\x19.grpc.v1.GetFilesResponse\x12c\n" +
-	"\x14GetFilePathistFolder\x12$.grpc.v1.GetFilePathistFolderRequest\x1a%.grpc.v1.GetFilePathistFolderResponse2\xd9\x02\n" +
+	"\x14GetFilePathistFolder\x12$.grpc.v1.GetFilePathistFolderRequest\x1a%.grpc.v1.GetFilePathistFolderResponse\x12B\n" +
+	"\tCopyFiles\x12\x19.grpc.v1.CopyFilesRequest\x1a\x1a.grpc.v1.CopyFilesResponse\x12B\n" +
+	"\tMoveFiles\x12\x19.grpc.v1.MoveFilesRequest\x1a\x1a.grpc.v1.MoveFilesResponse\x12H\n" +
+	"\vDeleteFiles\x12\x1b.grpc.v1.DeleteFilesRequest\x1a\x1c.grpc.v1.DeleteFilesResponse\x12K\n" +
+	"\fCreateFolder\x12\x1c.grpc.v1.CreateFolderRequest\x1a\x1d.grpc.v1.CreateFolderResponse\x12B\n" +
+	"\tListTrash\x12\x19.grpc.v1.ListTrashRequest\x1a\x1a.grpc.v1.ListTrashResponse\x12W\n" +
+	"\x10RestoreFromTrash\x12 .grpc.v1.RestoreFromTrashRequest\x1a!.grpc.v1.RestoreFromTrashResponse\x12E\n" +
+	"\n" +
+	"PurgeTrash\x12\x1a.grpc.v1.PurgeTrashRequest\x1a\x1b.grpc.v1.PurgeTrashResponse\x12M\n" +
+	"\fDownloadFile\x12\x1c.grpc.v1.DownloadFileRequest\x1a\x1d.grpc.v1.DownloadFileResponse0\x01\x12G\n" +
+	"\n" +
+	"UploadFile\x12\x1a.grpc.v1.UploadFileRequest\x1a\x1b.grpc.v1.UploadFileResponse(\x01\x12Q\n" +
+	"\x0eFindDuplicates\x12\x1e.grpc.v1.FindDuplicatesRequest\x1a\x1f.grpc.v1.FindDuplicatesResponse\x12H\n" +
+	"\vSearchFiles\x12\x1b.grpc.v1.SearchFilesRequest\x1a\x1c.grpc.v1.SearchFilesResponse\x12]\n" +
+	"\x12GetWorkbookSummary\x12\".grpc.v1.GetWorkbookSummaryRequest\x1a#.grpc.v1.GetWorkbookSummaryResponse\x12T\n" +
+	"\x0fSearchWorkbooks\x12\x1f.grpc.v1.SearchWorkbooksRequest\x1a .grpc.v1.SearchWorkbooksResponse\x12P\n" +
+	"\rExportArchive\x12\x1d.grpc.v1.ExportArchiveRequest\x1a\x1e.grpc.v1.ExportArchiveResponse0\x01\x12K\n" +
+	"\fGetDiskUsage\x12\x1c.grpc.v1.GetDiskUsageRequest\x1a\x1d.grpc.v1.GetDiskUsageResponse\x12W\n" +
+	"\x10ListFileVersions\x12 .grpc.v1.ListFileVersionsRequest\x1a!.grpc.v1.ListFileVersionsResponse\x12]\n" +
+	"\x12RestoreFileVersion\x12\".grpc.v1.RestoreFileVersionRequest\x1a#.grpc.v1.RestoreFileVersionResponse\x12B\n" +
+	"\tBatchPlan\x12\x19.grpc.v1.BatchPlanRequest\x1a\x1a.grpc.v1.BatchPlanResponse\x12c\n" +
+	"\x14NormalizeFolderNames\x12$.grpc.v1.NormalizeFolderNamesRequest\x1a%.grpc.v1.NormalizeFolderNamesResponse2\xcf\x04\n" +
 	"\x0eCompanyService\x12K\n" +
 	"\fGetCompanies\x12\x1c.grpc.v1.GetCompaniesRequest\x1a\x1d.grpc.v1.GetCompaniesResponse\x12E\n" +
 	"\n" +
 	"GetCompany\x12\x1a.grpc.v1.GetCompanyRequest\x1a\x1b.grpc.v1.GetCompanyResponse\x12N\n" +
 	"\rUpdateCompany\x12\x1d.grpc.v1.UpdateCompanyRequest\x1a\x1e.grpc.v1.UpdateCompanyResponse\x12c\n" +
-	"\x14GetCompanyCategories\x12$.grpc.v1.GetCompanyCategoriesRequest\x1a%.grpc.v1.GetCompanyCategoriesResponse2\xd6\x01\n" +
+	"\x14GetCompanyCategories\x12$.grpc.v1.GetCompanyCategoriesRequest\x1a%.grpc.v1.GetCompanyCategoriesResponse\x12N\n" +
+	"\rCreateCompany\x12\x1d.grpc.v1.CreateCompanyRequest\x1a\x1e.grpc.v1.CreateCompanyResponse\x12Q\n" +
+	"\x0eArchiveCompany\x12\x1e.grpc.v1.ArchiveCompanyRequest\x1a\x1f.grpc.v1.ArchiveCompanyResponse\x12Q\n" +
+	"\x0eRestoreCompany\x12\x1e.grpc.v1.RestoreCompanyRequest\x1a\x1f.grpc.v1.RestoreCompanyResponse2\x9d\x02\n" +
 	"\vKojiService\x12<\n" +
 	"\aGetKoji\x12\x17.grpc.v1.GetKojiRequest\x1a\x18.grpc.v1.GetKojiResponse\x12B\n" +
 	"\tGetKojies\x12\x19.grpc.v1.GetKojiesRequest\x1a\x1a.grpc.v1.GetKojiesResponse\x12E\n" +
 	"\n" +
-	"UpdateKoji\x12\x1a.grpc.v1.UpdateKojiRequest\x1a\x1b.grpc.v1.UpdateKojiResponseB\x88\x01\n" +
+	"UpdateKoji\x12\x1a.grpc.v1.UpdateKojiRequest\x1a\x1b.grpc.v1.UpdateKojiResponse\x12E\n" +
+	"\n" +
+	"CreateKoji\x12\x1a.grpc.v1.CreateKojiRequest\x1a\x1b.grpc.v1.CreateKojiResponse2\x95\x02\n" +
+	"\x11MultiMediaService\x12K\n" +
+	"\fGetThumbnail\x12\x1c.grpc.v1.GetThumbnailRequest\x1a\x1d.grpc.v1.GetThumbnailResponse\x12W\n" +
+	"\x10GetMediaMetadata\x12 .grpc.v1.GetMediaMetadataRequest\x1a!.grpc.v1.GetMediaMetadataResponse\x12Z\n" +
+	"\x11GetKojiPhotoAlbum\x12!.grpc.v1.GetKojiPhotoAlbumRequest\x1a\".grpc.v1.GetKojiPhotoAlbumResponse2V\n" +
+	"\rChangeService\x12E\n" +
+	"\n" +
+	"GetChanges\x12\x1a.grpc.v1.GetChangesRequest\x1a\x1b.grpc.v1.GetChangesResponseB\x88\x01\n" +
 	"\vcom.grpc.v1B\x12ToyotachikuroProtoP\x01Z\x1eserver-grpc/gen/grpc/v1;grpcv1\xa2\x02\x03GXX\xaa\x02\aGrpc.V1\xca\x02\aGrpc\\V1\xe2\x02\x13Grpc\\V1\\GPBMetadata\xea\x02\bGrpc::V1\x92\x03\a\xd2>\x02\x10\x03\b\x02b\beditionsp\xe8\a"
 
-var file_grpc_v1_toyotachikuro_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_grpc_v1_toyotachikuro_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_grpc_v1_toyotachikuro_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_grpc_v1_toyotachikuro_proto_goTypes = []any{
-	(*File)(nil),                         // 0: grpc.v1.File
-	(*Company)(nil),                      // 1: grpc.v1.Company
-	(*CompanyCategory)(nil),              // 2: grpc.v1.CompanyCategory
-	(*Koji)(nil),                         // 3: grpc.v1.Koji
-	(*GetFilesRequest)(nil),              // 4: grpc.v1.GetFilesRequest
-	(*GetFilesResponse)(nil),             // 5: grpc.v1.GetFilesResponse
-	(*GetFilePathistFolderRequest)(nil),  // 6: grpc.v1.GetFilePathistFolderRequest
-	(*GetFilePathistFolderResponse)(nil), // 7: grpc.v1.GetFilePathistFolderResponse
-	(*GetCompaniesRequest)(nil),          // 8: grpc.v1.GetCompaniesRequest
-	(*GetCompaniesResponse)(nil),         // 9: grpc.v1.GetCompaniesResponse
-	(*GetCompanyRequest)(nil),            // 10: grpc.v1.GetCompanyRequest
-	(*GetCompanyResponse)(nil),           // 11: grpc.v1.GetCompanyResponse
-	(*UpdateCompanyRequest)(nil),         // 12: grpc.v1.UpdateCompanyRequest
-	(*UpdateCompanyResponse)(nil),        // 13: grpc.v1.UpdateCompanyResponse
-	(*GetCompanyCategoriesRequest)(nil),  // 14: grpc.v1.GetCompanyCategoriesRequest
-	(*GetCompanyCategoriesResponse)(nil), // 15: grpc.v1.GetCompanyCategoriesResponse
-	(*GetKojiesRequest)(nil),             // 16: grpc.v1.GetKojiesRequest
-	(*GetKojiesResponse)(nil),            // 17: grpc.v1.GetKojiesResponse
-	(*GetKojiRequest)(nil),               // 18: grpc.v1.GetKojiRequest
-	(*GetKojiResponse)(nil),              // 19: grpc.v1.GetKojiResponse
-	(*UpdateKojiRequest)(nil),            // 20: grpc.v1.UpdateKojiRequest
-	(*UpdateKojiResponse)(nil),           // 21: grpc.v1.UpdateKojiResponse
-	nil,                                  // 22: grpc.v1.GetCompaniesResponse.CompaniesEntry
-	nil,                                  // 23: grpc.v1.GetKojiesResponse.KojiesEntry
-	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
+	(OverwritePolicy)(0),                 // 0: grpc.v1.OverwritePolicy
+	(FileSortKey)(0),                     // 1: grpc.v1.FileSortKey
+	(CompanySortKey)(0),                  // 2: grpc.v1.CompanySortKey
+	(BatchOperationKind)(0),              // 3: grpc.v1.BatchOperationKind
+	(*File)(nil),                         // 4: grpc.v1.File
+	(*Company)(nil),                      // 5: grpc.v1.Company
+	(*CompanyCategory)(nil),              // 6: grpc.v1.CompanyCategory
+	(*Koji)(nil),                         // 7: grpc.v1.Koji
+	(*ChangeEntry)(nil),                  // 8: grpc.v1.ChangeEntry
+	(*FileTransfer)(nil),                 // 9: grpc.v1.FileTransfer
+	(*FileOperationError)(nil),           // 10: grpc.v1.FileOperationError
+	(*FileOperationResult)(nil),          // 11: grpc.v1.FileOperationResult
+	(*BatchOperation)(nil),               // 12: grpc.v1.BatchOperation
+	(*BatchOperationResult)(nil),         // 13: grpc.v1.BatchOperationResult
+	(*FolderNameIssue)(nil),              // 14: grpc.v1.FolderNameIssue
+	(*TrashItem)(nil),                    // 15: grpc.v1.TrashItem
+	(*FileVersion)(nil),                  // 16: grpc.v1.FileVersion
+	(*DuplicateGroup)(nil),               // 17: grpc.v1.DuplicateGroup
+	(*FileSearchHit)(nil),                // 18: grpc.v1.FileSearchHit
+	(*WorkbookRow)(nil),                  // 19: grpc.v1.WorkbookRow
+	(*WorkbookSheetSummary)(nil),         // 20: grpc.v1.WorkbookSheetSummary
+	(*WorkbookCellMatch)(nil),            // 21: grpc.v1.WorkbookCellMatch
+	(*WorkbookSearchHit)(nil),            // 22: grpc.v1.WorkbookSearchHit
+	(*DiskUsageEntry)(nil),               // 23: grpc.v1.DiskUsageEntry
+	(*MediaMetadata)(nil),                // 24: grpc.v1.MediaMetadata
+	(*PhotoAlbumItem)(nil),               // 25: grpc.v1.PhotoAlbumItem
+	(*PhotoAlbumDay)(nil),                // 26: grpc.v1.PhotoAlbumDay
+	(*GetFilesRequest)(nil),              // 27: grpc.v1.GetFilesRequest
+	(*GetFilesResponse)(nil),             // 28: grpc.v1.GetFilesResponse
+	(*GetFilePathistFolderRequest)(nil),  // 29: grpc.v1.GetFilePathistFolderRequest
+	(*GetFilePathistFolderResponse)(nil), // 30: grpc.v1.GetFilePathistFolderResponse
+	(*CopyFilesRequest)(nil),             // 31: grpc.v1.CopyFilesRequest
+	(*CopyFilesResponse)(nil),            // 32: grpc.v1.CopyFilesResponse
+	(*MoveFilesRequest)(nil),             // 33: grpc.v1.MoveFilesRequest
+	(*MoveFilesResponse)(nil),            // 34: grpc.v1.MoveFilesResponse
+	(*DeleteFilesRequest)(nil),           // 35: grpc.v1.DeleteFilesRequest
+	(*DeleteFilesResponse)(nil),          // 36: grpc.v1.DeleteFilesResponse
+	(*CreateFolderRequest)(nil),          // 37: grpc.v1.CreateFolderRequest
+	(*CreateFolderResponse)(nil),         // 38: grpc.v1.CreateFolderResponse
+	(*ListTrashRequest)(nil),             // 39: grpc.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),            // 40: grpc.v1.ListTrashResponse
+	(*RestoreFromTrashRequest)(nil),      // 41: grpc.v1.RestoreFromTrashRequest
+	(*RestoreFromTrashResponse)(nil),     // 42: grpc.v1.RestoreFromTrashResponse
+	(*PurgeTrashRequest)(nil),            // 43: grpc.v1.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),           // 44: grpc.v1.PurgeTrashResponse
+	(*DownloadFileRequest)(nil),          // 45: grpc.v1.DownloadFileRequest
+	(*DownloadFileResponse)(nil),         // 46: grpc.v1.DownloadFileResponse
+	(*UploadFileRequest)(nil),            // 47: grpc.v1.UploadFileRequest
+	(*UploadFileResponse)(nil),           // 48: grpc.v1.UploadFileResponse
+	(*FindDuplicatesRequest)(nil),        // 49: grpc.v1.FindDuplicatesRequest
+	(*FindDuplicatesResponse)(nil),       // 50: grpc.v1.FindDuplicatesResponse
+	(*SearchFilesRequest)(nil),           // 51: grpc.v1.SearchFilesRequest
+	(*SearchFilesResponse)(nil),          // 52: grpc.v1.SearchFilesResponse
+	(*GetWorkbookSummaryRequest)(nil),    // 53: grpc.v1.GetWorkbookSummaryRequest
+	(*GetWorkbookSummaryResponse)(nil),   // 54: grpc.v1.GetWorkbookSummaryResponse
+	(*SearchWorkbooksRequest)(nil),       // 55: grpc.v1.SearchWorkbooksRequest
+	(*SearchWorkbooksResponse)(nil),      // 56: grpc.v1.SearchWorkbooksResponse
+	(*ExportArchiveRequest)(nil),         // 57: grpc.v1.ExportArchiveRequest
+	(*ExportArchiveResponse)(nil),        // 58: grpc.v1.ExportArchiveResponse
+	(*GetDiskUsageRequest)(nil),          // 59: grpc.v1.GetDiskUsageRequest
+	(*GetDiskUsageResponse)(nil),         // 60: grpc.v1.GetDiskUsageResponse
+	(*ListFileVersionsRequest)(nil),      // 61: grpc.v1.ListFileVersionsRequest
+	(*ListFileVersionsResponse)(nil),     // 62: grpc.v1.ListFileVersionsResponse
+	(*RestoreFileVersionRequest)(nil),    // 63: grpc.v1.RestoreFileVersionRequest
+	(*RestoreFileVersionResponse)(nil),   // 64: grpc.v1.RestoreFileVersionResponse
+	(*BatchPlanRequest)(nil),             // 65: grpc.v1.BatchPlanRequest
+	(*BatchPlanResponse)(nil),            // 66: grpc.v1.BatchPlanResponse
+	(*NormalizeFolderNamesRequest)(nil),  // 67: grpc.v1.NormalizeFolderNamesRequest
+	(*NormalizeFolderNamesResponse)(nil), // 68: grpc.v1.NormalizeFolderNamesResponse
+	(*GetCompaniesRequest)(nil),          // 69: grpc.v1.GetCompaniesRequest
+	(*GetCompaniesResponse)(nil),         // 70: grpc.v1.GetCompaniesResponse
+	(*GetCompanyRequest)(nil),            // 71: grpc.v1.GetCompanyRequest
+	(*GetCompanyResponse)(nil),           // 72: grpc.v1.GetCompanyResponse
+	(*UpdateCompanyRequest)(nil),         // 73: grpc.v1.UpdateCompanyRequest
+	(*UpdateCompanyResponse)(nil),        // 74: grpc.v1.UpdateCompanyResponse
+	(*GetCompanyCategoriesRequest)(nil),  // 75: grpc.v1.GetCompanyCategoriesRequest
+	(*GetCompanyCategoriesResponse)(nil), // 76: grpc.v1.GetCompanyCategoriesResponse
+	(*CreateCompanyRequest)(nil),         // 77: grpc.v1.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),        // 78: grpc.v1.CreateCompanyResponse
+	(*ArchiveCompanyRequest)(nil),        // 79: grpc.v1.ArchiveCompanyRequest
+	(*ArchiveCompanyResponse)(nil),       // 80: grpc.v1.ArchiveCompanyResponse
+	(*RestoreCompanyRequest)(nil),        // 81: grpc.v1.RestoreCompanyRequest
+	(*RestoreCompanyResponse)(nil),       // 82: grpc.v1.RestoreCompanyResponse
+	(*GetKojiesRequest)(nil),             // 83: grpc.v1.GetKojiesRequest
+	(*GetKojiesResponse)(nil),            // 84: grpc.v1.GetKojiesResponse
+	(*GetKojiRequest)(nil),               // 85: grpc.v1.GetKojiRequest
+	(*GetKojiResponse)(nil),              // 86: grpc.v1.GetKojiResponse
+	(*CreateKojiRequest)(nil),            // 87: grpc.v1.CreateKojiRequest
+	(*CreateKojiResponse)(nil),           // 88: grpc.v1.CreateKojiResponse
+	(*UpdateKojiRequest)(nil),            // 89: grpc.v1.UpdateKojiRequest
+	(*UpdateKojiResponse)(nil),           // 90: grpc.v1.UpdateKojiResponse
+	(*GetThumbnailRequest)(nil),          // 91: grpc.v1.GetThumbnailRequest
+	(*GetThumbnailResponse)(nil),         // 92: grpc.v1.GetThumbnailResponse
+	(*GetMediaMetadataRequest)(nil),      // 93: grpc.v1.GetMediaMetadataRequest
+	(*GetMediaMetadataResponse)(nil),     // 94: grpc.v1.GetMediaMetadataResponse
+	(*GetKojiPhotoAlbumRequest)(nil),     // 95: grpc.v1.GetKojiPhotoAlbumRequest
+	(*GetKojiPhotoAlbumResponse)(nil),    // 96: grpc.v1.GetKojiPhotoAlbumResponse
+	(*GetChangesRequest)(nil),            // 97: grpc.v1.GetChangesRequest
+	(*GetChangesResponse)(nil),           // 98: grpc.v1.GetChangesResponse
+	nil,                                  // 99: grpc.v1.GetCompaniesResponse.CompaniesEntry
+	nil,                                  // 100: grpc.v1.GetCompaniesResponse.FolderSizesEntry
+	nil,                                  // 101: grpc.v1.GetKojiesResponse.KojiesEntry
+	nil,                                  // 102: grpc.v1.GetKojiesResponse.FolderSizesEntry
+	(*timestamppb.Timestamp)(nil),        // 103: google.protobuf.Timestamp
 }
 var file_grpc_v1_toyotachikuro_proto_depIdxs = []int32{
-	24, // 0: grpc.v1.File.modified_time:type_name -> google.protobuf.Timestamp
-	24, // 1: grpc.v1.Koji.start:type_name -> google.protobuf.Timestamp
-	24, // 2: grpc.v1.Koji.persist_end:type_name -> google.protobuf.Timestamp
-	0,  // 3: grpc.v1.GetFilesResponse.files:type_name -> grpc.v1.File
-	22, // 4: grpc.v1.GetCompaniesResponse.companies:type_name -> grpc.v1.GetCompaniesResponse.CompaniesEntry
-	1,  // 5: grpc.v1.GetCompanyResponse.company:type_name -> grpc.v1.Company
-	1,  // 6: grpc.v1.UpdateCompanyRequest.new_company:type_name -> grpc.v1.Company
-	1,  // 7: grpc.v1.UpdateCompanyResponse.prev_company:type_name -> grpc.v1.Company
-	2,  // 8: grpc.v1.GetCompanyCategoriesResponse.categories:type_name -> grpc.v1.CompanyCategory
-	23, // 9: grpc.v1.GetKojiesResponse.kojies:type_name -> grpc.v1.GetKojiesResponse.KojiesEntry
-	3,  // 10: grpc.v1.GetKojiResponse.koji:type_name -> grpc.v1.Koji
-	3,  // 11: grpc.v1.UpdateKojiRequest.new_koji:type_name -> grpc.v1.Koji
-	3,  // 12: grpc.v1.UpdateKojiResponse.prev_koji:type_name -> grpc.v1.Koji
-	1,  // 13: grpc.v1.GetCompaniesResponse.CompaniesEntry.value:type_name -> grpc.v1.Company
-	3,  // 14: grpc.v1.GetKojiesResponse.KojiesEntry.value:type_name -> grpc.v1.Koji
-	4,  // 15: grpc.v1.FileService.GetFiles:input_type -> grpc.v1.GetFilesRequest
-	6,  // 16: grpc.v1.FileService.GetFilePathistFolder:input_type -> grpc.v1.GetFilePathistFolderRequest
-	8,  // 17: grpc.v1.CompanyService.GetCompanies:input_type -> grpc.v1.GetCompaniesRequest
-	10, // 18: grpc.v1.CompanyService.GetCompany:input_type -> grpc.v1.GetCompanyRequest
-	12, // 19: grpc.v1.CompanyService.UpdateCompany:input_type -> grpc.v1.UpdateCompanyRequest
-	14, // 20: grpc.v1.CompanyService.GetCompanyCategories:input_type -> grpc.v1.GetCompanyCategoriesRequest
-	18, // 21: grpc.v1.KojiService.GetKoji:input_type -> grpc.v1.GetKojiRequest
-	16, // 22: grpc.v1.KojiService.GetKojies:input_type -> grpc.v1.GetKojiesRequest
-	20, // 23: grpc.v1.KojiService.UpdateKoji:input_type -> grpc.v1.UpdateKojiRequest
-	5,  // 24: grpc.v1.FileService.GetFiles:output_type -> grpc.v1.GetFilesResponse
-	7,  // 25: grpc.v1.FileService.GetFilePathistFolder:output_type -> grpc.v1.GetFilePathistFolderResponse
-	9,  // 26: grpc.v1.CompanyService.GetCompanies:output_type -> grpc.v1.GetCompaniesResponse
-	11, // 27: grpc.v1.CompanyService.GetCompany:output_type -> grpc.v1.GetCompanyResponse
-	13, // 28: grpc.v1.CompanyService.UpdateCompany:output_type -> grpc.v1.UpdateCompanyResponse
-	15, // 29: grpc.v1.CompanyService.GetCompanyCategories:output_type -> grpc.v1.GetCompanyCategoriesResponse
-	19, // 30: grpc.v1.KojiService.GetKoji:output_type -> grpc.v1.GetKojiResponse
-	17, // 31: grpc.v1.KojiService.GetKojies:output_type -> grpc.v1.GetKojiesResponse
-	21, // 32: grpc.v1.KojiService.UpdateKoji:output_type -> grpc.v1.UpdateKojiResponse
-	24, // [24:33] is the sub-list for method output_type
-	15, // [15:24] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	103, // 0: grpc.v1.File.modified_time:type_name -> google.protobuf.Timestamp
+	103, // 1: grpc.v1.Koji.start:type_name -> google.protobuf.Timestamp
+	103, // 2: grpc.v1.Koji.persist_end:type_name -> google.protobuf.Timestamp
+	103, // 3: grpc.v1.ChangeEntry.time:type_name -> google.protobuf.Timestamp
+	10,  // 4: grpc.v1.FileOperationResult.error:type_name -> grpc.v1.FileOperationError
+	3,   // 5: grpc.v1.BatchOperation.kind:type_name -> grpc.v1.BatchOperationKind
+	12,  // 6: grpc.v1.BatchOperationResult.operation:type_name -> grpc.v1.BatchOperation
+	10,  // 7: grpc.v1.BatchOperationResult.error:type_name -> grpc.v1.FileOperationError
+	10,  // 8: grpc.v1.FolderNameIssue.error:type_name -> grpc.v1.FileOperationError
+	103, // 9: grpc.v1.TrashItem.deleted_time:type_name -> google.protobuf.Timestamp
+	103, // 10: grpc.v1.FileVersion.saved_time:type_name -> google.protobuf.Timestamp
+	103, // 11: grpc.v1.FileVersion.modified_time:type_name -> google.protobuf.Timestamp
+	4,   // 12: grpc.v1.DuplicateGroup.files:type_name -> grpc.v1.File
+	4,   // 13: grpc.v1.FileSearchHit.file:type_name -> grpc.v1.File
+	19,  // 14: grpc.v1.WorkbookSheetSummary.preview:type_name -> grpc.v1.WorkbookRow
+	4,   // 15: grpc.v1.WorkbookSearchHit.file:type_name -> grpc.v1.File
+	21,  // 16: grpc.v1.WorkbookSearchHit.matches:type_name -> grpc.v1.WorkbookCellMatch
+	4,   // 17: grpc.v1.MediaMetadata.file:type_name -> grpc.v1.File
+	103, // 18: grpc.v1.MediaMetadata.capture_time:type_name -> google.protobuf.Timestamp
+	24,  // 19: grpc.v1.PhotoAlbumItem.metadata:type_name -> grpc.v1.MediaMetadata
+	25,  // 20: grpc.v1.PhotoAlbumDay.photos:type_name -> grpc.v1.PhotoAlbumItem
+	103, // 21: grpc.v1.GetFilesRequest.modified_after:type_name -> google.protobuf.Timestamp
+	103, // 22: grpc.v1.GetFilesRequest.modified_before:type_name -> google.protobuf.Timestamp
+	1,   // 23: grpc.v1.GetFilesRequest.sort_key:type_name -> grpc.v1.FileSortKey
+	4,   // 24: grpc.v1.GetFilesResponse.files:type_name -> grpc.v1.File
+	9,   // 25: grpc.v1.CopyFilesRequest.items:type_name -> grpc.v1.FileTransfer
+	0,   // 26: grpc.v1.CopyFilesRequest.overwrite_policy:type_name -> grpc.v1.OverwritePolicy
+	11,  // 27: grpc.v1.CopyFilesResponse.results:type_name -> grpc.v1.FileOperationResult
+	9,   // 28: grpc.v1.MoveFilesRequest.items:type_name -> grpc.v1.FileTransfer
+	0,   // 29: grpc.v1.MoveFilesRequest.overwrite_policy:type_name -> grpc.v1.OverwritePolicy
+	11,  // 30: grpc.v1.MoveFilesResponse.results:type_name -> grpc.v1.FileOperationResult
+	11,  // 31: grpc.v1.DeleteFilesResponse.results:type_name -> grpc.v1.FileOperationResult
+	4,   // 32: grpc.v1.CreateFolderResponse.folder:type_name -> grpc.v1.File
+	15,  // 33: grpc.v1.ListTrashResponse.items:type_name -> grpc.v1.TrashItem
+	0,   // 34: grpc.v1.RestoreFromTrashRequest.overwrite_policy:type_name -> grpc.v1.OverwritePolicy
+	11,  // 35: grpc.v1.RestoreFromTrashResponse.results:type_name -> grpc.v1.FileOperationResult
+	11,  // 36: grpc.v1.PurgeTrashResponse.results:type_name -> grpc.v1.FileOperationResult
+	103, // 37: grpc.v1.DownloadFileResponse.modified_time:type_name -> google.protobuf.Timestamp
+	0,   // 38: grpc.v1.UploadFileRequest.overwrite_policy:type_name -> grpc.v1.OverwritePolicy
+	4,   // 39: grpc.v1.UploadFileResponse.file:type_name -> grpc.v1.File
+	17,  // 40: grpc.v1.FindDuplicatesResponse.groups:type_name -> grpc.v1.DuplicateGroup
+	18,  // 41: grpc.v1.SearchFilesResponse.hits:type_name -> grpc.v1.FileSearchHit
+	4,   // 42: grpc.v1.GetWorkbookSummaryResponse.file:type_name -> grpc.v1.File
+	20,  // 43: grpc.v1.GetWorkbookSummaryResponse.sheets:type_name -> grpc.v1.WorkbookSheetSummary
+	22,  // 44: grpc.v1.SearchWorkbooksResponse.hits:type_name -> grpc.v1.WorkbookSearchHit
+	23,  // 45: grpc.v1.GetDiskUsageResponse.largest_children:type_name -> grpc.v1.DiskUsageEntry
+	16,  // 46: grpc.v1.ListFileVersionsResponse.versions:type_name -> grpc.v1.FileVersion
+	4,   // 47: grpc.v1.ListFileVersionsResponse.current:type_name -> grpc.v1.File
+	4,   // 48: grpc.v1.RestoreFileVersionResponse.file:type_name -> grpc.v1.File
+	12,  // 49: grpc.v1.BatchPlanRequest.operations:type_name -> grpc.v1.BatchOperation
+	13,  // 50: grpc.v1.BatchPlanResponse.results:type_name -> grpc.v1.BatchOperationResult
+	14,  // 51: grpc.v1.NormalizeFolderNamesResponse.issues:type_name -> grpc.v1.FolderNameIssue
+	2,   // 52: grpc.v1.GetCompaniesRequest.sort_key:type_name -> grpc.v1.CompanySortKey
+	99,  // 53: grpc.v1.GetCompaniesResponse.companies:type_name -> grpc.v1.GetCompaniesResponse.CompaniesEntry
+	100, // 54: grpc.v1.GetCompaniesResponse.folder_sizes:type_name -> grpc.v1.GetCompaniesResponse.FolderSizesEntry
+	5,   // 55: grpc.v1.GetCompaniesResponse.ordered_companies:type_name -> grpc.v1.Company
+	5,   // 56: grpc.v1.GetCompanyResponse.company:type_name -> grpc.v1.Company
+	5,   // 57: grpc.v1.UpdateCompanyRequest.new_company:type_name -> grpc.v1.Company
+	5,   // 58: grpc.v1.UpdateCompanyResponse.prev_company:type_name -> grpc.v1.Company
+	6,   // 59: grpc.v1.GetCompanyCategoriesResponse.categories:type_name -> grpc.v1.CompanyCategory
+	5,   // 60: grpc.v1.CreateCompanyRequest.new_company:type_name -> grpc.v1.Company
+	5,   // 61: grpc.v1.CreateCompanyResponse.company:type_name -> grpc.v1.Company
+	5,   // 62: grpc.v1.ArchiveCompanyResponse.company:type_name -> grpc.v1.Company
+	5,   // 63: grpc.v1.RestoreCompanyResponse.company:type_name -> grpc.v1.Company
+	101, // 64: grpc.v1.GetKojiesResponse.kojies:type_name -> grpc.v1.GetKojiesResponse.KojiesEntry
+	102, // 65: grpc.v1.GetKojiesResponse.folder_sizes:type_name -> grpc.v1.GetKojiesResponse.FolderSizesEntry
+	7,   // 66: grpc.v1.GetKojiResponse.koji:type_name -> grpc.v1.Koji
+	7,   // 67: grpc.v1.CreateKojiRequest.new_koji:type_name -> grpc.v1.Koji
+	7,   // 68: grpc.v1.CreateKojiResponse.koji:type_name -> grpc.v1.Koji
+	7,   // 69: grpc.v1.UpdateKojiRequest.new_koji:type_name -> grpc.v1.Koji
+	7,   // 70: grpc.v1.UpdateKojiResponse.prev_koji:type_name -> grpc.v1.Koji
+	24,  // 71: grpc.v1.GetMediaMetadataResponse.metadata:type_name -> grpc.v1.MediaMetadata
+	7,   // 72: grpc.v1.GetKojiPhotoAlbumResponse.koji:type_name -> grpc.v1.Koji
+	26,  // 73: grpc.v1.GetKojiPhotoAlbumResponse.days:type_name -> grpc.v1.PhotoAlbumDay
+	25,  // 74: grpc.v1.GetKojiPhotoAlbumResponse.undated:type_name -> grpc.v1.PhotoAlbumItem
+	8,   // 75: grpc.v1.GetChangesResponse.changes:type_name -> grpc.v1.ChangeEntry
+	5,   // 76: grpc.v1.GetCompaniesResponse.CompaniesEntry.value:type_name -> grpc.v1.Company
+	7,   // 77: grpc.v1.GetKojiesResponse.KojiesEntry.value:type_name -> grpc.v1.Koji
+	27,  // 78: grpc.v1.FileService.GetFiles:input_type -> grpc.v1.GetFilesRequest
+	29,  // 79: grpc.v1.FileService.GetFilePathistFolder:input_type -> grpc.v1.GetFilePathistFolderRequest
+	31,  // 80: grpc.v1.FileService.CopyFiles:input_type -> grpc.v1.CopyFilesRequest
+	33,  // 81: grpc.v1.FileService.MoveFiles:input_type -> grpc.v1.MoveFilesRequest
+	35,  // 82: grpc.v1.FileService.DeleteFiles:input_type -> grpc.v1.DeleteFilesRequest
+	37,  // 83: grpc.v1.FileService.CreateFolder:input_type -> grpc.v1.CreateFolderRequest
+	39,  // 84: grpc.v1.FileService.ListTrash:input_type -> grpc.v1.ListTrashRequest
+	41,  // 85: grpc.v1.FileService.RestoreFromTrash:input_type -> grpc.v1.RestoreFromTrashRequest
+	43,  // 86: grpc.v1.FileService.PurgeTrash:input_type -> grpc.v1.PurgeTrashRequest
+	45,  // 87: grpc.v1.FileService.DownloadFile:input_type -> grpc.v1.DownloadFileRequest
+	47,  // 88: grpc.v1.FileService.UploadFile:input_type -> grpc.v1.UploadFileRequest
+	49,  // 89: grpc.v1.FileService.FindDuplicates:input_type -> grpc.v1.FindDuplicatesRequest
+	51,  // 90: grpc.v1.FileService.SearchFiles:input_type -> grpc.v1.SearchFilesRequest
+	53,  // 91: grpc.v1.FileService.GetWorkbookSummary:input_type -> grpc.v1.GetWorkbookSummaryRequest
+	55,  // 92: grpc.v1.FileService.SearchWorkbooks:input_type -> grpc.v1.SearchWorkbooksRequest
+	57,  // 93: grpc.v1.FileService.ExportArchive:input_type -> grpc.v1.ExportArchiveRequest
+	59,  // 94: grpc.v1.FileService.GetDiskUsage:input_type -> grpc.v1.GetDiskUsageRequest
+	61,  // 95: grpc.v1.FileService.ListFileVersions:input_type -> grpc.v1.ListFileVersionsRequest
+	63,  // 96: grpc.v1.FileService.RestoreFileVersion:input_type -> grpc.v1.RestoreFileVersionRequest
+	65,  // 97: grpc.v1.FileService.BatchPlan:input_type -> grpc.v1.BatchPlanRequest
+	67,  // 98: grpc.v1.FileService.NormalizeFolderNames:input_type -> grpc.v1.NormalizeFolderNamesRequest
+	69,  // 99: grpc.v1.CompanyService.GetCompanies:input_type -> grpc.v1.GetCompaniesRequest
+	71,  // 100: grpc.v1.CompanyService.GetCompany:input_type -> grpc.v1.GetCompanyRequest
+	73,  // 101: grpc.v1.CompanyService.UpdateCompany:input_type -> grpc.v1.UpdateCompanyRequest
+	75,  // 102: grpc.v1.CompanyService.GetCompanyCategories:input_type -> grpc.v1.GetCompanyCategoriesRequest
+	77,  // 103: grpc.v1.CompanyService.CreateCompany:input_type -> grpc.v1.CreateCompanyRequest
+	79,  // 104: grpc.v1.CompanyService.ArchiveCompany:input_type -> grpc.v1.ArchiveCompanyRequest
+	81,  // 105: grpc.v1.CompanyService.RestoreCompany:input_type -> grpc.v1.RestoreCompanyRequest
+	85,  // 106: grpc.v1.KojiService.GetKoji:input_type -> grpc.v1.GetKojiRequest
+	83,  // 107: grpc.v1.KojiService.GetKojies:input_type -> grpc.v1.GetKojiesRequest
+	89,  // 108: grpc.v1.KojiService.UpdateKoji:input_type -> grpc.v1.UpdateKojiRequest
+	87,  // 109: grpc.v1.KojiService.CreateKoji:input_type -> grpc.v1.CreateKojiRequest
+	91,  // 110: grpc.v1.MultiMediaService.GetThumbnail:input_type -> grpc.v1.GetThumbnailRequest
+	93,  // 111: grpc.v1.MultiMediaService.GetMediaMetadata:input_type -> grpc.v1.GetMediaMetadataRequest
+	95,  // 112: grpc.v1.MultiMediaService.GetKojiPhotoAlbum:input_type -> grpc.v1.GetKojiPhotoAlbumRequest
+	97,  // 113: grpc.v1.ChangeService.GetChanges:input_type -> grpc.v1.GetChangesRequest
+	28,  // 114: grpc.v1.FileService.GetFiles:output_type -> grpc.v1.GetFilesResponse
+	30,  // 115: grpc.v1.FileService.GetFilePathistFolder:output_type -> grpc.v1.GetFilePathistFolderResponse
+	32,  // 116: grpc.v1.FileService.CopyFiles:output_type -> grpc.v1.CopyFilesResponse
+	34,  // 117: grpc.v1.FileService.MoveFiles:output_type -> grpc.v1.MoveFilesResponse
+	36,  // 118: grpc.v1.FileService.DeleteFiles:output_type -> grpc.v1.DeleteFilesResponse
+	38,  // 119: grpc.v1.FileService.CreateFolder:output_type -> grpc.v1.CreateFolderResponse
+	40,  // 120: grpc.v1.FileService.ListTrash:output_type -> grpc.v1.ListTrashResponse
+	42,  // 121: grpc.v1.FileService.RestoreFromTrash:output_type -> grpc.v1.RestoreFromTrashResponse
+	44,  // 122: grpc.v1.FileService.PurgeTrash:output_type -> grpc.v1.PurgeTrashResponse
+	46,  // 123: grpc.v1.FileService.DownloadFile:output_type -> grpc.v1.DownloadFileResponse
+	48,  // 124: grpc.v1.FileService.UploadFile:output_type -> grpc.v1.UploadFileResponse
+	50,  // 125: grpc.v1.FileService.FindDuplicates:output_type -> grpc.v1.FindDuplicatesResponse
+	52,  // 126: grpc.v1.FileService.SearchFiles:output_type -> grpc.v1.SearchFilesResponse
+	54,  // 127: grpc.v1.FileService.GetWorkbookSummary:output_type -> grpc.v1.GetWorkbookSummaryResponse
+	56,  // 128: grpc.v1.FileService.SearchWorkbooks:output_type -> grpc.v1.SearchWorkbooksResponse
+	58,  // 129: grpc.v1.FileService.ExportArchive:output_type -> grpc.v1.ExportArchiveResponse
+	60,  // 130: grpc.v1.FileService.GetDiskUsage:output_type -> grpc.v1.GetDiskUsageResponse
+	62,  // 131: grpc.v1.FileService.ListFileVersions:output_type -> grpc.v1.ListFileVersionsResponse
+	64,  // 132: grpc.v1.FileService.RestoreFileVersion:output_type -> grpc.v1.RestoreFileVersionResponse
+	66,  // 133: grpc.v1.FileService.BatchPlan:output_type -> grpc.v1.BatchPlanResponse
+	68,  // 134: grpc.v1.FileService.NormalizeFolderNames:output_type -> grpc.v1.NormalizeFolderNamesResponse
+	70,  // 135: grpc.v1.CompanyService.GetCompanies:output_type -> grpc.v1.GetCompaniesResponse
+	72,  // 136: grpc.v1.CompanyService.GetCompany:output_type -> grpc.v1.GetCompanyResponse
+	74,  // 137: grpc.v1.CompanyService.UpdateCompany:output_type -> grpc.v1.UpdateCompanyResponse
+	76,  // 138: grpc.v1.CompanyService.GetCompanyCategories:output_type -> grpc.v1.GetCompanyCategoriesResponse
+	78,  // 139: grpc.v1.CompanyService.CreateCompany:output_type -> grpc.v1.CreateCompanyResponse
+	80,  // 140: grpc.v1.CompanyService.ArchiveCompany:output_type -> grpc.v1.ArchiveCompanyResponse
+	82,  // 141: grpc.v1.CompanyService.RestoreCompany:output_type -> grpc.v1.RestoreCompanyResponse
+	86,  // 142: grpc.v1.KojiService.GetKoji:output_type -> grpc.v1.GetKojiResponse
+	84,  // 143: grpc.v1.KojiService.GetKojies:output_type -> grpc.v1.GetKojiesResponse
+	90,  // 144: grpc.v1.KojiService.UpdateKoji:output_type -> grpc.v1.UpdateKojiResponse
+	88,  // 145: grpc.v1.KojiService.CreateKoji:output_type -> grpc.v1.CreateKojiResponse
+	92,  // 146: grpc.v1.MultiMediaService.GetThumbnail:output_type -> grpc.v1.GetThumbnailResponse
+	94,  // 147: grpc.v1.MultiMediaService.GetMediaMetadata:output_type -> grpc.v1.GetMediaMetadataResponse
+	96,  // 148: grpc.v1.MultiMediaService.GetKojiPhotoAlbum:output_type -> grpc.v1.GetKojiPhotoAlbumResponse
+	98,  // 149: grpc.v1.ChangeService.GetChanges:output_type -> grpc.v1.GetChangesResponse
+	114, // [114:150] is the sub-list for method output_type
+	78,  // [78:114] is the sub-list for method input_type
+	78,  // [78:78] is the sub-list for extension type_name
+	78,  // [78:78] is the sub-list for extension extendee
+	0,   // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_grpc_v1_toyotachikuro_proto_init() }
@@ -1814,13 +9809,14 @@ func file_grpc_v1_toyotachikuro_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grpc_v1_toyotachikuro_proto_rawDesc), len(file_grpc_v1_toyotachikuro_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   24,
+			NumEnums:      4,
+			NumMessages:   99,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_grpc_v1_toyotachikuro_proto_goTypes,
 		DependencyIndexes: file_grpc_v1_toyotachikuro_proto_depIdxs,
+		EnumInfos:         file_grpc_v1_toyotachikuro_proto_enumTypes,
 		MessageInfos:      file_grpc_v1_toyotachikuro_proto_msgTypes,
 	}.Build()
 	File_grpc_v1_toyotachikuro_proto = out.File
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// zipFlagUTF8 は ZIP のファイル名が UTF-8 であることを示す汎用フラグ（bit 11）です
+// 設定しない場合、日本語版 Windows の展開ツールはファイル名を Shift_JIS として扱います
+const zipFlagUTF8 = 0x800
+
+// archiveStoredExts は圧縮済みのため無圧縮で格納する拡張子です
+var archiveStoredExts = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true, ".heic": true,
+	".mp4": true, ".mov": true, ".mp3": true, ".m4a": true,
+	".zip": true, ".7z": true, ".gz": true, ".rar": true,
+	".xlsx": true, ".docx": true, ".pptx": true,
+}
+
+// ArchiveEntry は ZIP に格納するファイル・フォルダーです
+type ArchiveEntry struct {
+	// AbsPath は格納するファイル・フォルダーの絶対パス
+	AbsPath string
+
+	// Name は ZIP 内のパス（スラッシュ区切り、フォルダーは末尾に / を付けない）
+	Name string
+
+	// IsDir はフォルダーかどうか
+	IsDir bool
+}
+
+// ArchiveStats は ZIP の書き込み結果です
+type ArchiveStats struct {
+	// Files, Folders は格納したファイル数・フォルダー数
+	Files   int
+	Folders int
+
+	// Skipped は書き込み時に読み込めず格納しなかったファイル数
+	Skipped int
+
+	// Bytes は格納したファイルの合計サイズ（圧縮前）
+	Bytes int64
+}
+
+// CollectArchiveEntries は absPath（ファイルまたはフォルダー）とその配下を ZIP 内の name 以下に格納するエントリーを返します。
+//   - 除外ルールに一致するものと Pathist の内部管理用ファイル・フォルダーは含めません。
+//   - 各フォルダーの除外ルールのファイルを読み込み、配下に適用します。
+//   - シンボリックリンクはリンク先を含めないよう除外します。
+func CollectArchiveEntries(ctx context.Context, absPath, name string, rules *IgnoreRules) ([]ArchiveEntry, error) {
+	fi, err := os.Lstat(absPath)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Mode()&os.ModeSymlink != 0 || rules.Match(absPath, fi.IsDir()) {
+		return nil, nil
+	}
+	if !fi.IsDir() {
+		return []ArchiveEntry{{AbsPath: absPath, Name: name}}, nil
+	}
+
+	entries := []ArchiveEntry{{AbsPath: absPath, Name: name, IsDir: true}}
+	err = collectArchiveFolder(ctx, absPath, name, rules, &entries)
+	return entries, err
+}
+
+// collectArchiveFolder はフォルダー配下のエントリーを再帰的に追加します
+func collectArchiveFolder(ctx context.Context, absFolder, name string, rules *IgnoreRules, entries *[]ArchiveEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	rules, err := rules.WithFolder(absFolder)
+	if err != nil {
+		return err
+	}
+	dirs, err := os.ReadDir(absFolder)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if dir.Type()&os.ModeSymlink != 0 {
+			continue
+		}
+		absPath := filepath.Join(absFolder, dir.Name())
+		if rules.Match(absPath, dir.IsDir()) {
+			continue
+		}
+		entry := ArchiveEntry{AbsPath: absPath, Name: path.Join(name, dir.Name()), IsDir: dir.IsDir()}
+		*entries = append(*entries, entry)
+		if entry.IsDir {
+			if err := collectArchiveFolder(ctx, absPath, entry.Name, rules, entries); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteZipArchive はエントリーを ZIP 形式で w に書き込みます。
+//   - 全体をメモリに保持せず、ファイル毎に読み込みながら書き込みます。
+//   - ファイル名は UTF-8 フラグを付けて格納します。
+//   - 書き込み時に開けなくなったファイルは読み飛ばし、Skipped に数えます。
+//
+// 書き込み途中でエラーになった場合、w には不完全な ZIP が書き込まれています。
+func WriteZipArchive(ctx context.Context, w io.Writer, entries []ArchiveEntry) (ArchiveStats, error) {
+	stats := ArchiveStats{}
+	zw := zip.NewWriter(w)
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+		written, err := writeZipEntry(zw, entry)
+		if err != nil {
+			var skipErr *archiveSkipError
+			if errors.As(err, &skipErr) {
+				stats.Skipped++
+				continue
+			}
+			return stats, fmt.Errorf("%s: %w", entry.Name, err)
+		}
+		if entry.IsDir {
+			stats.Folders++
+		} else {
+			stats.Files++
+			stats.Bytes += written
+		}
+	}
+	return stats, zw.Close()
+}
+
+// archiveSkipError は読み飛ばしたエントリーのエラーです
+type archiveSkipError struct {
+	err error
+}
+
+func (e *archiveSkipError) Error() string {
+	return e.err.Error()
+}
+
+// writeZipEntry は1つのエントリーを書き込み、格納したバイト数を返します
+func writeZipEntry(zw *zip.Writer, entry ArchiveEntry) (int64, error) {
+	if entry.IsDir {
+		fi, err := os.Stat(entry.AbsPath)
+		if err != nil {
+			return 0, &archiveSkipError{err}
+		}
+		header := &zip.FileHeader{Name: entry.Name + "/", Method: zip.Store, Modified: fi.ModTime()}
+		header.Flags |= zipFlagUTF8
+		header.SetMode(fi.Mode())
+		_, err = zw.CreateHeader(header)
+		return 0, err
+	}
+
+	// ヘッダーを書き込む前に開けることを確認
+	file, err := os.Open(entry.AbsPath)
+	if err != nil {
+		return 0, &archiveSkipError{err}
+	}
+	defer file.Close()
+	fi, err := file.Stat()
+	if err != nil || !fi.Mode().IsRegular() {
+		return 0, &archiveSkipError{fmt.Errorf("not a regular file: %s", entry.AbsPath)}
+	}
+
+	header := &zip.FileHeader{Name: entry.Name, Method: zip.Deflate, Modified: fi.ModTime()}
+	if archiveStoredExts[strings.ToLower(path.Ext(entry.Name))] {
+		header.Method = zip.Store
+	}
+	header.Flags |= zipFlagUTF8
+	header.SetMode(fi.Mode())
+	writer, err := zw.CreateHeader(header)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(writer, file)
+}
@@ -0,0 +1,145 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// batchJournalSuffix は一括操作のジャーナルファイルの拡張子です
+const batchJournalSuffix = ".yaml"
+
+// BatchOpMove などは一括操作の種類です
+const (
+	BatchOpMove   = "move"
+	BatchOpCopy   = "copy"
+	BatchOpDelete = "delete"
+	BatchOpMkdir  = "mkdir"
+)
+
+// BatchStep は一括操作の1件分の記録です
+type BatchStep struct {
+	// Op は操作の種類（BatchOpMove など）
+	Op string `yaml:"op"`
+
+	// Src, Dst はルートフォルダーからの相対パス（削除は Src、フォルダー作成は Dst のみ）
+	Src string `yaml:"src,omitempty"`
+	Dst string `yaml:"dst,omitempty"`
+
+	// TrashId は削除でゴミ箱に移動したエントリーの識別子
+	TrashId string `yaml:"trash_id,omitempty"`
+
+	// Done は操作が完了したかどうか
+	Done bool `yaml:"done"`
+
+	// RolledBack は操作を取り消したかどうか
+	RolledBack bool `yaml:"rolled_back,omitempty"`
+}
+
+// BatchJournal は一括操作の実行記録です。
+//   - 各操作の実行前後に保存し、途中で失敗した場合に完了した操作を逆順に取り消すために使用します。
+//   - 正常に完了または取り消しできた場合は削除し、取り消しに失敗した場合やプロセスが終了した場合は残します。
+type BatchJournal struct {
+	// Id はジャーナルの識別子
+	Id string `yaml:"id"`
+
+	// Actor は一括操作を行ったユーザーまたは接続元
+	Actor string `yaml:"actor"`
+
+	// StartedAt は一括操作を開始した時刻
+	StartedAt time.Time `yaml:"started_at"`
+
+	// Steps は実行した操作の記録
+	Steps []BatchStep `yaml:"steps"`
+
+	// path はジャーナルファイルのパス
+	path string
+}
+
+// NewBatchJournal は folder に新しいジャーナルを作成します。フォルダーが存在しない場合は作成します。
+func NewBatchJournal(folder, actor string) (*BatchJournal, error) {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+	id, err := newTrashId(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	journal := &BatchJournal{
+		Id:        id,
+		Actor:     actor,
+		StartedAt: time.Now(),
+		Steps:     []BatchStep{},
+		path:      filepath.Join(folder, id+batchJournalSuffix),
+	}
+	return journal, journal.Save()
+}
+
+// ListBatchJournals は folder に残っているジャーナルを開始日時の古い順に返します
+// 完了または取り消しできなかった一括操作の確認に使用します
+func ListBatchJournals(folder string) ([]*BatchJournal, error) {
+	dirs, err := os.ReadDir(folder)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*BatchJournal{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	journals := make([]*BatchJournal, 0, len(dirs))
+	for _, dir := range dirs {
+		if dir.IsDir() || !strings.HasSuffix(dir.Name(), batchJournalSuffix) {
+			continue
+		}
+		path := filepath.Join(folder, dir.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		journal := &BatchJournal{}
+		if err := yaml.Unmarshal(data, journal); err != nil {
+			continue
+		}
+		journal.path = path
+		journals = append(journals, journal)
+	}
+
+	sort.Slice(journals, func(a, b int) bool {
+		return journals[a].StartedAt.Before(journals[b].StartedAt)
+	})
+	return journals, nil
+}
+
+// Append は操作を追加して保存し、追加した操作のインデックスを返します
+func (j *BatchJournal) Append(step BatchStep) (int, error) {
+	j.Steps = append(j.Steps, step)
+	return len(j.Steps) - 1, j.Save()
+}
+
+// Save はジャーナルファイルを保存します
+func (j *BatchJournal) Save() error {
+	data, err := yaml.Marshal(j)
+	if err != nil {
+		return err
+	}
+
+	// 書き込み途中で終了しても壊れないよう一時ファイルから置き換える
+	temp := j.path + ".tmp"
+	if err := os.WriteFile(temp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(temp, j.path)
+}
+
+// Remove はジャーナルファイルを削除します
+func (j *BatchJournal) Remove() error {
+	if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
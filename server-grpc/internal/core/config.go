@@ -8,13 +8,36 @@ import (
 // DefaultStringMap はサービスのデフォルト設定を定義します。
 // これらの値は、環境変数が設定されていない場合に使用されます。
 var ConfigMap = map[string]string{
-	"FileServiceTarget":          "{ROOT}",
-	"CompanyServiceFolder":       "{ROOT}/1 会社",
-	"CompanyPersistFilename":     "@company.yaml",
-	"CompanyPollIntervalMillSec": "3000",
-	"KojiServiceFolder":          "{ROOT}/2 工事",
-	"KojiPersistFilename":        "@koji.yaml",
-	"MemberPersistFilename":      "@member.yaml",
+	"FileServiceTarget":           "{ROOT}",
+	"FileServiceSymlinkPolicy":    "follow-within-root",
+	"TrashRetentionDays":          "30",
+	"TrashPurgeIntervalSec":       "3600",
+	"UploadExpireHours":           "24",
+	"FileVersionMaxCount":         "10",
+	"FileVersionRetentionDays":    "90",
+	"FileVersionPurgeIntervalSec": "3600",
+	"BatchJournalFolder":          "{ROOT}/.pathist-state/batch",
+	"HashCacheFile":               "{ROOT}/.pathist-state/hashes.json",
+	"HashScanIntervalSec":         "900",
+	"SearchWatcherMaxDepth":       "8",
+	"SearchRebuildIntervalSec":    "3600",
+	"ThumbnailCacheFolder":        "{ROOT}/.pathist-state/thumbnails",
+	"ThumbnailCacheMaxMB":         "512",
+	"ThumbnailMaxConcurrency":     "2",
+	"CompanyServiceFolder":        "{ROOT}/1 会社",
+	"CompanyPersistFilename":      "@company.yaml",
+	"CompanyPollIntervalMillSec":  "3000",
+	"CompanyTemplateFolder":       "{ROOT}/.pathist-templates/会社",
+	"CompanyArchiveFolder":        "{ROOT}/.pathist-archive/会社",
+	"KojiServiceFolder":           "{ROOT}/2 工事",
+	"KojiPersistFilename":         "@koji.yaml",
+	"KojiTemplateFolder":          "{ROOT}/.pathist-templates/工事",
+	"MemberPersistFilename":       "@member.yaml",
+	"JournalFolder":               "{ROOT}/.pathist-state/journal",
+	"JournalSegmentMaxBytes":      "4194304",
+	"JournalMaxSegments":          "8",
+	"ChangeWatcherMaxDepth":       "3",
+	"FolderLockTTLSec":            "60",
 }
 
 var WorkerConfigMap = map[string]int{
@@ -0,0 +1,289 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DiskUsageEntry はフォルダー直下のファイル・フォルダー1件の使用量です
+type DiskUsageEntry struct {
+	// Path は絶対パス
+	Path string
+
+	// Name はファイル名またはフォルダー名
+	Name string
+
+	// IsDir はフォルダーかどうか
+	IsDir bool
+
+	// Size は合計サイズ（フォルダーの場合は配下の全ファイルの合計）
+	Size int64
+
+	// Files はファイル数（ファイルの場合は1）
+	Files int64
+}
+
+// DiskUsage はファイル・フォルダーの使用量です
+type DiskUsage struct {
+	// Path は集計したファイル・フォルダーの絶対パス
+	Path string
+
+	// Size は配下の全ファイルの合計サイズ
+	Size int64
+
+	// Files, Folders は配下のファイル数・フォルダー数（自身を含まない）
+	Files   int64
+	Folders int64
+
+	// Skipped は読み込めず集計に含めなかったファイル・フォルダー数
+	Skipped int64
+
+	// Children は直下のファイル・フォルダーをサイズの大きい順に並べたもの
+	Children []DiskUsageEntry
+}
+
+// diskUsageTotal はキャッシュするフォルダー配下の集計値です
+type diskUsageTotal struct {
+	size    int64
+	files   int64
+	folders int64
+	skipped int64
+}
+
+// DiskUsageCache はフォルダー毎の使用量を集計してキャッシュします。
+//   - フォルダー毎の合計のみを保持し、ファイル毎のサイズは保持しません。
+//   - ファイルが変更された場合は Invalidate で変更されたパスと上位フォルダーのキャッシュを破棄し、
+//     次回の集計では変更のないフォルダーのキャッシュを再利用します。
+//   - シンボリックリンクと Pathist の内部管理用ファイル・フォルダーは集計に含めません。
+type DiskUsageCache struct {
+	mu sync.Mutex
+
+	// root はキャッシュ対象のルートフォルダー
+	root string
+
+	// folders はフォルダーの絶対パスをキーとした集計値
+	folders map[string]diskUsageTotal
+
+	// generation はキャッシュを破棄する毎に増える番号
+	// 集計中に破棄された場合に古い集計値を保存しないために使用します
+	generation uint64
+}
+
+// NewDiskUsageCache は root を対象とする空のキャッシュを作成します
+func NewDiskUsageCache(root string) *DiskUsageCache {
+	return &DiskUsageCache{
+		root:    filepath.Clean(root),
+		folders: map[string]diskUsageTotal{},
+	}
+}
+
+// Len はキャッシュしているフォルダー数を返します
+func (c *DiskUsageCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.folders)
+}
+
+// Usage は absPath（ファイルまたはフォルダー）の使用量と直下のファイル・フォルダー毎の使用量を返します
+// キャッシュのないサブフォルダーは DecideNumWorkers で決めたワーカー数で並列に集計します
+func (c *DiskUsageCache) Usage(ctx context.Context, absPath string) (*DiskUsage, error) {
+	absPath = filepath.Clean(absPath)
+	fi, err := os.Lstat(absPath)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		entry := DiskUsageEntry{Path: absPath, Name: fi.Name(), Size: fi.Size(), Files: 1}
+		return &DiskUsage{Path: absPath, Size: fi.Size(), Files: 1, Children: []DiskUsageEntry{entry}}, nil
+	}
+
+	dirs, err := os.ReadDir(absPath)
+	if err != nil {
+		return nil, err
+	}
+	usage := &DiskUsage{Path: absPath, Children: make([]DiskUsageEntry, 0, len(dirs))}
+	folders := make([]string, 0)
+	for _, dir := range dirs {
+		if dir.Type()&os.ModeSymlink != 0 || FilenameIsPathistSystem(dir.Name()) {
+			continue
+		}
+		path := filepath.Join(absPath, dir.Name())
+		if dir.IsDir() {
+			folders = append(folders, path)
+			continue
+		}
+		info, err := dir.Info()
+		if err != nil {
+			usage.Skipped++
+			continue
+		}
+		usage.Children = append(usage.Children, DiskUsageEntry{Path: path, Name: dir.Name(), Size: info.Size(), Files: 1})
+		usage.Size += info.Size()
+		usage.Files++
+	}
+
+	// サブフォルダーを並列に集計
+	type folderTotal struct {
+		path  string
+		total diskUsageTotal
+	}
+	totals, err := ParallelMap(ctx, folders,
+		func(ctx context.Context, path string) (folderTotal, error) {
+			total, err := c.total(ctx, path)
+			return folderTotal{path: path, total: total}, err
+		})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		// 読み込めなかったサブフォルダーは件数のみ数える
+		usage.Skipped += int64(len(folders) - len(totals))
+	}
+	for _, t := range totals {
+		usage.Children = append(usage.Children, DiskUsageEntry{
+			Path:  t.path,
+			Name:  filepath.Base(t.path),
+			IsDir: true,
+			Size:  t.total.size,
+			Files: t.total.files,
+		})
+		usage.Size += t.total.size
+		usage.Files += t.total.files
+		usage.Folders += t.total.folders + 1
+		usage.Skipped += t.total.skipped
+	}
+
+	slices.SortFunc(usage.Children, func(a, b DiskUsageEntry) int {
+		if c := cmp.Compare(b.Size, a.Size); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return usage, nil
+}
+
+// Sizes は各フォルダー配下の合計サイズを並列に集計し、絶対パスをキーとして返します
+// 存在しない・読み込めないフォルダーは含めません
+func (c *DiskUsageCache) Sizes(ctx context.Context, absFolders []string) (map[string]int64, error) {
+	type folderSize struct {
+		path string
+		size int64
+	}
+	sizes, _ := ParallelMap(ctx, absFolders,
+		func(ctx context.Context, path string) (folderSize, error) {
+			total, err := c.total(ctx, filepath.Clean(path))
+			return folderSize{path: path, size: total.size}, err
+		})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(sizes))
+	for _, s := range sizes {
+		result[s.path] = s.size
+	}
+	return result, nil
+}
+
+// Invalidate は absPath とその上位フォルダーのキャッシュを破棄します
+// ファイルの作成・書き込みなど、配下のフォルダーに影響しない変更で使用します
+func (c *DiskUsageCache) Invalidate(absPath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLocked(filepath.Clean(absPath))
+}
+
+// Remove は absPath とその配下、上位フォルダーのキャッシュを破棄します
+// ファイル・フォルダーの削除や名前の変更で使用します
+func (c *DiskUsageCache) Remove(absPath string) {
+	absPath = filepath.Clean(absPath)
+	prefix := absPath + string(os.PathSeparator)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for path := range c.folders {
+		if strings.HasPrefix(path, prefix) {
+			delete(c.folders, path)
+		}
+	}
+	c.invalidateLocked(absPath)
+}
+
+// Reset は全てのキャッシュを破棄します
+func (c *DiskUsageCache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.folders = map[string]diskUsageTotal{}
+	c.generation++
+}
+
+// invalidateLocked は absPath からルートフォルダーまでのキャッシュを破棄します
+func (c *DiskUsageCache) invalidateLocked(absPath string) {
+	c.generation++
+	for path := absPath; ; path = filepath.Dir(path) {
+		delete(c.folders, path)
+		if path == c.root || filepath.Dir(path) == path {
+			return
+		}
+	}
+}
+
+// total はフォルダー配下の集計値を返します（キャッシュがなければ集計して保存します）
+func (c *DiskUsageCache) total(ctx context.Context, absFolder string) (diskUsageTotal, error) {
+	c.mu.Lock()
+	total, ok := c.folders[absFolder]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok {
+		return total, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return diskUsageTotal{}, err
+	}
+
+	dirs, err := os.ReadDir(absFolder)
+	if err != nil {
+		return diskUsageTotal{}, err
+	}
+	for _, dir := range dirs {
+		if dir.Type()&os.ModeSymlink != 0 || FilenameIsPathistSystem(dir.Name()) {
+			continue
+		}
+		if dir.IsDir() {
+			sub, err := c.total(ctx, filepath.Join(absFolder, dir.Name()))
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+					return diskUsageTotal{}, err
+				}
+				total.skipped++
+				continue
+			}
+			total.size += sub.size
+			total.files += sub.files
+			total.folders += sub.folders + 1
+			total.skipped += sub.skipped
+			continue
+		}
+		info, err := dir.Info()
+		if err != nil {
+			total.skipped++
+			continue
+		}
+		total.size += info.Size()
+		total.files++
+	}
+
+	// 集計中にキャッシュが破棄された場合は古い可能性があるため保存しない
+	c.mu.Lock()
+	if c.generation == generation {
+		c.folders[absFolder] = total
+	}
+	c.mu.Unlock()
+	return total, nil
+}
@@ -0,0 +1,386 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrExifNotFound は画像に EXIF 情報が含まれない場合のエラーです
+var ErrExifNotFound = errors.New("exif not found")
+
+// exifMaxSize は PNG から読み込む EXIF の最大サイズ（JPEG の APP1 セグメントの上限と同じ）
+const exifMaxSize = 64 << 10
+
+// exifDateTimeLayout は EXIF の日時の書式
+const exifDateTimeLayout = "2006:01:02 15:04:05"
+
+// EXIF のタグ
+const (
+	exifTagMake             = 0x010F
+	exifTagModel            = 0x0110
+	exifTagOrientation      = 0x0112
+	exifTagDateTime         = 0x0132
+	exifTagExifIFD          = 0x8769
+	exifTagGPSIFD           = 0x8825
+	exifTagDateTimeOriginal = 0x9003
+	exifTagOffsetTimeOrig   = 0x9011
+	exifTagSubSecTimeOrig   = 0x9291
+	exifTagPixelXDimension  = 0xA002
+	exifTagPixelYDimension  = 0xA003
+
+	exifTagGPSLatitudeRef  = 0x0001
+	exifTagGPSLatitude     = 0x0002
+	exifTagGPSLongitudeRef = 0x0003
+	exifTagGPSLongitude    = 0x0004
+)
+
+// EXIF の値の型
+const (
+	exifTypeByte      = 1
+	exifTypeASCII     = 2
+	exifTypeShort     = 3
+	exifTypeLong      = 4
+	exifTypeRational  = 5
+	exifTypeUndefined = 7
+	exifTypeSLong     = 9
+	exifTypeSRational = 10
+)
+
+// exifMaxEntriesPerIFD は1つの IFD から読み込む最大のエントリー数、壊れたデータ対策です
+const exifMaxEntriesPerIFD = 1024
+
+// Exif は画像の EXIF 情報のうち、写真の整理に使う項目です
+type Exif struct {
+	// Make, Model はカメラのメーカー名・機種名
+	Make  string
+	Model string
+
+	// Orientation は画像の向き（1〜8、EXIF の定義どおり、不明な場合は 1）
+	Orientation int
+
+	// CaptureTime は撮影日時（不明な場合はゼロ値）
+	// タイムゾーンの記録がない場合はサーバーのローカル時刻として解釈します
+	CaptureTime time.Time
+
+	// Width, Height は EXIF に記録された画像のピクセル数（不明な場合は 0）
+	Width  int
+	Height int
+
+	// HasLocation は撮影位置が記録されているかどうか
+	HasLocation bool
+
+	// Latitude, Longitude は撮影位置の緯度・経度（10進数、南緯・西経は負）
+	Latitude  float64
+	Longitude float64
+}
+
+// ReadExif は JPEG・PNG の画像ファイルから EXIF 情報を読み込みます。
+//   - JPEG は APP1 セグメント、PNG は eXIf チャンクから読み込みます。
+//   - EXIF 情報がない場合は ErrExifNotFound を返します。
+func ReadExif(filename string) (*Exif, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var raw []byte
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".jpg", ".jpeg":
+		raw, err = exifFromJpeg(bufio.NewReader(file))
+	case ".png":
+		raw, err = exifFromPng(bufio.NewReader(file))
+	default:
+		return nil, ErrExifNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseExif(raw)
+}
+
+// ParseExif は TIFF 形式（"II" または "MM" で始まる）の EXIF を解析します
+func ParseExif(raw []byte) (*Exif, error) {
+	if len(raw) < 8 {
+		return nil, ErrExifNotFound
+	}
+	var order binary.ByteOrder
+	switch string(raw[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%w: invalid byte order", ErrExifNotFound)
+	}
+	r := exifReader{raw: raw, order: order}
+
+	exif := &Exif{Orientation: 1}
+	ifd0 := r.ifd(order.Uint32(raw[4:8]))
+
+	exif.Make = r.ascii(ifd0[exifTagMake])
+	exif.Model = r.ascii(ifd0[exifTagModel])
+	if o := r.uint(ifd0[exifTagOrientation]); o >= 1 && o <= 8 {
+		exif.Orientation = int(o)
+	}
+
+	// 撮影日時は Exif IFD の DateTimeOriginal を優先し、なければ IFD0 の DateTime
+	dateTime := r.ascii(ifd0[exifTagDateTime])
+	offset, subSec := "", ""
+	if entry, ok := ifd0[exifTagExifIFD]; ok {
+		sub := r.ifd(r.uint(entry))
+		if original := r.ascii(sub[exifTagDateTimeOriginal]); original != "" {
+			dateTime = original
+			offset = r.ascii(sub[exifTagOffsetTimeOrig])
+			subSec = r.ascii(sub[exifTagSubSecTimeOrig])
+		}
+		exif.Width = int(r.uint(sub[exifTagPixelXDimension]))
+		exif.Height = int(r.uint(sub[exifTagPixelYDimension]))
+	}
+	exif.CaptureTime = parseExifDateTime(dateTime, offset, subSec)
+
+	// 撮影位置
+	if entry, ok := ifd0[exifTagGPSIFD]; ok {
+		gps := r.ifd(r.uint(entry))
+		lat, latOk := r.degrees(gps[exifTagGPSLatitude])
+		lon, lonOk := r.degrees(gps[exifTagGPSLongitude])
+		if latOk && lonOk {
+			if strings.HasPrefix(r.ascii(gps[exifTagGPSLatitudeRef]), "S") {
+				lat = -lat
+			}
+			if strings.HasPrefix(r.ascii(gps[exifTagGPSLongitudeRef]), "W") {
+				lon = -lon
+			}
+			exif.HasLocation = true
+			exif.Latitude, exif.Longitude = lat, lon
+		}
+	}
+	return exif, nil
+}
+
+// exifFromJpeg は JPEG の APP1 セグメントから TIFF 形式の EXIF を取り出します
+// 画像データ（SOS）の手前で読み込みを終了します
+func exifFromJpeg(r *bufio.Reader) ([]byte, error) {
+	var soi [2]byte
+	if _, err := io.ReadFull(r, soi[:]); err != nil || soi != [2]byte{0xFF, 0xD8} {
+		return nil, fmt.Errorf("%w: not a jpeg file", ErrExifNotFound)
+	}
+	for {
+		// マーカーの前の 0xFF の詰め物を読み飛ばす
+		marker, err := r.ReadByte()
+		if err != nil {
+			return nil, ErrExifNotFound
+		}
+		if marker != 0xFF {
+			return nil, fmt.Errorf("%w: invalid jpeg marker", ErrExifNotFound)
+		}
+		for marker == 0xFF {
+			if marker, err = r.ReadByte(); err != nil {
+				return nil, ErrExifNotFound
+			}
+		}
+		switch {
+		case marker == 0xD9 || marker == 0xDA:
+			// EOI または SOS
+			return nil, ErrExifNotFound
+		case marker >= 0xD0 && marker <= 0xD7, marker == 0x01:
+			// 長さを持たないマーカー
+			continue
+		}
+
+		var length [2]byte
+		if _, err := io.ReadFull(r, length[:]); err != nil {
+			return nil, ErrExifNotFound
+		}
+		size := int(binary.BigEndian.Uint16(length[:])) - 2
+		if size < 0 {
+			return nil, fmt.Errorf("%w: invalid jpeg segment", ErrExifNotFound)
+		}
+		if marker != 0xE1 {
+			if _, err := r.Discard(size); err != nil {
+				return nil, ErrExifNotFound
+			}
+			continue
+		}
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, ErrExifNotFound
+		}
+		// XMP も APP1 のため、EXIF の識別子を確認
+		if raw, ok := bytes.CutPrefix(segment, []byte("Exif\x00\x00")); ok {
+			return raw, nil
+		}
+	}
+}
+
+// exifFromPng は PNG の eXIf チャンクから TIFF 形式の EXIF を取り出します
+func exifFromPng(r *bufio.Reader) ([]byte, error) {
+	var signature [8]byte
+	if _, err := io.ReadFull(r, signature[:]); err != nil || string(signature[:]) != "\x89PNG\r\n\x1a\n" {
+		return nil, fmt.Errorf("%w: not a png file", ErrExifNotFound)
+	}
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, ErrExifNotFound
+		}
+		size := int(binary.BigEndian.Uint32(header[:4]))
+		switch string(header[4:]) {
+		case "eXIf":
+			if size > exifMaxSize {
+				return nil, fmt.Errorf("%w: exif is too large", ErrExifNotFound)
+			}
+			raw := make([]byte, size)
+			if _, err := io.ReadFull(r, raw); err != nil {
+				return nil, ErrExifNotFound
+			}
+			return raw, nil
+		case "IDAT", "IEND":
+			// eXIf は画像データより前にある
+			return nil, ErrExifNotFound
+		}
+		// チャンクのデータと CRC を読み飛ばす
+		if _, err := r.Discard(size + 4); err != nil {
+			return nil, ErrExifNotFound
+		}
+	}
+}
+
+// parseExifDateTime は EXIF の日時を解析します（解析できない場合はゼロ値）
+func parseExifDateTime(dateTime, offset, subSec string) time.Time {
+	dateTime = strings.TrimSpace(dateTime)
+	if dateTime == "" || strings.HasPrefix(dateTime, "0000") {
+		return time.Time{}
+	}
+	loc := time.Local
+	if t, err := time.Parse("-07:00", strings.TrimSpace(offset)); err == nil {
+		_, seconds := t.Zone()
+		loc = time.FixedZone("", seconds)
+	}
+	t, err := time.ParseInLocation(exifDateTimeLayout, dateTime, loc)
+	if err != nil {
+		return time.Time{}
+	}
+	// 1秒未満の桁（"123" は 0.123 秒）
+	if digits := strings.TrimSpace(subSec); digits != "" && len(digits) <= 9 {
+		if nanos, err := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits))); err == nil {
+			t = t.Add(time.Duration(nanos))
+		}
+	}
+	return t
+}
+
+// exifEntry は IFD のエントリーです
+type exifEntry struct {
+	typ   uint16
+	count uint32
+
+	// value は値（4バイト以下の場合）または値へのオフセットを含む4バイト
+	value []byte
+}
+
+// exifReader は TIFF 形式の EXIF を読み込みます
+// 範囲外のオフセットなど壊れたデータは値なしとして扱います
+type exifReader struct {
+	raw   []byte
+	order binary.ByteOrder
+}
+
+// ifd は offset の IFD のエントリーをタグをキーにして返します
+func (r exifReader) ifd(offset uint32) map[uint16]exifEntry {
+	entries := map[uint16]exifEntry{}
+	if offset == 0 || int64(offset)+2 > int64(len(r.raw)) {
+		return entries
+	}
+	count := int(r.order.Uint16(r.raw[offset:]))
+	base := int(offset) + 2
+	for i := range min(count, exifMaxEntriesPerIFD) {
+		p := base + i*12
+		if p+12 > len(r.raw) {
+			break
+		}
+		entries[r.order.Uint16(r.raw[p:])] = exifEntry{
+			typ:   r.order.Uint16(r.raw[p+2:]),
+			count: r.order.Uint32(r.raw[p+4:]),
+			value: r.raw[p+8 : p+12],
+		}
+	}
+	return entries
+}
+
+// data はエントリーの値のバイト列を返します
+func (r exifReader) data(entry exifEntry) []byte {
+	var unit uint64
+	switch entry.typ {
+	case exifTypeByte, exifTypeASCII, exifTypeUndefined:
+		unit = 1
+	case exifTypeShort:
+		unit = 2
+	case exifTypeLong, exifTypeSLong:
+		unit = 4
+	case exifTypeRational, exifTypeSRational:
+		unit = 8
+	default:
+		return nil
+	}
+	size := unit * uint64(entry.count)
+	if size <= 4 {
+		return entry.value[:size]
+	}
+	offset := uint64(r.order.Uint32(entry.value))
+	if offset+size > uint64(len(r.raw)) {
+		return nil
+	}
+	return r.raw[offset : offset+size]
+}
+
+// ascii は ASCII 型の値を返します
+func (r exifReader) ascii(entry exifEntry) string {
+	if entry.typ != exifTypeASCII {
+		return ""
+	}
+	data, _, _ := bytes.Cut(r.data(entry), []byte{0})
+	return strings.TrimSpace(string(data))
+}
+
+// uint は SHORT・LONG 型の最初の値を返します
+func (r exifReader) uint(entry exifEntry) uint32 {
+	data := r.data(entry)
+	switch {
+	case entry.typ == exifTypeShort && len(data) >= 2:
+		return uint32(r.order.Uint16(data))
+	case entry.typ == exifTypeLong && len(data) >= 4:
+		return r.order.Uint32(data)
+	}
+	return 0
+}
+
+// degrees は度・分・秒の RATIONAL 3つの値を10進数の度に変換します
+func (r exifReader) degrees(entry exifEntry) (float64, bool) {
+	data := r.data(entry)
+	if entry.typ != exifTypeRational || len(data) < 24 {
+		return 0, false
+	}
+	value := 0.0
+	for i, scale := range []float64{1, 60, 3600} {
+		num := r.order.Uint32(data[i*8:])
+		den := r.order.Uint32(data[i*8+4:])
+		if den == 0 {
+			if num == 0 {
+				continue
+			}
+			return 0, false
+		}
+		value += float64(num) / float64(den) / scale
+	}
+	return value, true
+}
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+// tiffEntry はテスト用の TIFF 形式の EXIF を作成するための IFD のエントリーです
+type tiffEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	data  []byte
+}
+
+// buildTiff は IFD0 と Exif IFD からなる TIFF 形式の EXIF を作成します
+// sub が nil でない場合は IFD0 の末尾に Exif IFD へのポインタを追加します
+func buildTiff(order binary.ByteOrder, ifd0, sub []tiffEntry) []byte {
+	if sub != nil {
+		ifd0 = append(ifd0, tiffEntry{tag: exifTagExifIFD, typ: exifTypeLong, count: 1})
+	}
+	ifdSize := func(n int) int { return 2 + 12*n + 4 }
+	ifd0Offset := 8
+	subOffset := ifd0Offset + ifdSize(len(ifd0))
+	dataOffset := subOffset
+	if sub != nil {
+		dataOffset += ifdSize(len(sub))
+	}
+
+	buf := make([]byte, dataOffset)
+	if order == binary.ByteOrder(binary.LittleEndian) {
+		copy(buf, "II")
+	} else {
+		copy(buf, "MM")
+	}
+	order.PutUint16(buf[2:], 42)
+	order.PutUint32(buf[4:], uint32(ifd0Offset))
+
+	write := func(offset int, entries []tiffEntry) {
+		order.PutUint16(buf[offset:], uint16(len(entries)))
+		for i, entry := range entries {
+			p := offset + 2 + 12*i
+			data := entry.data
+			if entry.tag == exifTagExifIFD && sub != nil {
+				data = tiffLong(order, uint32(subOffset))
+			}
+			order.PutUint16(buf[p:], entry.tag)
+			order.PutUint16(buf[p+2:], entry.typ)
+			order.PutUint32(buf[p+4:], entry.count)
+			if len(data) <= 4 {
+				copy(buf[p+8:p+12], data)
+				continue
+			}
+			order.PutUint32(buf[p+8:], uint32(len(buf)))
+			buf = append(buf, data...)
+		}
+	}
+	write(ifd0Offset, ifd0)
+	if sub != nil {
+		write(subOffset, sub)
+	}
+	return buf
+}
+
+func tiffShort(order binary.ByteOrder, v uint16) []byte {
+	data := make([]byte, 2)
+	order.PutUint16(data, v)
+	return data
+}
+
+func tiffLong(order binary.ByteOrder, v uint32) []byte {
+	data := make([]byte, 4)
+	order.PutUint32(data, v)
+	return data
+}
+
+func tiffASCII(tag uint16, text string) tiffEntry {
+	return tiffEntry{tag: tag, typ: exifTypeASCII, count: uint32(len(text) + 1), data: append([]byte(text), 0)}
+}
+
+// sampleTiff は向き以外の項目を一通り含む EXIF を作成します
+func sampleTiff(order binary.ByteOrder, orientation uint16) []byte {
+	return buildTiff(order,
+		[]tiffEntry{
+			{tag: exifTagOrientation, typ: exifTypeShort, count: 1, data: tiffShort(order, orientation)},
+			tiffASCII(exifTagMake, "Canon"),
+			tiffASCII(exifTagModel, "EOS R5"),
+		},
+		[]tiffEntry{
+			tiffASCII(exifTagDateTimeOriginal, "2024:04:01 09:30:00"),
+			tiffASCII(exifTagOffsetTimeOrig, "+09:00"),
+			{tag: exifTagPixelXDimension, typ: exifTypeLong, count: 1, data: tiffLong(order, 6000)},
+			{tag: exifTagPixelYDimension, typ: exifTypeShort, count: 1, data: tiffShort(order, 4000)},
+		})
+}
+
+func TestParseExifByteOrder(t *testing.T) {
+	want := time.Date(2024, 4, 1, 9, 30, 0, 0, time.FixedZone("", 9*60*60))
+	tests := []struct {
+		name  string
+		order binary.ByteOrder
+	}{
+		{"little endian", binary.LittleEndian},
+		{"big endian", binary.BigEndian},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exif, err := ParseExif(sampleTiff(tt.order, 1))
+			if err != nil {
+				t.Fatalf("ParseExif() error = %v", err)
+			}
+			if exif.Make != "Canon" || exif.Model != "EOS R5" {
+				t.Errorf("Make, Model = %q, %q", exif.Make, exif.Model)
+			}
+			if !exif.CaptureTime.Equal(want) {
+				t.Errorf("CaptureTime = %v, want %v", exif.CaptureTime, want)
+			}
+			if exif.Width != 6000 || exif.Height != 4000 {
+				t.Errorf("Width, Height = %d, %d", exif.Width, exif.Height)
+			}
+		})
+	}
+}
+
+func TestParseExifOrientation(t *testing.T) {
+	tests := []struct {
+		orientation uint16
+		want        int
+	}{
+		{5, 5},
+		{6, 6},
+		{7, 7},
+		{8, 8},
+		// 範囲外は不明として 1
+		{0, 1},
+		{9, 1},
+	}
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for _, tt := range tests {
+			exif, err := ParseExif(sampleTiff(order, tt.orientation))
+			if err != nil {
+				t.Fatalf("%v: ParseExif() error = %v", order, err)
+			}
+			if exif.Orientation != tt.want {
+				t.Errorf("%v: Orientation(%d) = %d, want %d", order, tt.orientation, exif.Orientation, tt.want)
+			}
+		}
+	}
+}
+
+func TestParseExifTruncated(t *testing.T) {
+	order := binary.ByteOrder(binary.LittleEndian)
+	full := sampleTiff(order, 6)
+
+	// IFD0 のエントリー数を実際より多く記録したデータ
+	overCount := append([]byte(nil), full[:8+2+12]...)
+	order.PutUint16(overCount[8:], 0xFFFF)
+
+	tests := []struct {
+		name            string
+		raw             []byte
+		wantErr         error
+		wantOrientation int
+		wantMake        string
+		wantWidth       int
+	}{
+		{name: "header only", raw: full[:7], wantErr: ErrExifNotFound},
+		{name: "invalid byte order", raw: append([]byte("XX"), full[2:]...), wantErr: ErrExifNotFound},
+		// 最初のエントリー（向き）の途中で切れている
+		{name: "cut in first entry", raw: full[:8+2+6], wantOrientation: 1},
+		// 最初のエントリー（向き）のみ読み込める
+		{name: "cut after first entry", raw: full[:8+2+12], wantOrientation: 6},
+		{name: "entry count exceeds data", raw: overCount, wantOrientation: 6},
+		// IFD は全て読み込めるが、4バイトを超える値の領域がない
+		{name: "cut before values", raw: full[:8+(2+12*4+4)+(2+12*4+4)], wantOrientation: 6, wantWidth: 6000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exif, err := ParseExif(tt.raw)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseExif() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseExif() error = %v", err)
+			}
+			if exif.Orientation != tt.wantOrientation || exif.Make != tt.wantMake || exif.Width != tt.wantWidth {
+				t.Errorf("Orientation, Make, Width = %d, %q, %d, want %d, %q, %d",
+					exif.Orientation, exif.Make, exif.Width, tt.wantOrientation, tt.wantMake, tt.wantWidth)
+			}
+			if !exif.CaptureTime.IsZero() {
+				t.Errorf("CaptureTime = %v, want zero", exif.CaptureTime)
+			}
+		})
+	}
+}
@@ -34,6 +34,27 @@ func NormalizeAbsPath(absPath string) (string, error) {
 	return "", err
 }
 
+// PathistSystemPrefix は Pathist が内部で利用するファイル・フォルダー名の接頭辞です
+const PathistSystemPrefix = ".pathist"
+
+// ErrSystemPath はパスが Pathist の内部管理用ファイル・フォルダーを指していることを示します
+var ErrSystemPath = errors.New("system path is not accessible")
+
+// FilenameIsPathistSystem は Pathist の内部管理用ファイル・フォルダーかどうかをチェック
+func FilenameIsPathistSystem(filename string) bool {
+	return strings.HasPrefix(filename, PathistSystemPrefix)
+}
+
+// PathIsPathistSystem はパスの途中に Pathist の内部管理用フォルダーが含まれるかをチェック
+func PathIsPathistSystem(pathname string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(pathname), "/") {
+		if FilenameIsPathistSystem(part) {
+			return true
+		}
+	}
+	return false
+}
+
 // パスからファイル名またはフォルダー名を取得します
 func GetBaseName(pathname string) string {
 	basename := filepath.Base(pathname)
@@ -44,7 +65,11 @@ func GetBaseName(pathname string) string {
 }
 
 // IsExcel エクセルファイルかどうかをチェック
+// Excel が編集中に作成する "~$" で始まる一時ファイルは除外します
 func FilenameIsExcel(filename string) bool {
+	if strings.HasPrefix(filename, "~$") {
+		return false
+	}
 	excelSuffix := []string{".xlsx", ".xls"}
 	nameLower := strings.ToLower(filename)
 
@@ -56,6 +81,11 @@ func FilenameIsExcel(filename string) bool {
 	return false
 }
 
+// FilenameIsXlsx は ReadWorkbook で読み込み可能な xlsx ファイルかどうかをチェック
+func FilenameIsXlsx(filename string) bool {
+	return FilenameIsExcel(filename) && strings.HasSuffix(strings.ToLower(filename), ".xlsx")
+}
+
 // EntryIsExcelFile エクセルファイルかどうかをチェック
 func EntryIsExcelFile(entry os.DirEntry) bool {
 	return FilenameIsExcel(entry.Name())
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"os"
+	"slices"
+	"strings"
+)
+
+// systemFilenames は OS や NAS が自動的に作成する管理用のファイル・フォルダー名です
+var systemFilenames = []string{
+	".ds_store",
+	"thumbs.db",
+	"desktop.ini",
+	"@eadir",
+	"#recycle",
+	"#snapshot",
+	"$recycle.bin",
+	"system volume information",
+}
+
+// FileAttributesOf はファイルの隠し属性とシステム属性を返します
+//   - hidden: ドットで始まる名前、または OS の隠し属性
+//   - system: Pathist の内部管理用、OS や NAS の管理用、または OS のシステム属性
+func FileAttributesOf(path string, fi os.FileInfo) (hidden, system bool) {
+	name := fi.Name()
+	hidden = strings.HasPrefix(name, ".")
+	system = FilenameIsPathistSystem(name) || slices.Contains(systemFilenames, strings.ToLower(name))
+
+	osHidden, osSystem := osFileAttributes(path, fi)
+	return hidden || osHidden, system || osSystem
+}
@@ -0,0 +1,11 @@
+//go:build !windows
+
+package core
+
+import "os"
+
+// osFileAttributes は隠し属性とシステム属性を返します
+// Windows 以外では名前以外の属性を持たないため常に false です
+func osFileAttributes(_ string, _ os.FileInfo) (hidden, system bool) {
+	return false, false
+}
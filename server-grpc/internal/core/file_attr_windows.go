@@ -0,0 +1,19 @@
+//go:build windows
+
+package core
+
+import (
+	"os"
+	"syscall"
+)
+
+// osFileAttributes は Windows のファイル属性から隠し属性とシステム属性を返します
+func osFileAttributes(_ string, fi os.FileInfo) (hidden, system bool) {
+	data, ok := fi.Sys().(*syscall.Win32FileAttributeData)
+	if !ok {
+		return false, false
+	}
+	hidden = data.FileAttributes&syscall.FILE_ATTRIBUTE_HIDDEN != 0
+	system = data.FileAttributes&syscall.FILE_ATTRIBUTE_SYSTEM != 0
+	return hidden, system
+}
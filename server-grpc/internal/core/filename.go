@@ -0,0 +1,130 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrUnsafeFilename は Windows・macOS・Linux のいずれかで使用できないファイル名・フォルダー名のエラーです
+var ErrUnsafeFilename = errors.New("unsafe filename")
+
+// MaxFilenameBytes はファイル名・フォルダー名の最大長（UTF-8 のバイト数）
+// Linux（ext4 等）の 255 バイトが最も厳しいため、これに合わせます
+const MaxFilenameBytes = 255
+
+// filenameForbiddenChars は Windows でファイル名に使用できない文字です（"/" は全 OS で使用不可）
+// macOS の ":" は Finder で "/" として表示されるため同様に禁止します
+const filenameForbiddenChars = `<>:"/\|?*`
+
+// windowsReservedNames は Windows の予約デバイス名です（拡張子付き・大文字小文字違いも使用不可）
+var windowsReservedNames = map[string]struct{}{
+	"CON": {}, "PRN": {}, "AUX": {}, "NUL": {},
+	"COM1": {}, "COM2": {}, "COM3": {}, "COM4": {}, "COM5": {}, "COM6": {}, "COM7": {}, "COM8": {}, "COM9": {},
+	"COM¹": {}, "COM²": {}, "COM³": {},
+	"LPT1": {}, "LPT2": {}, "LPT3": {}, "LPT4": {}, "LPT5": {}, "LPT6": {}, "LPT7": {}, "LPT8": {}, "LPT9": {},
+	"LPT¹": {}, "LPT²": {}, "LPT³": {},
+}
+
+// ValidateFilename は name が Windows・macOS・Linux の全てでファイル名・フォルダー名として使用できるか検査します。
+//   - 空の名前、"." と ".."
+//   - 制御文字と Windows で使用できない文字（<>:"/\|?*）
+//   - 末尾のピリオド・スペース（Windows では削除されて別の名前になります）
+//   - Windows の予約デバイス名（CON, PRN, AUX, NUL, COM1〜9, LPT1〜9、拡張子付きを含む）
+//   - MaxFilenameBytes を超える長さ
+//
+// 問題がある場合は ErrUnsafeFilename をラップしたエラーを返します。メッセージには SanitizeFilename の候補を含めます。
+func ValidateFilename(name string) error {
+	problems := filenameProblems(name)
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %q: %s（候補: %q）",
+		ErrUnsafeFilename, name, strings.Join(problems, "、"), SanitizeFilename(name))
+}
+
+// filenameProblems は name の問題点を返します
+func filenameProblems(name string) []string {
+	if name == "" || name == "." || name == ".." {
+		return []string{"名前が空です"}
+	}
+
+	problems := []string{}
+	if !utf8.ValidString(name) {
+		problems = append(problems, "UTF-8 として無効な文字を含みます")
+	}
+	forbidden := []string{}
+	control := false
+	for _, r := range name {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			control = true
+		case strings.ContainsRune(filenameForbiddenChars, r):
+			if s := string(r); !slices.Contains(forbidden, s) {
+				forbidden = append(forbidden, s)
+			}
+		}
+	}
+	if control {
+		problems = append(problems, "制御文字を含みます")
+	}
+	if len(forbidden) > 0 {
+		problems = append(problems, "使用できない文字 "+strings.Join(forbidden, " ")+" を含みます")
+	}
+	if strings.HasSuffix(name, ".") || strings.HasSuffix(name, " ") {
+		problems = append(problems, "末尾がピリオドまたはスペースです")
+	}
+	if filenameIsReserved(name) {
+		problems = append(problems, "Windows の予約名です")
+	}
+	if len(name) > MaxFilenameBytes {
+		problems = append(problems, fmt.Sprintf("長すぎます（%d バイト、最大 %d バイト）", len(name), MaxFilenameBytes))
+	}
+	return problems
+}
+
+// SanitizeFilename は name を Windows・macOS・Linux の全てで使用できる名前に変換します。
+//   - 制御文字と使用できない文字は "_" に置き換えます。
+//   - 末尾のピリオド・スペースは削除します。
+//   - Windows の予約名は拡張子の前に "_" を付けます。
+//   - MaxFilenameBytes を超える場合は文字の途中で切らないように末尾を切り詰めます。
+func SanitizeFilename(name string) string {
+	name = strings.ToValidUTF8(name, "_")
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(filenameForbiddenChars, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	name = strings.TrimRight(name, ". ")
+	if filenameIsReserved(name) {
+		// 拡張子の前に付ける（"CON.txt" → "CON_.txt"）
+		base, ext, found := strings.Cut(name, ".")
+		name = base + "_"
+		if found {
+			name += "." + ext
+		}
+	}
+	if len(name) > MaxFilenameBytes {
+		cut := MaxFilenameBytes
+		for cut > 0 && !utf8.RuneStart(name[cut]) {
+			cut--
+		}
+		name = name[:cut]
+	}
+	name = strings.TrimRight(name, ". ")
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// filenameIsReserved は name の拡張子を除いた部分が Windows の予約名かどうかを返します
+func filenameIsReserved(name string) bool {
+	base, _, _ := strings.Cut(name, ".")
+	_, reserved := windowsReservedNames[strings.ToUpper(strings.TrimRight(base, " "))]
+	return reserved
+}
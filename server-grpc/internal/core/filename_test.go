@@ -0,0 +1,100 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"見積書.xlsx", true},
+		{".gitignore", true},
+		{"", false},
+		{".", false},
+		{"..", false},
+
+		// Windows の予約名（拡張子付き・大文字小文字違いを含む）
+		{"CON", false},
+		{"con.txt", false},
+		{"Nul.tar.gz", false},
+		{"COM1.xlsx", false},
+		{"LPT¹.txt", false},
+		{"CON .txt", false},
+		{"CONSOLE.txt", true},
+		{"COM0", true},
+		{"xCON.txt", true},
+
+		// 末尾のピリオド・スペース
+		{"報告書.", false},
+		{"報告書 ", false},
+		{"報告書. ", false},
+		{"報告書 .txt", true},
+
+		// 使用できない文字
+		{"a:b", false},
+		{"a?b", false},
+		{"a\tb", false},
+		{"a\x7fb", false},
+		{"a／b：c", true},
+
+		// 長さ（"あ" は 3 バイト）
+		{strings.Repeat("あ", 85), true},
+		{strings.Repeat("あ", 85) + "a", false},
+		{strings.Repeat("a", MaxFilenameBytes), true},
+		{strings.Repeat("a", MaxFilenameBytes+1), false},
+	}
+	for _, tt := range tests {
+		err := ValidateFilename(tt.name)
+		if tt.ok && err != nil {
+			t.Errorf("ValidateFilename(%q) error = %v", tt.name, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrUnsafeFilename) {
+			t.Errorf("ValidateFilename(%q) error = %v, want %v", tt.name, err, ErrUnsafeFilename)
+		}
+	}
+}
+
+func TestSanitizeFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"見積書.xlsx", "見積書.xlsx"},
+		{"", "_"},
+		{"...", "_"},
+
+		// Windows の予約名は拡張子の前に "_" を付ける
+		{"CON", "CON_"},
+		{"con.txt", "con_.txt"},
+		{"Nul.tar.gz", "Nul_.tar.gz"},
+		{"CON .txt", "CON _.txt"},
+
+		// 末尾のピリオド・スペースは削除
+		{"報告書. .", "報告書"},
+		{"CON.", "CON_"},
+
+		// 使用できない文字・制御文字は "_" に置き換え
+		{"a:b?.txt", "a_b_.txt"},
+		{"a\tb\x7f", "a_b_"},
+		{"a／b", "a／b"},
+
+		// 文字の途中で切らない（"a" の後の "あ" の 85 文字目は 254〜256 バイト目）
+		{"a" + strings.Repeat("あ", 85), "a" + strings.Repeat("あ", 84)},
+		{strings.Repeat("あ", 86), strings.Repeat("あ", 85)},
+		// 切り詰めた後の末尾のピリオドも削除
+		{strings.Repeat("a", 254) + ". b", strings.Repeat("a", 254)},
+	}
+	for _, tt := range tests {
+		got := SanitizeFilename(tt.name)
+		if got != tt.want {
+			t.Errorf("SanitizeFilename(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if err := ValidateFilename(got); err != nil {
+			t.Errorf("ValidateFilename(SanitizeFilename(%q)) error = %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// hashCacheEntry はファイル内容のハッシュとその算出時のファイル情報です
+type hashCacheEntry struct {
+	// Size は算出時のファイルサイズ
+	Size int64 `json:"size"`
+
+	// ModTime は算出時の最終更新時刻（UnixNano）
+	ModTime int64 `json:"mtime"`
+
+	// Digest はファイル内容の BLAKE2b-256 ハッシュ（16進文字列）
+	Digest string `json:"digest"`
+}
+
+// HashCache はファイル内容のハッシュをパス・サイズ・更新時刻で管理するキャッシュです。
+//   - サイズまたは更新時刻が変わったファイルは再計算します。
+//   - Save で JSON ファイルに保存し、再起動後も再利用します。
+type HashCache struct {
+	mu sync.Mutex
+
+	// path はキャッシュを保存するファイルのパス（空の場合は保存しない）
+	path string
+
+	// entries は絶対パスをキーとしたキャッシュ
+	entries map[string]hashCacheEntry
+
+	// dirty は保存されていない変更があるかどうか
+	dirty bool
+}
+
+// OpenHashCache は path に保存されたキャッシュを読み込みます。
+// ファイルが存在しない、または読み込めない場合は空のキャッシュを返します。
+func OpenHashCache(path string) *HashCache {
+	c := &HashCache{path: path, entries: map[string]hashCacheEntry{}}
+	if path == "" {
+		return c
+	}
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, &c.entries); err != nil || c.entries == nil {
+			c.entries = map[string]hashCacheEntry{}
+		}
+	}
+	return c
+}
+
+// Len はキャッシュ済みのファイル数を返します
+func (c *HashCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Lookup はファイル情報が一致するキャッシュ済みのハッシュを返します
+func (c *HashCache) Lookup(path string, fi os.FileInfo) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[path]
+	if !ok || entry.Size != fi.Size() || entry.ModTime != fi.ModTime().UnixNano() {
+		return "", false
+	}
+	return entry.Digest, true
+}
+
+// Digest は path のファイル内容のハッシュを返します。
+// キャッシュが有効な場合はファイルを読み込みません。
+func (c *HashCache) Digest(path string) (string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if digest, ok := c.Lookup(path, fi); ok {
+		return digest, nil
+	}
+
+	digest, err := Blake2bHexFromFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	// 計算中に変更された場合はキャッシュしない
+	after, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if after.Size() == fi.Size() && after.ModTime().Equal(fi.ModTime()) {
+		c.mu.Lock()
+		c.entries[path] = hashCacheEntry{Size: fi.Size(), ModTime: fi.ModTime().UnixNano(), Digest: digest}
+		c.dirty = true
+		c.mu.Unlock()
+	}
+	return digest, nil
+}
+
+// Prune は keep が false を返すパスのキャッシュを削除します
+// 戻り値は削除した件数です
+func (c *HashCache) Prune(keep func(path string) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for path := range c.entries {
+		if !keep(path) {
+			delete(c.entries, path)
+			removed++
+		}
+	}
+	if removed > 0 {
+		c.dirty = true
+	}
+	return removed
+}
+
+// Save は変更がある場合にキャッシュをファイルに保存します
+// 書き込み途中の状態を残さないよう一時ファイルに書き込んでから置き換えます
+func (c *HashCache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.path == "" || !c.dirty {
+		return nil
+	}
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
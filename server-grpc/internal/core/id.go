@@ -1,7 +1,10 @@
 package core
 
 import (
+	"encoding/hex"
+	"io"
 	"math/big"
+	"os"
 
 	"golang.org/x/crypto/blake2b"
 )
@@ -39,3 +42,25 @@ func ParseIdFromBytes(data []byte) string {
 func GenerateIdFromString(str string) string {
 	return ParseIdFromBytes([]byte(str))
 }
+
+// Blake2bHexFromReader は r の内容全体の BLAKE2b-256 ハッシュを16進文字列で返します
+func Blake2bHexFromReader(r io.Reader) (string, error) {
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Blake2bHexFromFile はファイル内容の BLAKE2b-256 ハッシュを16進文字列で返します
+func Blake2bHexFromFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	return Blake2bHexFromReader(file)
+}
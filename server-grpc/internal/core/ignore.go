@@ -0,0 +1,198 @@
+package core
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFilename はフォルダー毎の除外ルールを記述するファイル名です
+// 書式は .gitignore のサブセット（#コメント、! による否定、末尾 / でフォルダーのみ、先頭 / で固定、* ? [] **）です
+const IgnoreFilename = PathistSystemPrefix + "ignore"
+
+// DefaultIgnorePatterns は常に適用する除外ルールです
+// Office・OS が作成する一時ファイルや管理用ファイルを除外します
+var DefaultIgnorePatterns = []string{
+	"~$*",
+	".~lock.*#",
+	"*.tmp",
+	".DS_Store",
+	"._*",
+	"Thumbs.db",
+	"desktop.ini",
+	".git/",
+}
+
+// ignoreRule は除外ルールの1行です
+type ignoreRule struct {
+	// base はルールを記述したフォルダーの絶対パス
+	base string
+
+	// pattern は先頭の ! と / 、末尾の / を除いたパターン
+	pattern string
+
+	// negate は ! で始まる（除外しない）ルールかどうか
+	negate bool
+
+	// dirOnly はフォルダーのみに一致するルールかどうか
+	dirOnly bool
+
+	// anchored は base からの相対パス全体と照合するかどうか（パターンが / を含む場合）
+	anchored bool
+}
+
+// IgnoreRules はフォルダーを降りながら積み重ねる除外ルールです。
+//   - 後に追加したルールほど優先し、最後に一致したルールで除外するかを決めます。
+//   - Pathist の内部管理用ファイル・フォルダーは常に除外します。
+type IgnoreRules struct {
+	rules []ignoreRule
+}
+
+// NewIgnoreRules は root を基準に patterns を適用する除外ルールを作成します
+func NewIgnoreRules(root string, patterns []string) *IgnoreRules {
+	r := &IgnoreRules{}
+	for _, pattern := range patterns {
+		if rule, ok := parseIgnoreRule(filepath.Clean(root), pattern); ok {
+			r.rules = append(r.rules, rule)
+		}
+	}
+	return r
+}
+
+// WithFolder は absFolder に除外ルールのファイルがあれば読み込み、追加した除外ルールを返します
+// ファイルがない場合は自身を返します（元の除外ルールは変更しません）
+func (r *IgnoreRules) WithFolder(absFolder string) (*IgnoreRules, error) {
+	file, err := os.Open(filepath.Join(absFolder, IgnoreFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return r, err
+	}
+	defer file.Close()
+
+	base := filepath.Clean(absFolder)
+	next := &IgnoreRules{rules: append([]ignoreRule(nil), r.rules...)}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if rule, ok := parseIgnoreRule(base, scanner.Text()); ok {
+			next.rules = append(next.rules, rule)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return r, err
+	}
+	return next, nil
+}
+
+// WithFolders は root から absFolder までの各フォルダーの除外ルールを順に読み込んだ除外ルールを返します
+// absFolder が root の配下でない場合は自身を返します
+func (r *IgnoreRules) WithFolders(root, absFolder string) (*IgnoreRules, error) {
+	rel, err := filepath.Rel(root, absFolder)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return r, nil
+	}
+	next, folder := r, filepath.Clean(root)
+	if next, err = next.WithFolder(folder); err != nil {
+		return r, err
+	}
+	if rel == "." {
+		return next, nil
+	}
+	for _, name := range strings.Split(rel, string(filepath.Separator)) {
+		folder = filepath.Join(folder, name)
+		if next, err = next.WithFolder(folder); err != nil {
+			return r, err
+		}
+	}
+	return next, nil
+}
+
+// Match は absPath を除外するかどうかを返します
+func (r *IgnoreRules) Match(absPath string, isDir bool) bool {
+	absPath = filepath.Clean(absPath)
+	name := filepath.Base(absPath)
+	if FilenameIsPathistSystem(name) {
+		return true
+	}
+
+	ignored := false
+	for _, rule := range r.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		rel, err := filepath.Rel(rule.base, absPath)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		target := name
+		if rule.anchored {
+			target = filepath.ToSlash(rel)
+		}
+		if globMatch(rule.pattern, target) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// parseIgnoreRule は除外ルールの1行を解析します（空行・コメントは ok = false）
+func parseIgnoreRule(base, line string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+	rule := ignoreRule{base: base}
+	if rest, ok := strings.CutPrefix(line, "!"); ok {
+		rule.negate = true
+		line = rest
+	}
+	if rest, ok := strings.CutSuffix(line, "/"); ok {
+		rule.dirOnly = true
+		line = rest
+	}
+	if rest, ok := strings.CutPrefix(line, "/"); ok {
+		rule.anchored = true
+		line = rest
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+	rule.pattern = line
+	return rule, true
+}
+
+// globMatch はスラッシュ区切りの name がパターンに一致するかを返します
+// ** は0個以上のフォルダーに一致し、それ以外の要素は path.Match で照合します
+func globMatch(pattern, name string) bool {
+	return globMatchParts(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// globMatchParts はパス要素毎に照合します
+func globMatchParts(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			// 残りのパターンが残りのパスのいずれかの位置から一致するか
+			for i := 0; i <= len(names); i++ {
+				if globMatchParts(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(names) == 0 {
+			return false
+		}
+		if ok, err := path.Match(patterns[0], names[0]); err != nil || !ok {
+			return false
+		}
+		patterns, names = patterns[1:], names[1:]
+	}
+	return len(names) == 0
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrPathOutsideRoot はパスがルートフォルダーの外を指していることを示します
+var ErrPathOutsideRoot = errors.New("path is outside of the root folder")
+
+// SymlinkPolicy はパス解決時のシンボリックリンクの扱いです
+type SymlinkPolicy string
+
+const (
+	// SymlinkFollowWithinRoot はリンク先がルート配下の場合のみシンボリックリンクを辿ります
+	SymlinkFollowWithinRoot SymlinkPolicy = "follow-within-root"
+
+	// SymlinkNeverFollow はシンボリックリンクを含むパスを拒否します
+	SymlinkNeverFollow SymlinkPolicy = "never-follow"
+
+	// SymlinkAllow はリンク先を確認せずにシンボリックリンクを辿ります
+	SymlinkAllow SymlinkPolicy = "allow"
+)
+
+// ParseSymlinkPolicy は文字列を SymlinkPolicy に変換します
+// 空文字列は SymlinkFollowWithinRoot として扱います
+func ParseSymlinkPolicy(text string) (SymlinkPolicy, error) {
+	switch policy := SymlinkPolicy(strings.TrimSpace(text)); policy {
+	case "":
+		return SymlinkFollowWithinRoot, nil
+	case SymlinkFollowWithinRoot, SymlinkNeverFollow, SymlinkAllow:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown symlink policy: %s", text)
+}
+
+// PathJail は相対パスをルートフォルダー配下の絶対パスに解決します。
+//   - ".." によるルート外への移動を拒否します。
+//   - シンボリックリンクは SymlinkPolicy に従って検証します。
+type PathJail struct {
+	// root はルートフォルダーの絶対パス（シンボリックリンク解決済み）
+	root string
+
+	// policy はシンボリックリンクの扱い
+	policy SymlinkPolicy
+}
+
+// NewPathJail は root をルートとする PathJail を作成します
+func NewPathJail(root string, policy SymlinkPolicy) (*PathJail, error) {
+	resolved, err := NormalizeAbsPath(root)
+	if err != nil {
+		return nil, err
+	}
+	return &PathJail{root: resolved, policy: policy}, nil
+}
+
+// Root はルートフォルダーの絶対パスを返します
+func (j *PathJail) Root() string {
+	return j.root
+}
+
+// Resolve は relPath をルートフォルダー配下の絶対パスに変換します。
+// ルート外を指す場合は ErrPathOutsideRoot をラップしたエラーを返します。
+func (j *PathJail) Resolve(relPath string) (string, error) {
+	// 絶対パスやホームディレクトリ指定は使用できない
+	slashPath := filepath.ToSlash(relPath)
+	if strings.HasPrefix(slashPath, "~/") || strings.HasPrefix(slashPath, "/") ||
+		filepath.IsAbs(relPath) || filepath.VolumeName(relPath) != "" {
+		return "", fmt.Errorf("%w: 絶対パスは使用できません: %s", ErrPathOutsideRoot, relPath)
+	}
+
+	absPath := filepath.Join(j.root, filepath.FromSlash(slashPath))
+	if err := j.Verify(absPath); err != nil {
+		return "", err
+	}
+	return absPath, nil
+}
+
+// Verify は absPath がルートフォルダー配下にあることを検証します。
+// 存在する部分のシンボリックリンクは SymlinkPolicy に従って検証します。
+func (j *PathJail) Verify(absPath string) error {
+	absPath = filepath.Clean(absPath)
+	if !j.contains(absPath) {
+		return fmt.Errorf("%w: %s", ErrPathOutsideRoot, absPath)
+	}
+
+	switch j.policy {
+	case SymlinkAllow:
+		return nil
+
+	case SymlinkNeverFollow:
+		// ルート以下の各要素がシンボリックリンクでないことを確認
+		rel, _ := filepath.Rel(j.root, absPath)
+		current := j.root
+		for _, part := range strings.Split(rel, string(os.PathSeparator)) {
+			if part == "." || part == "" {
+				continue
+			}
+			current = filepath.Join(current, part)
+			fi, err := os.Lstat(current)
+			if err != nil {
+				// 存在しない要素以降はシンボリックリンクではない
+				return nil
+			}
+			if fi.Mode()&os.ModeSymlink != 0 {
+				return fmt.Errorf("%w: シンボリックリンクは使用できません: %s", ErrPathOutsideRoot, current)
+			}
+		}
+		return nil
+	}
+
+	// SymlinkFollowWithinRoot
+	// 存在する最も深い要素のリンク先がルート配下であることを確認
+	existing, rest := absPath, ""
+	for {
+		resolved, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			resolved = filepath.Join(resolved, rest)
+			if !j.contains(resolved) {
+				return fmt.Errorf("%w: リンク先がルートフォルダーの外です: %s", ErrPathOutsideRoot, absPath)
+			}
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing || !j.contains(parent) {
+			return err
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = parent
+	}
+}
+
+// contains は absPath がルートフォルダー自身またはその配下かをチェックします
+func (j *PathJail) contains(absPath string) bool {
+	rel, err := filepath.Rel(j.root, absPath)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator)) && !filepath.IsAbs(rel)
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPathJailResolve(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "a", "b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// in はルート配下、out はルート外へのリンク
+	if err := os.Symlink(filepath.Join(root, "a"), filepath.Join(root, "in")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "out")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join("..", "..", filepath.Base(outside)), filepath.Join(root, "a", "up")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		relPath string
+		want    string
+		// ok はポリシー毎に解決できるかどうか（follow-within-root, never-follow, allow の順）
+		ok [3]bool
+	}{
+		{"", ".", [3]bool{true, true, true}},
+		{"a/b", "a/b", [3]bool{true, true, true}},
+		{"a/b/../b", "a/b", [3]bool{true, true, true}},
+		{"missing/new", "missing/new", [3]bool{true, true, true}},
+
+		// ".." によるルート外への移動
+		{"..", "", [3]bool{}},
+		{"../x", "", [3]bool{}},
+		{"a/../../x", "", [3]bool{}},
+		{"a/b/../../../" + filepath.Base(outside), "", [3]bool{}},
+
+		// 絶対パス・ホームディレクトリ
+		{"/etc", "", [3]bool{}},
+		{"~/x", "", [3]bool{}},
+
+		// ルート配下へのリンク
+		{"in/b", "in/b", [3]bool{true, false, true}},
+		// ".." はリンクを辿る前に取り除くため in 自体を指す
+		{"in/b/..", "in", [3]bool{true, false, true}},
+		{"in/../a", "a", [3]bool{true, true, true}},
+
+		// ルート外へのリンク（存在しない配下を含む）
+		{"out", "out", [3]bool{false, false, true}},
+		{"out/missing/new", "out/missing/new", [3]bool{false, false, true}},
+		{"a/up/x", "a/up/x", [3]bool{false, false, true}},
+	}
+	policies := []SymlinkPolicy{SymlinkFollowWithinRoot, SymlinkNeverFollow, SymlinkAllow}
+	for i, policy := range policies {
+		jail, err := NewPathJail(root, policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range tests {
+			got, err := jail.Resolve(tt.relPath)
+			if !tt.ok[i] {
+				if !errors.Is(err, ErrPathOutsideRoot) {
+					t.Errorf("%s: Resolve(%q) = %q, %v, want %v", policy, tt.relPath, got, err, ErrPathOutsideRoot)
+				}
+				continue
+			}
+			want := filepath.Join(jail.Root(), filepath.FromSlash(tt.want))
+			if err != nil || got != want {
+				t.Errorf("%s: Resolve(%q) = %q, %v, want %q", policy, tt.relPath, got, err, want)
+			}
+		}
+	}
+}
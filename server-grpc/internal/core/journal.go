@@ -0,0 +1,376 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// 変更ジャーナルで扱う変更種別
+const (
+	JournalKindFile    = "file"
+	JournalKindCompany = "company"
+	JournalKindKoji    = "koji"
+)
+
+// journalSegmentPrefix はセグメントファイル名の接頭辞です
+const journalSegmentPrefix = "journal-"
+
+// journalSegmentSuffix はセグメントファイル名の拡張子です
+const journalSegmentSuffix = ".log"
+
+// JournalEntry は変更ジャーナルの1レコードです。
+type JournalEntry struct {
+	// Seq は単調増加するシーケンス番号です
+	Seq uint64 `json:"seq"`
+
+	// Time は変更を検知した時刻です
+	Time time.Time `json:"time"`
+
+	// Kind は変更対象の種別です（file, company, koji）
+	Kind string `json:"kind"`
+
+	// Op は変更操作です（create, write, remove, rename, chmod）
+	Op string `json:"op"`
+
+	// Path は変更対象の絶対パスです
+	Path string `json:"path"`
+
+	// EntityId は変更対象のエンティティIDです（エンティティの場合のみ）
+	EntityId string `json:"entity_id,omitempty"`
+}
+
+// journalSegment はジャーナルのセグメントファイル情報です
+type journalSegment struct {
+	// path はセグメントファイルのフルパス
+	path string
+
+	// firstSeq はセグメント内の最初のシーケンス番号
+	firstSeq uint64
+}
+
+// Journal は状態フォルダー配下に追記専用ログとして保存される変更ジャーナルです。
+//   - セグメントファイルが最大サイズに達すると新しいセグメントに切り替えます。
+//   - 保持セグメント数を超えた古いセグメントは削除されます。
+type Journal struct {
+	mu sync.Mutex
+
+	// folder はセグメントファイルを保存するフォルダー
+	folder string
+
+	// segmentMaxBytes はセグメントファイルの最大サイズ
+	segmentMaxBytes int64
+
+	// maxSegments は保持するセグメントの最大数
+	maxSegments int
+
+	// segments はシーケンス番号順のセグメント一覧
+	segments []journalSegment
+
+	// current は追記中のセグメントファイル
+	current *os.File
+
+	// currentSize は追記中のセグメントファイルのサイズ
+	currentSize int64
+
+	// lastSeq は最後に記録したシーケンス番号
+	lastSeq uint64
+}
+
+// OpenJournal は folder 配下のジャーナルを開きます。
+// フォルダーが存在しない場合は作成し、既存のセグメントから最後のシーケンス番号を復元します。
+func OpenJournal(folder string, segmentMaxBytes int64, maxSegments int) (*Journal, error) {
+	if segmentMaxBytes <= 0 {
+		return nil, errors.New("segmentMaxBytes must be positive")
+	}
+	if maxSegments <= 0 {
+		return nil, errors.New("maxSegments must be positive")
+	}
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+
+	j := &Journal{
+		folder:          folder,
+		segmentMaxBytes: segmentMaxBytes,
+		maxSegments:     maxSegments,
+	}
+
+	// 既存セグメントの読み込み
+	if err := j.loadSegments(); err != nil {
+		return nil, err
+	}
+
+	// 最終セグメントから最後のシーケンス番号を復元
+	if len(j.segments) > 0 {
+		last := j.segments[len(j.segments)-1]
+		j.lastSeq = last.firstSeq - 1
+		err := readSegment(last.path, func(entry JournalEntry) bool {
+			j.lastSeq = entry.Seq
+			return true
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if err := j.openCurrent(last.path); err != nil {
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+// Close はジャーナルを閉じます
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.current == nil {
+		return nil
+	}
+	err := j.current.Close()
+	j.current = nil
+	return err
+}
+
+// LastSeq は最後に記録したシーケンス番号を返します
+func (j *Journal) LastSeq() uint64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.lastSeq
+}
+
+// Append はエントリーにシーケンス番号を採番してジャーナルに追記します。
+// 追記後はファイルを同期して永続化します。
+func (j *Journal) Append(entries ...JournalEntry) ([]JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	written := make([]JournalEntry, 0, len(entries))
+	for _, entry := range entries {
+		// セグメントの切り替えが必要か確認
+		if j.current == nil || j.currentSize >= j.segmentMaxBytes {
+			if err := j.rotate(); err != nil {
+				return written, err
+			}
+		}
+
+		// シーケンス番号と時刻を設定
+		entry.Seq = j.lastSeq + 1
+		if entry.Time.IsZero() {
+			entry.Time = time.Now()
+		}
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return written, err
+		}
+		line = append(line, '\n')
+
+		n, err := j.current.Write(line)
+		j.currentSize += int64(n)
+		if err != nil {
+			return written, err
+		}
+		j.lastSeq = entry.Seq
+		written = append(written, entry)
+	}
+
+	if j.current != nil {
+		if err := j.current.Sync(); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ReadSince は since より後のエントリーを最大 limit 件返します。
+// 戻り値 truncated は since 直後のエントリーが既に削除されている、
+// もしくは since が記録済みの範囲を超えていることを示し、呼び出し側は全件再取得が必要です。
+// 読み込み中のセグメントの切り替えで削除されたセグメントも欠落として扱います。
+func (j *Journal) ReadSince(since uint64, limit int) (entries []JournalEntry, truncated bool, err error) {
+	j.mu.Lock()
+	segments := append([]journalSegment(nil), j.segments...)
+	lastSeq := j.lastSeq
+	j.mu.Unlock()
+
+	// 記録済み範囲より先のカーソルはジャーナルが初期化されたとみなす
+	if since > lastSeq {
+		return nil, true, nil
+	}
+
+	// 保持範囲より古いカーソルは欠落があるため再取得を要求
+	if len(segments) > 0 && since+1 < segments[0].firstSeq {
+		truncated = true
+	}
+
+	entries = make([]JournalEntry, 0)
+	for i, segment := range segments {
+		// 次のセグメントが since より後から始まらない場合は読み飛ばす
+		if i+1 < len(segments) && segments[i+1].firstSeq <= since+1 {
+			continue
+		}
+		err = readSegment(segment.path, func(entry JournalEntry) bool {
+			if entry.Seq <= since {
+				return true
+			}
+			entries = append(entries, entry)
+			return limit <= 0 || len(entries) < limit
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			// 一覧の取得後に削除されたセグメントのエントリーは読めないため再取得を要求
+			return entries, true, nil
+		}
+		if err != nil {
+			return nil, truncated, err
+		}
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+	}
+
+	// since 直後のエントリーが見つからない場合も欠落があるため再取得を要求
+	if since < lastSeq && (len(entries) == 0 || entries[0].Seq > since+1) {
+		truncated = true
+	}
+	return entries, truncated, nil
+}
+
+// rotate は新しいセグメントファイルを作成し、古いセグメントを削除します
+func (j *Journal) rotate() error {
+	if j.current != nil {
+		if err := j.current.Close(); err != nil {
+			return err
+		}
+		j.current = nil
+	}
+
+	firstSeq := j.lastSeq + 1
+	path := filepath.Join(j.folder, fmt.Sprintf("%s%020d%s", journalSegmentPrefix, firstSeq, journalSegmentSuffix))
+	if err := j.openCurrent(path); err != nil {
+		return err
+	}
+	j.segments = append(j.segments, journalSegment{path: path, firstSeq: firstSeq})
+
+	// 保持数を超えたセグメントを削除
+	for len(j.segments) > j.maxSegments {
+		if err := os.Remove(j.segments[0].path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		j.segments = j.segments[1:]
+	}
+	return nil
+}
+
+// openCurrent は追記用にセグメントファイルを開きます
+func (j *Journal) openCurrent(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	j.current = file
+	j.currentSize = fi.Size()
+	return nil
+}
+
+// loadSegments はフォルダー内のセグメントファイル一覧を読み込みます
+func (j *Journal) loadSegments() error {
+	entries, err := os.ReadDir(j.folder)
+	if err != nil {
+		return err
+	}
+
+	j.segments = j.segments[:0]
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, journalSegmentPrefix) || !strings.HasSuffix(name, journalSegmentSuffix) {
+			continue
+		}
+		seqText := strings.TrimSuffix(strings.TrimPrefix(name, journalSegmentPrefix), journalSegmentSuffix)
+		firstSeq, err := strconv.ParseUint(seqText, 10, 64)
+		if err != nil || firstSeq == 0 {
+			continue
+		}
+		j.segments = append(j.segments, journalSegment{
+			path:     filepath.Join(j.folder, name),
+			firstSeq: firstSeq,
+		})
+	}
+
+	sort.Slice(j.segments, func(a, b int) bool {
+		return j.segments[a].firstSeq < j.segments[b].firstSeq
+	})
+	return nil
+}
+
+// readSegment はセグメントファイルを先頭から読み込み、エントリー毎に fn を呼び出します。
+// fn が false を返した場合は読み込みを終了します。
+// 書き込み途中で破損した行は読み飛ばします。
+// セグメントファイルが存在しない場合は os.ErrNotExist を返します。
+func readSegment(path string, fn func(JournalEntry) bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry JournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if !fn(entry) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// JournalOpFrom は fsnotify の操作をジャーナルの操作名に変換します
+func JournalOpFrom(op fsnotify.Op) string {
+	switch {
+	case op.Has(fsnotify.Create):
+		return "create"
+	case op.Has(fsnotify.Remove):
+		return "remove"
+	case op.Has(fsnotify.Rename):
+		return "rename"
+	case op.Has(fsnotify.Write):
+		return "write"
+	case op.Has(fsnotify.Chmod):
+		return "chmod"
+	}
+	return "unknown"
+}
+
+// FormatJournalCursor はシーケンス番号をカーソル文字列に変換します
+func FormatJournalCursor(seq uint64) string {
+	return strconv.FormatUint(seq, 10)
+}
+
+// ParseJournalCursor はカーソル文字列をシーケンス番号に変換します。
+// 空文字列は先頭（0）として扱います。
+func ParseJournalCursor(cursor string) (uint64, error) {
+	cursor = strings.TrimSpace(cursor)
+	if cursor == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(cursor, 10, 64)
+}
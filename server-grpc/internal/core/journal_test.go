@@ -0,0 +1,78 @@
+package core
+
+import (
+	"os"
+	"testing"
+)
+
+// openTestJournal はエントリー毎にセグメントを切り替え、最新の3セグメントを保持するジャーナルに5件追記します
+func openTestJournal(t *testing.T) *Journal {
+	t.Helper()
+	journal, err := OpenJournal(t.TempDir(), 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { journal.Close() })
+	for range 5 {
+		if _, err := journal.Append(JournalEntry{Kind: "file", Op: "create", Path: "/a"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return journal
+}
+
+func TestJournalReadSince(t *testing.T) {
+	journal := openTestJournal(t)
+	tests := []struct {
+		since     uint64
+		limit     int
+		seqs      []uint64
+		truncated bool
+	}{
+		{2, 0, []uint64{3, 4, 5}, false},
+		{3, 1, []uint64{4}, false},
+		{5, 0, []uint64{}, false},
+		// 削除済みのセグメント・記録済み範囲より先
+		{1, 0, []uint64{3, 4, 5}, true},
+		{6, 0, nil, true},
+	}
+	for _, tt := range tests {
+		entries, truncated, err := journal.ReadSince(tt.since, tt.limit)
+		if err != nil {
+			t.Fatalf("ReadSince(%d) error = %v", tt.since, err)
+		}
+		if truncated != tt.truncated || len(entries) != len(tt.seqs) {
+			t.Errorf("ReadSince(%d) = %+v, %v, want %v, %v", tt.since, entries, truncated, tt.seqs, tt.truncated)
+			continue
+		}
+		for i, entry := range entries {
+			if entry.Seq != tt.seqs[i] {
+				t.Errorf("ReadSince(%d)[%d].Seq = %d, want %d", tt.since, i, entry.Seq, tt.seqs[i])
+			}
+		}
+	}
+}
+
+// 一覧の取得後に削除・欠落したセグメントは再取得を要求する
+func TestJournalReadSinceMissingSegment(t *testing.T) {
+	journal := openTestJournal(t)
+	segments := journal.segments
+
+	// since 直後のエントリーを含むセグメントが空になった
+	if err := os.Truncate(segments[1].path, 0); err != nil {
+		t.Fatal(err)
+	}
+	entries, truncated, err := journal.ReadSince(3, 0)
+	if err != nil || !truncated {
+		t.Errorf("ReadSince(3) = %+v, %v, %v, want truncated", entries, truncated, err)
+	}
+
+	// 読み込むセグメントが削除された
+	if err := os.Remove(segments[0].path); err != nil {
+		t.Fatal(err)
+	}
+	entries, truncated, err = journal.ReadSince(2, 0)
+	if err != nil || !truncated {
+		t.Errorf("ReadSince(2) = %+v, %v, %v, want truncated", entries, truncated, err)
+	}
+}
@@ -0,0 +1,422 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FolderLockFilename はフォルダーロックファイル名です
+const FolderLockFilename = PathistSystemPrefix + ".lock"
+
+// ErrFolderLocked は他の所有者がフォルダーをロックしていることを示します
+var ErrFolderLocked = errors.New("folder is locked")
+
+// FolderLockInfo はロックファイルに保存される所有者情報です
+type FolderLockInfo struct {
+	// Owner はロックを取得した操作の名前
+	Owner string `yaml:"owner"`
+
+	// PID はロックを取得したプロセスID
+	PID int `yaml:"pid"`
+
+	// Host はロックを取得したホスト名
+	Host string `yaml:"host"`
+
+	// Token はロック取得毎に生成される識別子、解放時の所有確認に使用
+	Token string `yaml:"token"`
+
+	// Acquired はロックの取得時刻
+	Acquired time.Time `yaml:"acquired"`
+
+	// Expires はロックの有効期限、これを過ぎたロックは放棄されたとみなす
+	Expires time.Time `yaml:"expires"`
+}
+
+// FolderLockedError はロック取得に失敗した際の保持者情報を持つエラーです
+type FolderLockedError struct {
+	Folder string
+	Holder FolderLockInfo
+}
+
+func (e *FolderLockedError) Error() string {
+	return fmt.Sprintf("%s: %s (owner=%s pid=%d host=%s expires=%s)",
+		ErrFolderLocked, e.Folder, e.Holder.Owner, e.Holder.PID, e.Holder.Host,
+		e.Holder.Expires.Format(time.RFC3339))
+}
+
+func (e *FolderLockedError) Unwrap() error {
+	return ErrFolderLocked
+}
+
+// FolderLock はフォルダー内のロックファイルによる助言的ロックです。
+//   - 同一フォルダーに対する別プロセスや別サーバーからの同時操作を防ぎます。
+//   - 有効期限切れ、または同一ホストで保持プロセスが終了しているロックは回収されます。
+//   - 保持している間は ttl の 1/3 毎に有効期限を延長するため、ttl より長い操作でも回収されません。
+type FolderLock struct {
+	// mu は info を保護する
+	mu sync.Mutex
+
+	// folder はロック対象のフォルダー
+	folder string
+
+	// info はこのロックの所有者情報
+	info FolderLockInfo
+
+	// ttl は有効期限の延長幅
+	ttl time.Duration
+
+	// stop は有効期限の延長を停止する
+	stop chan struct{}
+
+	// done は有効期限の延長が停止したことを通知する
+	done chan struct{}
+}
+
+// AcquireFolderLock は folder のロックを取得します。
+// 他の所有者が有効なロックを保持している場合は待機せず *FolderLockedError を返します。
+func AcquireFolderLock(folder, owner string, ttl time.Duration) (*FolderLock, error) {
+	host, _ := os.Hostname()
+	token := make([]byte, 8)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	lock := &FolderLock{
+		folder: folder,
+		info: FolderLockInfo{
+			Owner:    owner,
+			PID:      os.Getpid(),
+			Host:     host,
+			Token:    hex.EncodeToString(token),
+			Acquired: now,
+			Expires:  now.Add(ttl),
+		},
+		ttl:  ttl,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	data, err := yaml.Marshal(&lock.info)
+	if err != nil {
+		return nil, err
+	}
+
+	// 放棄されたロックを回収した場合に一度だけ再試行
+	for range 2 {
+		err = writeLockFile(lock.path(), data)
+		if err == nil {
+			go lock.heartbeat()
+			return lock, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		// 既存ロックの確認
+		if err := checkLockHolder(folder, now, ttl); err != nil {
+			return nil, err
+		}
+
+		// 放棄されたロックを回収（他の回収・解放と排他にし、削除の直前に再確認する）
+		beforeLockReclaim()
+		err = guardLockFile(lock.path(), ttl, func() error {
+			if err := checkLockHolder(folder, now, ttl); err != nil {
+				return err
+			}
+			if err := os.Remove(lock.path()); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, &FolderLockedError{Folder: folder}
+}
+
+// beforeLockReclaim は放棄されたロックを回収する直前に呼び出されます（テストで競合を再現するため）
+var beforeLockReclaim = func() {}
+
+// LockFolder は設定された有効期限で folder のロックを取得します
+// owner: ロックを取得する操作の名前（例: "CompanyService.UpdateCompany"）
+func LockFolder(folder, owner string) (*FolderLock, error) {
+	return AcquireFolderLock(folder, owner, folderLockTTL())
+}
+
+// LockFolders は folders のロックをパスの順に取得します。
+// 重複するフォルダーは一度だけロックし、途中で失敗した場合は取得済みのロックを解放します。
+func LockFolders(folders []string, owner string) (FolderLocks, error) {
+	folders = slices.Clone(folders)
+	slices.Sort(folders)
+	folders = slices.Compact(folders)
+
+	locks := make(FolderLocks, 0, len(folders))
+	for _, folder := range folders {
+		lock, err := LockFolder(folder, owner)
+		if err != nil {
+			locks.Release()
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+// FolderLocks は LockFolders で取得した複数のロックです
+type FolderLocks []*FolderLock
+
+// Release は全てのロックを取得と逆の順に解放し、最初のエラーを返します
+func (locks FolderLocks) Release() error {
+	var first error
+	for _, lock := range slices.Backward(locks) {
+		if err := lock.Release(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// folderLockTTL は設定されたロックの有効期限を返します
+func folderLockTTL() time.Duration {
+	ttlSec, err := strconv.Atoi(ConfigMap["FolderLockTTLSec"])
+	if err != nil || ttlSec <= 0 {
+		ttlSec = 60
+	}
+	return time.Duration(ttlSec) * time.Second
+}
+
+// ReadFolderLock は folder のロックファイルを読み込みます
+func ReadFolderLock(folder string) (*FolderLockInfo, error) {
+	return readFolderLockFile(filepath.Join(folder, FolderLockFilename))
+}
+
+// readFolderLockFile は path のロックファイルを読み込みます
+func readFolderLockFile(path string) (*FolderLockInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseFolderLockInfo(data)
+}
+
+// parseFolderLockInfo はロックファイルの内容を解析します
+func parseFolderLockInfo(data []byte) (*FolderLockInfo, error) {
+	info := &FolderLockInfo{}
+	if err := yaml.Unmarshal(data, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// IsStale はロックが放棄されているかを判定します
+func (info *FolderLockInfo) IsStale(now time.Time) bool {
+	// 有効期限切れ
+	if now.After(info.Expires) {
+		return true
+	}
+
+	// 同一ホストで保持プロセスが存在しない
+	host, _ := os.Hostname()
+	if info.Host == host && info.PID > 0 && !processAlive(info.PID) {
+		return true
+	}
+	return false
+}
+
+// CheckFolderUnlocked は folder 自身が他の所有者にロックされていないかを確認します。
+// フォルダーを移動・削除する操作は親フォルダーをロックするため、
+// 移動・削除するフォルダー自身のロックはこの関数で確認します。
+func CheckFolderUnlocked(folder string) error {
+	return checkLockHolder(folder, time.Now(), folderLockTTL())
+}
+
+// heartbeat はロックを解放するまで ttl の 1/3 毎に有効期限を延長します
+func (l *FolderLock) heartbeat() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(max(l.ttl/3, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			if err := l.renew(); err != nil {
+				// 他の所有者に回収された場合等は延長を諦める
+				return
+			}
+		}
+	}
+}
+
+// renew はロックファイルの有効期限を延長します。
+// ロックファイルが他の所有者に置き換わっている場合はエラーを返します。
+//
+// 所有者を確認したファイルを開いたまま上書きするため、確認の後に他の所有者が回収して
+// 作成したロックファイルを置き換えることはありません（回収済みのファイルへの書き込みは無視されます）。
+// 書き込み途中の内容を読んだ他の所有者は、更新日時が新しいためロック中とみなします。
+func (l *FolderLock) renew() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.OpenFile(l.path(), os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	current, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	holder, err := parseFolderLockInfo(current)
+	if err != nil {
+		return err
+	}
+	if holder.Token != l.info.Token {
+		return &FolderLockedError{Folder: l.folder, Holder: *holder}
+	}
+
+	l.info.Expires = time.Now().Add(l.ttl)
+	data, err := yaml.Marshal(&l.info)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteAt(data, 0); err != nil {
+		return err
+	}
+	if err := file.Truncate(int64(len(data))); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// Release はロックを解放します。
+// ロックファイルが他の所有者に置き換わっている場合は削除しません。
+func (l *FolderLock) Release() error {
+	if l == nil {
+		return nil
+	}
+
+	// 有効期限の延長を停止
+	select {
+	case <-l.stop:
+	default:
+		close(l.stop)
+	}
+	<-l.done
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 回収と排他にし、他の所有者に置き換わっている場合は削除しない
+	return guardLockFile(l.path(), l.ttl, func() error {
+		holder, err := ReadFolderLock(l.folder)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if holder.Token != l.info.Token {
+			return nil
+		}
+		if err := os.Remove(l.path()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// path はロックファイルのフルパスを返します
+func (l *FolderLock) path() string {
+	return filepath.Join(l.folder, FolderLockFilename)
+}
+
+// writeLockFile はロックファイルを排他的に作成します
+func writeLockFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+// lockGuardSuffix はロックファイルの回収・解放を排他にするためのファイルの接尾辞です
+const lockGuardSuffix = ".guard"
+
+// lockGuardRetries と lockGuardWait は排他用のファイルを取得できない場合の再試行回数と間隔です
+const (
+	lockGuardRetries = 50
+	lockGuardWait    = 10 * time.Millisecond
+)
+
+// guardLockFile はロックファイルを削除する操作（回収・解放）を排他にして fn を実行します。
+//   - ロックファイルを削除するのは fn のみのため、fn の中で内容を確認してから削除するまでの間に
+//     他の所有者が取得したロックファイルを削除することはありません（取得はロックファイルが存在しない場合のみ成功します）。
+//   - 排他用のファイルが ttl より古い場合は、異常終了により残ったものとみなして削除します。
+func guardLockFile(path string, ttl time.Duration, fn func() error) error {
+	guard := path + lockGuardSuffix
+	for range lockGuardRetries {
+		file, err := os.OpenFile(guard, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			file.Close()
+			defer os.Remove(guard)
+			return fn()
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		if lockFileIsStale(guard, time.Now(), ttl) {
+			os.Remove(guard)
+			continue
+		}
+		time.Sleep(lockGuardWait)
+	}
+	return &FolderLockedError{Folder: filepath.Dir(path)}
+}
+
+// checkLockHolder は folder のロックファイルが有効な場合に *FolderLockedError を返します
+// ロックファイルが存在しない、または放棄されている場合は nil を返します
+func checkLockHolder(folder string, now time.Time, ttl time.Duration) error {
+	path := filepath.Join(folder, FolderLockFilename)
+	holder, err := readFolderLockFile(path)
+	if err != nil {
+		if os.IsNotExist(err) || lockFileIsStale(path, now, ttl) {
+			return nil
+		}
+		// 書き込み途中の可能性があるため、古くなるまではロック中とみなす
+		return &FolderLockedError{Folder: folder}
+	}
+	if !holder.IsStale(now) {
+		return &FolderLockedError{Folder: folder, Holder: *holder}
+	}
+	return nil
+}
+
+// lockFileIsStale は読み込めないロックファイルが ttl より古いかを判定します
+func lockFileIsStale(path string, now time.Time, ttl time.Duration) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return os.IsNotExist(err)
+	}
+	return now.Sub(fi.ModTime()) > ttl
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// writeTestLockFile は folder に info のロックファイルを作成します
+func writeTestLockFile(t *testing.T, folder string, info FolderLockInfo) {
+	t.Helper()
+	data, err := yaml.Marshal(&info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(folder, FolderLockFilename), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 放棄されたロックを同時に回収しようとしても1つの競合者のみが取得する
+func TestAcquireFolderLockStaleRace(t *testing.T) {
+	const contenders = 8
+	folder := t.TempDir()
+	t.Cleanup(func() { beforeLockReclaim = func() {} })
+
+	for round := range 50 {
+		writeTestLockFile(t, folder, FolderLockInfo{
+			Owner:   "stale",
+			Token:   "stale",
+			Expires: time.Now().Add(-time.Minute),
+		})
+
+		var (
+			wg    sync.WaitGroup
+			start = make(chan struct{})
+			locks = make(chan *FolderLock, contenders)
+		)
+
+		// 全ての競合者が放棄されたロックを確認するまで回収を待たせる
+		var arrived atomic.Int32
+		reclaim := make(chan struct{})
+		beforeLockReclaim = func() {
+			if arrived.Add(1) == contenders {
+				close(reclaim)
+			}
+			<-reclaim
+		}
+		for range contenders {
+			wg.Go(func() {
+				<-start
+				lock, err := AcquireFolderLock(folder, "contender", time.Minute)
+				if err == nil {
+					locks <- lock
+				} else if !errors.Is(err, ErrFolderLocked) {
+					t.Errorf("AcquireFolderLock() error = %v", err)
+				}
+			})
+		}
+		close(start)
+		wg.Wait()
+		close(locks)
+
+		won := []*FolderLock{}
+		for lock := range locks {
+			won = append(won, lock)
+		}
+		if len(won) != 1 {
+			t.Fatalf("round %d: %d contenders acquired the lock", round, len(won))
+		}
+		holder, err := ReadFolderLock(folder)
+		if err != nil || holder.Token != won[0].info.Token {
+			t.Fatalf("round %d: holder = %+v, %v, want token %s", round, holder, err, won[0].info.Token)
+		}
+		if err := won[0].Release(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 回収・解放の一時ファイルを残さない
+	entries, err := os.ReadDir(folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("entries = %v", entries)
+	}
+}
+
+// 他の所有者に回収されたロックは延長・解放しない
+func TestFolderLockReclaimed(t *testing.T) {
+	folder := t.TempDir()
+	lock, err := AcquireFolderLock(folder, "first", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.renew(); err != nil {
+		t.Fatalf("renew() error = %v", err)
+	}
+
+	other := FolderLockInfo{Owner: "second", Token: "second", Expires: time.Now().Add(time.Minute)}
+	writeTestLockFile(t, folder, other)
+
+	if err := lock.renew(); !errors.Is(err, ErrFolderLocked) {
+		t.Errorf("renew() error = %v, want %v", err, ErrFolderLocked)
+	}
+	if err := lock.Release(); err != nil {
+		t.Fatal(err)
+	}
+	holder, err := ReadFolderLock(folder)
+	if err != nil || holder.Token != other.Token || !holder.Expires.Equal(other.Expires) {
+		t.Errorf("holder = %+v, %v, want %+v", holder, err, other)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package core
+
+import "os"
+
+// processAlive は pid のプロセスが存在するかを判定します
+func processAlive(pid int) bool {
+	// Windows では存在しないプロセスの FindProcess は失敗する
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	process.Release()
+	return true
+}
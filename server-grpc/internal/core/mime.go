@@ -0,0 +1,80 @@
+package core
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mimeSniffLength は内容からMIMEタイプを判定する際に読み込むバイト数です
+const mimeSniffLength = 512
+
+// extensionMimeTypes は OS の設定に依存せずに判定する拡張子とMIMEタイプです
+var extensionMimeTypes = map[string]string{
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".xlsm": "application/vnd.ms-excel.sheet.macroEnabled.12",
+	".xls":  "application/vnd.ms-excel",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".doc":  "application/msword",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	".csv":  "text/csv; charset=utf-8",
+	".heic": "image/heic",
+	".dxf":  "image/vnd.dxf",
+	".dwg":  "image/vnd.dwg",
+	".yaml": "application/yaml",
+	".yml":  "application/yaml",
+}
+
+// genericMimeTypes は内容からは種類を特定できないMIMEタイプです
+// この場合は拡張子から判定したMIMEタイプを優先します
+var genericMimeTypes = []string{
+	"application/octet-stream",
+	"application/zip",
+	"text/plain",
+	"text/xml",
+}
+
+// DetectMimeType はファイルの先頭部分の内容からMIMEタイプを判定します
+// 内容から種類を特定できない場合（Office文書のZIP等）は拡張子から判定します
+func DetectMimeType(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, mimeSniffLength)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	sniffed := http.DetectContentType(buf[:n])
+
+	// 汎用的なMIMEタイプの場合は拡張子を優先
+	mediaType, _, _ := mime.ParseMediaType(sniffed)
+	for _, generic := range genericMimeTypes {
+		if mediaType == generic {
+			if byExt := MimeTypeByExtension(path); byExt != "" {
+				return byExt, nil
+			}
+			break
+		}
+	}
+	return sniffed, nil
+}
+
+// MimeTypeByExtension は拡張子からMIMEタイプを返します
+// 判定できない場合は空文字列を返します
+func MimeTypeByExtension(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return ""
+	}
+	if mimeType, ok := extensionMimeTypes[ext]; ok {
+		return mimeType
+	}
+	return mime.TypeByExtension(ext)
+}
@@ -0,0 +1,156 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// NormalizeName はファイル名・フォルダー名の表記を統一します。
+//   - NFC 正規化で macOS で作成された NFD の名前（濁点の分離など）を合成済みの文字に統一します。
+//   - 全角英数字・全角スペースを半角に、半角カタカナを全角に統一します。
+//
+// 全角の記号は変換しません（"／" や "？" を半角にするとファイル名に使用できなくなるため）。
+// ①→1 や ㈱→(株) のような互換文字の変換は名前の意味が変わるため行いません（NFKC は使用しません）。
+// 会社フォルダー・工事フォルダーの解析と ID の生成はこの関数で統一した名前に対して行います。
+func NormalizeName(name string) string {
+	if isNormalizedASCII(name) {
+		return name
+	}
+	var builder strings.Builder
+	for _, r := range norm.NFC.String(name) {
+		builder.WriteString(foldNameRune(r))
+	}
+	// 半角カタカナの濁点は結合文字に変換されるため再度 NFC 正規化する
+	return norm.NFC.String(builder.String())
+}
+
+// foldNameRune は NormalizeName で統一する文字の幅を変換します
+func foldNameRune(r rune) string {
+	// 半角カタカナ（句読点・濁点を含む）は全角に
+	if r >= 0xFF61 && r <= 0xFF9F {
+		return width.Fold.String(string(r))
+	}
+	// 全角英数字・全角スペースは半角に
+	if p := width.LookupRune(r); p.Kind() == width.EastAsianFullwidth {
+		if narrow := p.Narrow(); unicode.IsLetter(narrow) || unicode.IsDigit(narrow) || narrow == ' ' {
+			return string(narrow)
+		}
+	}
+	return string(r)
+}
+
+// NameIsNormalized は name が NormalizeName で変換済みかどうかを返します
+func NameIsNormalized(name string) bool {
+	return NormalizeName(name) == name
+}
+
+// isNormalizedASCII は name が変換の必要がない ASCII 文字のみかどうかを返します
+func isNormalizedASCII(name string) bool {
+	for i := 0; i < len(name); i++ {
+		if name[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// NameIssue は表記が統一されていないフォルダーです
+type NameIssue struct {
+	// Path はフォルダーの絶対パス
+	Path string
+
+	// Name は現在のフォルダー名
+	Name string
+
+	// Normalized は NormalizeName で統一したフォルダー名
+	Normalized string
+
+	// Conflict は統一後の名前のフォルダー・ファイルが既に存在する、または統一後の名前が使用できないかどうか
+	Conflict bool
+
+	// Renamed はフォルダー名を変更したかどうか
+	Renamed bool
+}
+
+// ScanNameIssues は root 配下のフォルダーのうち、名前が NormalizeName で統一されていないものを返します。
+//   - Pathist の内部管理用のフォルダーとシンボリックリンクは対象外です。
+//   - 結果は深い階層から順に並べるため、先頭から名前を変更しても後続のパスは変わりません。
+func ScanNameIssues(ctx context.Context, root string) ([]NameIssue, error) {
+	issues := []NameIssue{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err != nil {
+			// 読み取れないフォルダーは飛ばして続ける
+			if d != nil && d.IsDir() && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if path == root || !d.IsDir() {
+			return nil
+		}
+		if FilenameIsPathistSystem(d.Name()) {
+			return filepath.SkipDir
+		}
+		if normalized := NormalizeName(d.Name()); normalized != d.Name() {
+			issue := NameIssue{Path: path, Name: d.Name(), Normalized: normalized}
+			// 統一後の名前が使用できない場合も変更できないため競合とする
+			issue.Conflict = ValidateFilename(normalized) != nil ||
+				nameConflicts(path, filepath.Join(filepath.Dir(path), normalized))
+			issues = append(issues, issue)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(issues, func(a, b NameIssue) int {
+		return strings.Count(b.Path, string(filepath.Separator)) - strings.Count(a.Path, string(filepath.Separator))
+	})
+	return issues, nil
+}
+
+// FixNameIssue は issue のフォルダー名を統一後の名前に変更します
+// 統一後の名前が使用できない場合は ErrUnsafeFilename を、既に存在する場合は os.ErrExist を返します
+func FixNameIssue(issue *NameIssue) error {
+	if err := ValidateFilename(issue.Normalized); err != nil {
+		issue.Conflict = true
+		return err
+	}
+	dst := filepath.Join(filepath.Dir(issue.Path), issue.Normalized)
+	if nameConflicts(issue.Path, dst) {
+		issue.Conflict = true
+		return &os.LinkError{Op: "rename", Old: issue.Path, New: dst, Err: os.ErrExist}
+	}
+	if err := os.Rename(issue.Path, dst); err != nil {
+		return err
+	}
+	issue.Renamed = true
+	return nil
+}
+
+// nameConflicts は dst に src 以外のフォルダー・ファイルが存在するかどうかを返します
+// 正規化を区別しないファイルシステム（macOS など）では src と dst が同じ実体を指します
+func nameConflicts(src, dst string) bool {
+	dstInfo, err := os.Lstat(dst)
+	if errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	srcInfo, err := os.Lstat(src)
+	return err != nil || !os.SameFile(srcInfo, dstInfo)
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"1 会社", "1 会社"},
+		// NFD（macOS）の濁点・半濁点を合成済みの文字に
+		{"か\u3099いしゃ", "がいしゃ"},
+		{"ハ\u309Aイフ\u309A", "パイプ"},
+		// 全角英数字・全角スペースを半角に
+		{"ＡＢＣ１２３　ｘｙｚ", "ABC123 xyz"},
+		// 半角カタカナ（濁点・句読点を含む）を全角に
+		{"ﾄﾖﾀﾞ ｶﾞｽ｡", "トヨダ ガス。"},
+		// 全角の記号は変換しない
+		{"a／b：c？＊＜＞＂｜＼", "a／b：c？＊＜＞＂｜＼"},
+		{"（株）豊田－工務店", "（株）豊田－工務店"},
+		// 互換文字は変換しない
+		{"①㈱", "①㈱"},
+	}
+	for _, tt := range tests {
+		got := NormalizeName(tt.name)
+		if got != tt.want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if !NameIsNormalized(got) {
+			t.Errorf("NameIsNormalized(%q) = false", got)
+		}
+	}
+}
+
+func TestScanNameIssues(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"ｘｙｚ", "ａｂｃ", "abc", "ＣＯＮ", "ｄｅｆ　", "ok", ".pathist-trash/ｚｚｚ"} {
+		if err := os.MkdirAll(filepath.Join(root, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues, err := ScanNameIssues(context.Background(), root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		normalized string
+		conflict   bool
+	}{
+		"ｘｙｚ": {"xyz", false},
+		// 統一後の名前が既に存在する
+		"ａｂｃ": {"abc", true},
+		// 統一後の名前が予約名・末尾スペースになる
+		"ＣＯＮ":  {"CON", true},
+		"ｄｅｆ　": {"def ", true},
+	}
+	if len(issues) != len(tests) {
+		t.Fatalf("issues = %+v", issues)
+	}
+	for _, issue := range issues {
+		want, ok := tests[issue.Name]
+		if !ok || issue.Normalized != want.normalized || issue.Conflict != want.conflict {
+			t.Errorf("issue = %+v, want %+v", issue, want)
+		}
+	}
+
+	// 使用できない名前には変更しない
+	for i := range issues {
+		issue := &issues[i]
+		err := FixNameIssue(issue)
+		switch issue.Name {
+		case "ｘｙｚ":
+			if err != nil || !issue.Renamed {
+				t.Errorf("FixNameIssue(%q) error = %v", issue.Name, err)
+			}
+		case "ＣＯＮ", "ｄｅｆ　":
+			if !errors.Is(err, ErrUnsafeFilename) || issue.Renamed {
+				t.Errorf("FixNameIssue(%q) error = %v, want %v", issue.Name, err, ErrUnsafeFilename)
+			}
+		case "ａｂｃ":
+			if !errors.Is(err, os.ErrExist) || issue.Renamed {
+				t.Errorf("FixNameIssue(%q) error = %v, want %v", issue.Name, err, os.ErrExist)
+			}
+		}
+	}
+}
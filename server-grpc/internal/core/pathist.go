@@ -70,7 +70,8 @@ func (p *Pathist) GenerateId() (string, error) {
 		return "", errors.New("pathist_folder is not set")
 	}
 	// ID 生成用テキストを作成してIDを生成
-	text := p.modelNameId + filepath.Base(p.pathistableModel.GetPathistFolder())
+	// NFD・全角半角の表記ゆれで ID が変わらないよう統一した名前を使用
+	text := p.modelNameId + NormalizeName(filepath.Base(p.pathistableModel.GetPathistFolder()))
 	return GenerateIdFromString(text), nil
 }
 
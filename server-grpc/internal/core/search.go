@@ -0,0 +1,346 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// SearchHit は検索結果の1件です
+type SearchHit struct {
+	// Path は一致したファイル・フォルダーの絶対パス
+	Path string
+
+	// Name はファイル名またはフォルダー名
+	Name string
+
+	// IsDir はフォルダーかどうか
+	IsDir bool
+
+	// Score は一致度、大きいほど上位
+	Score float64
+}
+
+// searchDoc は索引に登録したファイル・フォルダーです
+type searchDoc struct {
+	// name は元のファイル名
+	name string
+
+	// folded は FoldForSearch で変換したファイル名
+	folded string
+
+	// isDir はフォルダーかどうか
+	isDir bool
+}
+
+// SearchIndex はファイル名・フォルダー名の N-gram 索引です。
+//   - 名前を FoldForSearch で変換し、1文字と2文字の N-gram で索引します。
+//   - 漢字・ひらがな・カタカナを区切りなしで部分一致検索できます。
+type SearchIndex struct {
+	mu sync.RWMutex
+
+	// root は索引対象のルートフォルダー
+	root string
+
+	// docs は絶対パスをキーとした登録済みのファイル・フォルダー
+	docs map[string]searchDoc
+
+	// grams は N-gram をキーとした絶対パスの集合
+	grams map[string]map[string]struct{}
+
+	// changed は再構築中に変更されたパス、再構築後に反映し直す（再構築中以外は nil）
+	changed map[string]struct{}
+}
+
+// NewSearchIndex は root を対象とする空の索引を作成します
+func NewSearchIndex(root string) *SearchIndex {
+	return &SearchIndex{
+		root:  root,
+		docs:  map[string]searchDoc{},
+		grams: map[string]map[string]struct{}{},
+	}
+}
+
+// Len は登録済みのファイル・フォルダー数を返します
+func (x *SearchIndex) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Rebuild はルートフォルダー配下を走査して索引を作り直します
+// 走査中に AddTree, Remove で反映した変更は、差し替え後に反映し直します
+func (x *SearchIndex) Rebuild(ctx context.Context) error {
+	x.mu.Lock()
+	x.changed = map[string]struct{}{}
+	x.mu.Unlock()
+
+	next := NewSearchIndex(x.root)
+	err := next.walk(ctx, x.root)
+
+	x.mu.Lock()
+	if err == nil {
+		x.docs, x.grams = next.docs, next.grams
+	}
+	changed := x.changed
+	x.changed = nil
+	x.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range changedTrees(changed) {
+		x.Remove(path)
+		if _, err := os.Lstat(path); err == nil {
+			if err := x.walk(ctx, path); err != nil && ctx.Err() != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AddTree は absPath とその配下を索引に追加します
+func (x *SearchIndex) AddTree(ctx context.Context, absPath string) error {
+	x.markChanged(absPath)
+	return x.walk(ctx, absPath)
+}
+
+// markChanged は再構築中の場合に absPath を変更されたパスとして記録します
+func (x *SearchIndex) markChanged(absPath string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.changed != nil {
+		x.changed[filepath.Clean(absPath)] = struct{}{}
+	}
+}
+
+// Add は absPath を索引に追加します
+func (x *SearchIndex) Add(absPath string, isDir bool) {
+	absPath = filepath.Clean(absPath)
+	name := filepath.Base(absPath)
+	if absPath == x.root || PathIsPathistSystem(name) {
+		return
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.removeLocked(absPath)
+
+	doc := searchDoc{name: name, folded: FoldForSearch(name), isDir: isDir}
+	x.docs[absPath] = doc
+	for _, gram := range searchGrams(doc.folded) {
+		paths, ok := x.grams[gram]
+		if !ok {
+			paths = map[string]struct{}{}
+			x.grams[gram] = paths
+		}
+		paths[absPath] = struct{}{}
+	}
+}
+
+// Remove は absPath とその配下を索引から削除します
+func (x *SearchIndex) Remove(absPath string) {
+	absPath = filepath.Clean(absPath)
+	prefix := absPath + string(os.PathSeparator)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.changed != nil {
+		x.changed[absPath] = struct{}{}
+	}
+	for path := range x.docs {
+		if path == absPath || strings.HasPrefix(path, prefix) {
+			x.removeLocked(path)
+		}
+	}
+}
+
+// changedTrees は再構築中に変更されたパスを、他のパスの配下にあるものを除いて返します
+func changedTrees(changed map[string]struct{}) []string {
+	paths := slices.Sorted(maps.Keys(changed))
+	trees := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if len(trees) > 0 {
+			last := trees[len(trees)-1]
+			if path == last || strings.HasPrefix(path, last+string(os.PathSeparator)) {
+				continue
+			}
+		}
+		trees = append(trees, path)
+	}
+	return trees
+}
+
+// Search は query に一致するファイル・フォルダーを一致度の高い順に最大 limit 件返します。
+//   - query は空白区切りの全ての語を名前に含むものに一致します。
+//   - under を指定した場合は、その配下のみを対象とします。
+//
+// 戻り値 total は limit で切り捨てる前の一致件数です。
+func (x *SearchIndex) Search(query, under string, limit int) (hits []SearchHit, total int) {
+	terms := strings.Fields(FoldForSearch(query))
+	if len(terms) == 0 {
+		return nil, 0
+	}
+	prefix := ""
+	if under != "" && filepath.Clean(under) != x.root {
+		prefix = filepath.Clean(under) + string(os.PathSeparator)
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	// 最も候補の少ない N-gram から絞り込む
+	candidates := x.candidatesLocked(terms)
+
+	hits = make([]SearchHit, 0)
+	for path := range candidates {
+		if prefix != "" && !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		doc := x.docs[path]
+		if !containsAll(doc.folded, terms) {
+			continue
+		}
+		hits = append(hits, SearchHit{
+			Path:  path,
+			Name:  doc.name,
+			IsDir: doc.isDir,
+			Score: searchScore(doc.folded, terms, strings.Count(path, string(os.PathSeparator))),
+		})
+	}
+
+	slices.SortFunc(hits, func(a, b SearchHit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(len(a.Name), len(b.Name)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	total = len(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, total
+}
+
+// walk は absPath 配下を走査して索引に追加します（内部管理用は除外）
+func (x *SearchIndex) walk(ctx context.Context, absPath string) error {
+	return filepath.WalkDir(absPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// 読めないフォルダーは読み飛ばす
+			if entry != nil && entry.IsDir() && path != absPath {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if FilenameIsPathistSystem(entry.Name()) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		x.Add(path, entry.IsDir())
+		return nil
+	})
+}
+
+// removeLocked は absPath のみを索引から削除します（ロック取得済みであること）
+func (x *SearchIndex) removeLocked(absPath string) {
+	doc, ok := x.docs[absPath]
+	if !ok {
+		return
+	}
+	for _, gram := range searchGrams(doc.folded) {
+		if paths, ok := x.grams[gram]; ok {
+			delete(paths, absPath)
+			if len(paths) == 0 {
+				delete(x.grams, gram)
+			}
+		}
+	}
+	delete(x.docs, absPath)
+}
+
+// candidatesLocked は全ての語の N-gram を含む候補の集合を返します（ロック取得済みであること）
+func (x *SearchIndex) candidatesLocked(terms []string) map[string]struct{} {
+	var smallest map[string]struct{}
+	for _, term := range terms {
+		for _, gram := range searchGrams(term) {
+			paths := x.grams[gram]
+			if len(paths) == 0 {
+				return nil
+			}
+			if smallest == nil || len(paths) < len(smallest) {
+				smallest = paths
+			}
+		}
+	}
+	return smallest
+}
+
+// searchGrams は文字列の1文字と2文字の N-gram を返します（重複なし）
+func searchGrams(folded string) []string {
+	runes := []rune(folded)
+	grams := make([]string, 0, len(runes)*2)
+	for i := range runes {
+		if runes[i] == ' ' {
+			continue
+		}
+		grams = append(grams, string(runes[i]))
+		if i+1 < len(runes) && runes[i+1] != ' ' {
+			grams = append(grams, string(runes[i:i+2]))
+		}
+	}
+	slices.Sort(grams)
+	return slices.Compact(grams)
+}
+
+// containsAll は folded が全ての語を含むかを返します
+func containsAll(folded string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(folded, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// searchScore は一致度を計算します。
+//   - 名前のうち検索語が占める割合が大きいほど高くなります。
+//   - 完全一致、前方一致は加点し、階層が深いほど僅かに減点します。
+func searchScore(folded string, terms []string, depth int) float64 {
+	nameLen := utf8.RuneCountInString(folded)
+	if nameLen == 0 {
+		return 0
+	}
+	matched := 0
+	for _, term := range terms {
+		matched += utf8.RuneCountInString(term)
+	}
+
+	score := 50 * float64(min(matched, nameLen)) / float64(nameLen)
+	if folded == strings.Join(terms, " ") {
+		score += 100
+	} else if strings.HasPrefix(folded, terms[0]) {
+		score += 30
+	}
+	// 拡張子を除いた名前と完全一致
+	if stem := strings.TrimSuffix(folded, filepath.Ext(folded)); stem == strings.Join(terms, " ") {
+		score += 80
+	}
+	return score - 0.5*float64(depth)
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"iter"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// Snapshot は世代番号付きの不変インデックスです。
+//   - 公開後のインデックスは変更されないため、ロックなしで読み取れます。
+//   - 格納する値も公開後に変更しないことが前提です。
+type Snapshot[V any] struct {
+	// generation はスナップショットの世代番号、差し替える度に増加します
+	generation uint64
+
+	// items はIDをキーとしたインデックス
+	items map[string]V
+}
+
+// Generation はスナップショットの世代番号を返します
+func (s *Snapshot[V]) Generation() uint64 {
+	return s.generation
+}
+
+// Len はスナップショットの要素数を返します
+func (s *Snapshot[V]) Len() int {
+	return len(s.items)
+}
+
+// Get は id に対応する値を返します
+func (s *Snapshot[V]) Get(id string) (V, bool) {
+	v, ok := s.items[id]
+	return v, ok
+}
+
+// All はスナップショットの全要素を列挙します
+func (s *Snapshot[V]) All() iter.Seq2[string, V] {
+	return maps.All(s.items)
+}
+
+// SnapshotStore は Snapshot をアトミックに差し替える書き込み時コピーのキャッシュです。
+//   - 読み取りは Load で取得したスナップショットに対して行います。
+//   - 書き込みは新しいインデックスを作成して差し替えます。
+type SnapshotStore[V any] struct {
+	// mu は書き込み同士を直列化します
+	mu sync.Mutex
+
+	// current は現在公開中のスナップショット
+	current atomic.Pointer[Snapshot[V]]
+}
+
+// NewSnapshotStore は空のスナップショットを持つ SnapshotStore を作成します
+func NewSnapshotStore[V any]() *SnapshotStore[V] {
+	store := &SnapshotStore[V]{}
+	store.current.Store(&Snapshot[V]{items: map[string]V{}})
+	return store
+}
+
+// Load は現在のスナップショットを返します
+func (s *SnapshotStore[V]) Load() *Snapshot[V] {
+	return s.current.Load()
+}
+
+// Replace はインデックス全体を差し替えます。
+// items の所有権はストアに移るため、呼び出し後に変更しないでください。
+func (s *SnapshotStore[V]) Replace(items map[string]V) *Snapshot[V] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store(items)
+}
+
+// Update は現在のインデックスの複製を fn で変更して差し替えます。
+// fn がエラーを返した場合は差し替えずに現在のスナップショットを返します。
+func (s *SnapshotStore[V]) Update(fn func(items map[string]V) error) (*Snapshot[V], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev := s.current.Load()
+	items := maps.Clone(prev.items)
+	if err := fn(items); err != nil {
+		return prev, err
+	}
+
+	next := &Snapshot[V]{
+		generation: prev.generation + 1,
+		items:      items,
+	}
+	s.current.Store(next)
+	return next, nil
+}
+
+// snapshotRebuildRetries は Rebuild でロックを取得せずに再作成を試みる回数
+const snapshotRebuildRetries = 3
+
+// Rebuild は build で作成したインデックス全体に差し替えます。
+//   - build の実行中に Update 等で差し替えられた場合は、その変更を失わないよう作成し直します。
+//   - snapshotRebuildRetries 回続けて差し替えられた場合は、書き込みを止めて build を実行します。
+//
+// build がエラーを返した場合は差し替えずにエラーを返します。
+// build の中から Update 等の書き込みを呼び出さないでください（デッドロックします）。
+func (s *SnapshotStore[V]) Rebuild(build func() (map[string]V, error)) (*Snapshot[V], error) {
+	for range snapshotRebuildRetries {
+		generation := s.current.Load().generation
+		items, err := build()
+		if err != nil {
+			return nil, err
+		}
+		if next, ok := s.replaceIf(generation, items); ok {
+			return next, nil
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, err := build()
+	if err != nil {
+		return nil, err
+	}
+	return s.store(items), nil
+}
+
+// replaceIf は世代番号が generation のままの場合のみインデックス全体を差し替えます
+func (s *SnapshotStore[V]) replaceIf(generation uint64, items map[string]V) (*Snapshot[V], bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current.Load().generation != generation {
+		return nil, false
+	}
+	return s.store(items), true
+}
+
+// store は次の世代のスナップショットを公開します（s.mu を取得して呼び出すこと）
+func (s *SnapshotStore[V]) store(items map[string]V) *Snapshot[V] {
+	if items == nil {
+		items = map[string]V{}
+	}
+	next := &Snapshot[V]{
+		generation: s.current.Load().generation + 1,
+		items:      items,
+	}
+	s.current.Store(next)
+	return next
+}
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// ErrTemplateName はテンプレートのファイル名・フォルダー名を展開できないエラーです
+var ErrTemplateName = errors.New("invalid template name")
+
+// TemplateData はテンプレートのファイル名・フォルダー名に埋め込む値です
+// キーを {{.CompanyName}} のように参照します
+type TemplateData map[string]string
+
+// ExpandTemplateName は text/template 形式のファイル名・フォルダー名に data を埋め込みます。
+//   - {{ を含まない名前はそのまま返します。
+//   - 未定義のキーを参照した場合、展開後の名前が ValidateFilename で使用できない・Pathist の内部管理用の名前の場合はエラーです。
+func ExpandTemplateName(name string, data TemplateData) (string, error) {
+	if !strings.Contains(name, "{{") {
+		return name, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrTemplateName, name, err)
+	}
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, map[string]string(data)); err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrTemplateName, name, err)
+	}
+
+	expanded := strings.TrimSpace(builder.String())
+	if err := ValidateFilename(expanded); err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrTemplateName, name, err)
+	}
+	if FilenameIsPathistSystem(expanded) {
+		return "", fmt.Errorf("%w: %s: %q", ErrTemplateName, name, expanded)
+	}
+	return expanded, nil
+}
+
+// CopyTemplate はテンプレートフォルダーの内容を absDst フォルダーとしてコピーします。
+//   - ファイル名・フォルダー名は ExpandTemplateName で data を埋め込みます（ファイルの内容は変更しません）。
+//   - DefaultIgnorePatterns に一致する一時ファイル、シンボリックリンク、Pathist の内部管理用ファイルはコピーしません。
+//   - 作業用フォルダーに作成してから absDst に名前を変更するため、途中で失敗しても作りかけのフォルダーは残りません。
+//   - templateFolder が存在しない場合は空のフォルダーを作成します。
+//
+// absDst が既に存在する場合は os.ErrExist を返します。戻り値はコピーしたファイル数です。
+func CopyTemplate(ctx context.Context, templateFolder, absDst string, data TemplateData) (int, error) {
+	if _, err := os.Lstat(absDst); err == nil {
+		return 0, fmt.Errorf("%w: %s", os.ErrExist, absDst)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+
+	// 作業用フォルダーを作成
+	random := make([]byte, 4)
+	if _, err := rand.Read(random); err != nil {
+		return 0, err
+	}
+	parent := filepath.Dir(absDst)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return 0, err
+	}
+	staging := filepath.Join(parent, PathistSystemPrefix+"-create-"+hex.EncodeToString(random))
+	if err := os.Mkdir(staging, 0755); err != nil {
+		return 0, err
+	}
+
+	copied := 0
+	fi, err := os.Stat(templateFolder)
+	switch {
+	case err == nil && fi.IsDir():
+		rules := NewIgnoreRules(templateFolder, DefaultIgnorePatterns)
+		err = copyTemplateFolder(ctx, templateFolder, staging, data, rules, &copied)
+	case err == nil:
+		err = fmt.Errorf("template is not a folder: %s", templateFolder)
+	case errors.Is(err, os.ErrNotExist):
+		err = nil
+	}
+	if err != nil {
+		os.RemoveAll(staging)
+		return 0, err
+	}
+
+	// 作成中に同名のフォルダーが作成されていないことを確認してから配置
+	if _, err := os.Lstat(absDst); err == nil {
+		os.RemoveAll(staging)
+		return 0, fmt.Errorf("%w: %s", os.ErrExist, absDst)
+	}
+	if err := os.Rename(staging, absDst); err != nil {
+		os.RemoveAll(staging)
+		return 0, err
+	}
+	return copied, nil
+}
+
+// copyTemplateFolder はテンプレートのフォルダー配下を再帰的にコピーします
+func copyTemplateFolder(ctx context.Context, srcFolder, dstFolder string, data TemplateData, rules *IgnoreRules, copied *int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	dirs, err := os.ReadDir(srcFolder)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		srcPath := filepath.Join(srcFolder, dir.Name())
+		if dir.Type()&os.ModeSymlink != 0 || rules.Match(srcPath, dir.IsDir()) {
+			continue
+		}
+		name, err := ExpandTemplateName(dir.Name(), data)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dstFolder, name)
+
+		if dir.IsDir() {
+			if err := os.Mkdir(dstPath, 0755); err != nil {
+				return err
+			}
+			if err := copyTemplateFolder(ctx, srcPath, dstPath, data, rules, copied); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := copyTemplateFile(srcPath, dstPath); err != nil {
+			return err
+		}
+		*copied++
+	}
+	return nil
+}
+
+// copyTemplateFile はファイルをコピーします（展開後の名前が重複する場合は os.ErrExist）
+func copyTemplateFile(srcPath, dstPath string) error {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(dstPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FoldForSearch は検索で表記ゆれを吸収するための文字列に変換します。
+//   - NFKC 正規化で全角英数字・記号を半角に、半角カタカナを全角に統一します。
+//   - カタカナをひらがなに、英字を小文字に統一します。
+//   - 連続する空白は1つの半角スペースにまとめます。
+func FoldForSearch(text string) string {
+	text = norm.NFKC.String(text)
+
+	var b strings.Builder
+	b.Grow(len(text))
+	space := false
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		case r >= 'ァ' && r <= 'ヶ':
+			// カタカナ → ひらがな
+			r -= 'ァ' - 'ぁ'
+		case r == 'ヽ' || r == 'ヾ':
+			// 踊り字 → ひらがなの踊り字
+			r -= 'ヽ' - 'ゝ'
+		default:
+			r = unicode.ToLower(r)
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,297 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrThumbnailUnsupported はサムネイルを作成できない形式のファイルのエラーです
+var ErrThumbnailUnsupported = errors.New("unsupported image format")
+
+// ErrThumbnailTooLarge は画素数が多すぎてサムネイルを作成しない画像のエラーです
+var ErrThumbnailTooLarge = errors.New("image is too large")
+
+// ThumbnailMinSize, ThumbnailMaxSize はサムネイルの長辺の最小・最大ピクセル数です
+const (
+	ThumbnailMinSize = 16
+	ThumbnailMaxSize = 1024
+)
+
+// thumbnailMaxPixels はサムネイルを作成する元画像の最大画素数、展開後のメモリ使用量の上限です
+const thumbnailMaxPixels = 64_000_000
+
+// thumbnailJpegQuality は JPEG で保存するサムネイルの品質
+const thumbnailJpegQuality = 85
+
+// thumbnailSourceExts はサムネイルを作成できる画像の拡張子です
+var thumbnailSourceExts = []string{".jpg", ".jpeg", ".png", ".gif"}
+
+// Thumbnail は作成したサムネイル画像です
+type Thumbnail struct {
+	// Data はエンコード済みの画像
+	Data []byte
+
+	// MimeType は Data の MIME タイプ（image/jpeg または image/png）
+	MimeType string
+
+	// Width, Height はサムネイルのピクセル数
+	Width  int
+	Height int
+}
+
+// FilenameIsThumbnailSource はサムネイルを作成できる画像ファイルかどうかをチェック
+func FilenameIsThumbnailSource(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	for _, e := range thumbnailSourceExts {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadImageSize は画像ファイルのヘッダーからピクセル数を読み込みます（画像データは展開しません）
+func ReadImageSize(filename string) (width, height int, err error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(bufio.NewReader(file))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrThumbnailUnsupported, err)
+	}
+	return config.Width, config.Height, nil
+}
+
+// GenerateThumbnail は JPEG・PNG・GIF の画像ファイルから長辺が size 以下のサムネイルを作成します。
+//   - 元画像より大きくは拡大しません。
+//   - 透過のある画像は PNG、それ以外は JPEG で返します。
+//   - GIF アニメーションは最初のフレームを使用します。
+//   - EXIF の向き（Orientation）に合わせて回転します。
+func GenerateThumbnail(filename string, size int) (*Thumbnail, error) {
+	if !FilenameIsThumbnailSource(filename) {
+		return nil, ErrThumbnailUnsupported
+	}
+	size = min(max(size, ThumbnailMinSize), ThumbnailMaxSize)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	// 展開前に画素数を確認
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrThumbnailUnsupported, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrThumbnailUnsupported
+	}
+	if config.Width*config.Height > thumbnailMaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrThumbnailTooLarge, config.Width, config.Height)
+	}
+
+	var src image.Image
+	switch format {
+	case "jpeg":
+		src, err = jpeg.Decode(bytes.NewReader(data))
+	case "png":
+		src, err = png.Decode(bytes.NewReader(data))
+	case "gif":
+		src, err = gif.Decode(bytes.NewReader(data))
+	default:
+		return nil, ErrThumbnailUnsupported
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrThumbnailUnsupported, err)
+	}
+
+	width, height := thumbnailBounds(config.Width, config.Height, size)
+	dst := ScaleImage(src, width, height)
+
+	// EXIF の向きに合わせて回転・反転
+	if exif, err := ReadExif(filename); err == nil && exif.Orientation != 1 {
+		dst = OrientImage(dst, exif.Orientation)
+		width, height = dst.Rect.Dx(), dst.Rect.Dy()
+	}
+
+	var buf bytes.Buffer
+	thumb := &Thumbnail{Width: width, Height: height}
+	if dst.Opaque() {
+		thumb.MimeType = "image/jpeg"
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailJpegQuality})
+	} else {
+		thumb.MimeType = "image/png"
+		err = (&png.Encoder{CompressionLevel: png.BestSpeed}).Encode(&buf, dst)
+	}
+	if err != nil {
+		return nil, err
+	}
+	thumb.Data = buf.Bytes()
+	return thumb, nil
+}
+
+// thumbnailBounds は縦横比を保ったまま長辺が size 以下になるピクセル数を返します
+func thumbnailBounds(width, height, size int) (int, int) {
+	if width <= size && height <= size {
+		return width, height
+	}
+	if width >= height {
+		return size, max(1, (height*size+width/2)/width)
+	}
+	return max(1, (width*size+height/2)/height), size
+}
+
+// ScaleImage は src を width × height に縮小した画像を返します。
+// 各出力画素に対応する元画像の範囲を面積で加重平均する（エリア平均法）ため、
+// 縮小率が大きくてもモアレやちらつきが出にくい方式です。拡大にも使えますが最近傍と同等の品質です。
+func ScaleImage(src image.Image, width, height int) *image.RGBA {
+	// 標準ライブラリの高速な変換で RGBA（乗算済みアルファ）にそろえる
+	bounds := src.Bounds()
+	rgba, ok := src.(*image.RGBA)
+	if !ok || bounds.Min != (image.Point{}) {
+		rgba = image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	}
+	srcW, srcH := rgba.Rect.Dx(), rgba.Rect.Dy()
+
+	// 横方向に縮小
+	xSpans := scaleSpans(srcW, width)
+	tmp := make([]float32, width*srcH*4)
+	for y := 0; y < srcH; y++ {
+		row := rgba.Pix[y*rgba.Stride:]
+		for x, span := range xSpans {
+			var acc [4]float32
+			for i, w := range span.weights {
+				p := row[(span.start+i)*4:]
+				acc[0] += w * float32(p[0])
+				acc[1] += w * float32(p[1])
+				acc[2] += w * float32(p[2])
+				acc[3] += w * float32(p[3])
+			}
+			copy(tmp[(y*width+x)*4:], acc[:])
+		}
+	}
+
+	// 縦方向に縮小
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	ySpans := scaleSpans(srcH, height)
+	for y, span := range ySpans {
+		out := dst.Pix[y*dst.Stride:]
+		for x := 0; x < width; x++ {
+			var acc [4]float32
+			for i, w := range span.weights {
+				p := tmp[((span.start+i)*width+x)*4:]
+				acc[0] += w * p[0]
+				acc[1] += w * p[1]
+				acc[2] += w * p[2]
+				acc[3] += w * p[3]
+			}
+			for c := range acc {
+				out[x*4+c] = clampUint8(acc[c])
+			}
+		}
+	}
+	return dst
+}
+
+// OrientImage は EXIF の向き（1〜8）の画像を正しい向きに回転・反転した画像を返します
+// 向きが 5〜8 の場合は幅と高さが入れ替わります
+func OrientImage(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 左右反転
+				dx, dy = w-1-x, y
+			case 3: // 180度回転
+				dx, dy = w-1-x, h-1-y
+			case 4: // 上下反転
+				dx, dy = x, h-1-y
+			case 5: // 左上と右下を結ぶ対角線で反転
+				dx, dy = y, x
+			case 6: // 時計回りに90度回転
+				dx, dy = h-1-y, x
+			case 7: // 右上と左下を結ぶ対角線で反転
+				dx, dy = h-1-y, w-1-x
+			case 8: // 反時計回りに90度回転
+				dx, dy = y, w-1-x
+			}
+			s := src.PixOffset(x+src.Rect.Min.X, y+src.Rect.Min.Y)
+			d := dst.PixOffset(dx, dy)
+			copy(dst.Pix[d:d+4], src.Pix[s:s+4])
+		}
+	}
+	return dst
+}
+
+// scaleSpan は出力の1画素に対応する元画像の範囲と重みです
+type scaleSpan struct {
+	start   int
+	weights []float32
+}
+
+// scaleSpans は長さ srcLen を dstLen に縮小する際の各出力画素の範囲と重み（合計1）を返します
+func scaleSpans(srcLen, dstLen int) []scaleSpan {
+	spans := make([]scaleSpan, dstLen)
+	scale := float64(srcLen) / float64(dstLen)
+	for i := range spans {
+		lo, hi := float64(i)*scale, float64(i+1)*scale
+		start := int(lo)
+		end := min(int(hi+0.999999), srcLen)
+		if end <= start {
+			end = start + 1
+		}
+		weights := make([]float32, end-start)
+		total := 0.0
+		for j := start; j < end; j++ {
+			// 元画素 [j, j+1) と出力範囲 [lo, hi) の重なり
+			w := min(hi, float64(j+1)) - max(lo, float64(j))
+			if w <= 0 {
+				w = 0
+			}
+			weights[j-start] = float32(w)
+			total += w
+		}
+		if total == 0 {
+			weights[0], total = 1, 1
+		}
+		for j := range weights {
+			weights[j] /= float32(total)
+		}
+		spans[i] = scaleSpan{start: start, weights: weights}
+	}
+	return spans
+}
+
+// clampUint8 は v を四捨五入して 0〜255 に収めます
+func clampUint8(v float32) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	}
+	return uint8(v + 0.5)
+}
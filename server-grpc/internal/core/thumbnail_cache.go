@@ -0,0 +1,185 @@
+package core
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"image"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ThumbnailCache はファイル内容のハッシュとサイズをキーにサムネイルをディスクに保存するキャッシュです。
+//   - 内容が同じファイルはパスが異なっても同じサムネイルを共有します。
+//   - 合計サイズが上限を超えた場合は最後に使用した日時の古いものから削除します。
+type ThumbnailCache struct {
+	mu sync.Mutex
+
+	// folder はサムネイルを保存するフォルダー
+	folder string
+
+	// maxBytes は保存するサムネイルの合計サイズの上限（0以下の場合は無制限）
+	maxBytes int64
+
+	// totalBytes は保存済みのサムネイルの合計サイズ
+	totalBytes int64
+}
+
+// OpenThumbnailCache は folder のキャッシュを開きます（存在しない場合は作成します）
+func OpenThumbnailCache(folder string, maxBytes int64) (*ThumbnailCache, error) {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+	c := &ThumbnailCache{folder: folder, maxBytes: maxBytes}
+	files, err := c.files()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		c.totalBytes += file.size
+	}
+	return c, nil
+}
+
+// Folder はキャッシュのフォルダーを返します
+func (c *ThumbnailCache) Folder() string {
+	return c.folder
+}
+
+// Get はキャッシュ済みのサムネイルを返します
+func (c *ThumbnailCache) Get(digest string, size int) (*Thumbnail, bool) {
+	for _, mimeType := range []string{"image/jpeg", "image/png"} {
+		path := c.pathOf(digest, size, mimeType)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			// 壊れたキャッシュは削除して作り直す
+			c.remove(path)
+			continue
+		}
+		// 最後に使用した日時として更新時刻を更新
+		now := time.Now()
+		_ = os.Chtimes(path, now, now)
+		return &Thumbnail{Data: data, MimeType: mimeType, Width: config.Width, Height: config.Height}, true
+	}
+	return nil, false
+}
+
+// Put はサムネイルを保存し、上限を超えた場合は古いものを削除します
+func (c *ThumbnailCache) Put(digest string, size int, thumb *Thumbnail) error {
+	path := c.pathOf(digest, size, thumb.MimeType)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// 書き込み途中の状態を残さないよう一時ファイルに書き込んでから置き換える
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, thumb.Data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	c.mu.Lock()
+	c.totalBytes += int64(len(thumb.Data))
+	over := c.maxBytes > 0 && c.totalBytes > c.maxBytes
+	c.mu.Unlock()
+
+	if over {
+		return c.evict()
+	}
+	return nil
+}
+
+// thumbnailCacheFile はキャッシュに保存したサムネイルのファイルです
+type thumbnailCacheFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// evict は合計サイズが上限の 9 割になるまで最後に使用した日時の古いものから削除します
+func (c *ThumbnailCache) evict() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(files, func(a, b thumbnailCacheFile) int {
+		return cmp.Compare(a.modTime.UnixNano(), b.modTime.UnixNano())
+	})
+
+	c.totalBytes = 0
+	for _, file := range files {
+		c.totalBytes += file.size
+	}
+	target := c.maxBytes / 10 * 9
+	for _, file := range files {
+		if c.totalBytes <= target {
+			break
+		}
+		if err := os.Remove(file.path); err == nil {
+			c.totalBytes -= file.size
+		}
+	}
+	return nil
+}
+
+// files は保存済みのサムネイルのファイルを返します
+func (c *ThumbnailCache) files() ([]thumbnailCacheFile, error) {
+	files := make([]thumbnailCacheFile, 0)
+	err := filepath.WalkDir(c.folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+		fi, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		files = append(files, thumbnailCacheFile{path: path, size: fi.Size(), modTime: fi.ModTime()})
+		return nil
+	})
+	return files, err
+}
+
+// remove はキャッシュのファイルを削除します
+func (c *ThumbnailCache) remove(path string) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	if err := os.Remove(path); err == nil {
+		c.mu.Lock()
+		c.totalBytes -= fi.Size()
+		c.mu.Unlock()
+	}
+}
+
+// pathOf はサムネイルの保存先のパスを返します
+// ハッシュの先頭2文字のフォルダーに分けて1フォルダーのファイル数を抑えます
+func (c *ThumbnailCache) pathOf(digest string, size int, mimeType string) string {
+	ext := ".jpg"
+	if mimeType == "image/png" {
+		ext = ".png"
+	}
+	prefix := digest
+	if len(prefix) > 2 {
+		prefix = prefix[:2]
+	}
+	return filepath.Join(c.folder, prefix, fmt.Sprintf("%s-%d%s", digest, size, ext))
+}
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TrashFolderName はルートフォルダー直下に作成するゴミ箱フォルダー名です
+const TrashFolderName = PathistSystemPrefix + "-trash"
+
+// trashMetaSuffix はゴミ箱エントリーのメタデータファイルの拡張子です
+const trashMetaSuffix = ".yaml"
+
+// ErrTrashEntryNotFound は指定されたゴミ箱エントリーが存在しないことを示します
+var ErrTrashEntryNotFound = fmt.Errorf("trash entry %w", os.ErrNotExist)
+
+// TrashEntry はゴミ箱に移動したファイル・フォルダーのメタデータです
+type TrashEntry struct {
+	// Id はゴミ箱エントリーの識別子
+	Id string `yaml:"id"`
+
+	// OriginalPath はルートフォルダーからの元の相対パス
+	OriginalPath string `yaml:"original_path"`
+
+	// DeletedBy は削除を行ったユーザーまたは接続元
+	DeletedBy string `yaml:"deleted_by"`
+
+	// DeletedAt は削除した時刻
+	DeletedAt time.Time `yaml:"deleted_at"`
+
+	// IsDir はフォルダーかどうか
+	IsDir bool `yaml:"is_dir"`
+
+	// Size はファイルサイズ（フォルダーの場合は0）
+	Size int64 `yaml:"size"`
+}
+
+// Trash はルートフォルダー配下のゴミ箱です。
+//   - 削除対象は {folder}/{id}/{元の名前} に移動し、メタデータを {folder}/{id}.yaml に保存します。
+//   - 同一ボリューム内の移動のため、大きなフォルダーでも削除は即座に完了します。
+type Trash struct {
+	// folder はゴミ箱フォルダーの絶対パス
+	folder string
+}
+
+// OpenTrash は folder をゴミ箱として開きます。フォルダーが存在しない場合は作成します。
+func OpenTrash(folder string) (*Trash, error) {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+	return &Trash{folder: folder}, nil
+}
+
+// Folder はゴミ箱フォルダーの絶対パスを返します
+func (t *Trash) Folder() string {
+	return t.folder
+}
+
+// Put は absPath をゴミ箱に移動します
+// relPath: 復元時に使用する元の相対パス
+// deletedBy: 削除を行ったユーザーまたは接続元
+func (t *Trash) Put(absPath, relPath, deletedBy string) (TrashEntry, error) {
+	fi, err := os.Lstat(absPath)
+	if err != nil {
+		return TrashEntry{}, err
+	}
+
+	id, err := newTrashId(time.Now())
+	if err != nil {
+		return TrashEntry{}, err
+	}
+	entry := TrashEntry{
+		Id:           id,
+		OriginalPath: filepath.ToSlash(relPath),
+		DeletedBy:    deletedBy,
+		DeletedAt:    time.Now(),
+		IsDir:        fi.IsDir(),
+	}
+	if !fi.IsDir() {
+		entry.Size = fi.Size()
+	}
+
+	// メタデータを先に保存し、移動に失敗した場合は削除
+	if err := os.Mkdir(t.entryFolder(id), 0755); err != nil {
+		return TrashEntry{}, err
+	}
+	if err := t.writeMeta(entry); err != nil {
+		os.RemoveAll(t.entryFolder(id))
+		return TrashEntry{}, err
+	}
+	if err := os.Rename(absPath, t.contentPath(entry)); err != nil {
+		t.Purge(id)
+		return TrashEntry{}, err
+	}
+	return entry, nil
+}
+
+// List はゴミ箱のエントリー一覧を削除日時の新しい順に返します
+func (t *Trash) List() ([]TrashEntry, error) {
+	dirs, err := os.ReadDir(t.folder)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]TrashEntry, 0, len(dirs))
+	for _, dir := range dirs {
+		name := dir.Name()
+		if dir.IsDir() || !strings.HasSuffix(name, trashMetaSuffix) {
+			continue
+		}
+		entry, err := t.Get(strings.TrimSuffix(name, trashMetaSuffix))
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].DeletedAt.After(entries[b].DeletedAt)
+	})
+	return entries, nil
+}
+
+// Get は id のエントリーを返します
+func (t *Trash) Get(id string) (TrashEntry, error) {
+	if !validTrashId(id) {
+		return TrashEntry{}, ErrTrashEntryNotFound
+	}
+	data, err := os.ReadFile(t.metaPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return TrashEntry{}, ErrTrashEntryNotFound
+		}
+		return TrashEntry{}, err
+	}
+	entry := TrashEntry{}
+	if err := yaml.Unmarshal(data, &entry); err != nil {
+		return TrashEntry{}, err
+	}
+	entry.Id = id
+	return entry, nil
+}
+
+// Restore は id のエントリーを absDst に戻します。
+// absDst が既に存在する場合は os.ErrExist を返します。
+func (t *Trash) Restore(id, absDst string) (TrashEntry, error) {
+	entry, err := t.Get(id)
+	if err != nil {
+		return TrashEntry{}, err
+	}
+	if _, err := os.Lstat(absDst); err == nil {
+		return entry, fmt.Errorf("%w: %s", os.ErrExist, absDst)
+	}
+
+	// 元の親フォルダーが削除されている場合は作成
+	if err := os.MkdirAll(filepath.Dir(absDst), 0755); err != nil {
+		return entry, err
+	}
+	if err := os.Rename(t.contentPath(entry), absDst); err != nil {
+		return entry, err
+	}
+	return entry, t.Purge(id)
+}
+
+// Purge は id のエントリーを完全に削除します
+func (t *Trash) Purge(id string) error {
+	if !validTrashId(id) {
+		return ErrTrashEntryNotFound
+	}
+	if err := os.RemoveAll(t.entryFolder(id)); err != nil {
+		return err
+	}
+	if err := os.Remove(t.metaPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// PurgeOlderThan は cutoff より前に削除されたエントリーを完全に削除します
+// 戻り値は削除したエントリー数です
+func (t *Trash) PurgeOlderThan(cutoff time.Time) (int, error) {
+	entries, err := t.List()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, entry := range entries {
+		if !entry.DeletedAt.Before(cutoff) {
+			continue
+		}
+		if err := t.Purge(entry.Id); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
+
+// entryFolder はエントリーの内容を保存するフォルダーのパスを返します
+func (t *Trash) entryFolder(id string) string {
+	return filepath.Join(t.folder, id)
+}
+
+// metaPath はエントリーのメタデータファイルのパスを返します
+func (t *Trash) metaPath(id string) string {
+	return filepath.Join(t.folder, id+trashMetaSuffix)
+}
+
+// contentPath はゴミ箱内の移動先パスを返します
+func (t *Trash) contentPath(entry TrashEntry) string {
+	return filepath.Join(t.entryFolder(entry.Id), filepath.Base(filepath.FromSlash(entry.OriginalPath)))
+}
+
+// writeMeta はメタデータファイルを保存します
+func (t *Trash) writeMeta(entry TrashEntry) error {
+	data, err := yaml.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(t.metaPath(entry.Id), data, 0644)
+}
+
+// newTrashId は時刻と乱数からゴミ箱エントリーの識別子を作成します
+func newTrashId(now time.Time) (string, error) {
+	random := make([]byte, 4)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return now.Format("20060102T150405") + "-" + hex.EncodeToString(random), nil
+}
+
+// validTrashId は id がゴミ箱フォルダー外を指さない識別子かをチェックします
+func validTrashId(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
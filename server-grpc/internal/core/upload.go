@@ -0,0 +1,344 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UploadFolderName はルートフォルダー直下に作成するアップロード一時フォルダー名です
+const UploadFolderName = PathistSystemPrefix + "-uploads"
+
+// uploadPartSuffix はアップロード途中のデータファイルの拡張子です
+const uploadPartSuffix = ".part"
+
+// uploadMetaSuffix はアップロードのメタデータファイルの拡張子です
+const uploadMetaSuffix = ".yaml"
+
+var (
+	// ErrUploadInUse は同じアップロードIDが別のストリームで使用中であることを示します
+	ErrUploadInUse = errors.New("upload is in use")
+
+	// ErrUploadMismatch は再開時の要求内容が既存のアップロードと一致しないことを示します
+	ErrUploadMismatch = errors.New("upload does not match the existing session")
+
+	// ErrUploadOffset は書き込み位置が受信済みサイズと一致しないことを示します
+	ErrUploadOffset = errors.New("upload offset does not match the received size")
+
+	// ErrUploadChecksum はアップロードしたデータのチェックサムが一致しないことを示します
+	ErrUploadChecksum = errors.New("upload checksum mismatch")
+)
+
+// UploadInfo はアップロードのメタデータです
+type UploadInfo struct {
+	// Id はアップロードの識別子
+	Id string `yaml:"id"`
+
+	// Path はアップロード先のルートフォルダーからの相対パス
+	Path string `yaml:"path"`
+
+	// TotalSize はアップロードするファイルの全体サイズ
+	TotalSize int64 `yaml:"total_size"`
+
+	// Checksum はファイル全体の BLAKE2b-256 ハッシュ（16進文字列）
+	Checksum string `yaml:"checksum"`
+
+	// Created はアップロードの開始時刻
+	Created time.Time `yaml:"created"`
+}
+
+// UploadStore はルートフォルダー配下のアップロード一時領域です。
+//   - 受信中のデータは {folder}/{id}.part に追記し、メタデータを {folder}/{id}.yaml に保存します。
+//   - 接続が切れた場合も一時ファイルを残し、同じIDで再開できます。
+type UploadStore struct {
+	mu sync.Mutex
+
+	// folder はアップロード一時フォルダーの絶対パス
+	folder string
+
+	// active はストリームで使用中のアップロードID
+	active map[string]bool
+}
+
+// UploadSession は1つのアップロードへの書き込みです
+type UploadSession struct {
+	// store はセッションを作成したストア
+	store *UploadStore
+
+	// info はアップロードのメタデータ
+	info UploadInfo
+
+	// file は追記中の一時ファイル
+	file *os.File
+
+	// received は受信済みのサイズ
+	received int64
+}
+
+// OpenUploadStore は folder をアップロード一時領域として開きます。フォルダーが存在しない場合は作成します。
+func OpenUploadStore(folder string) (*UploadStore, error) {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+	return &UploadStore{folder: folder, active: map[string]bool{}}, nil
+}
+
+// Begin はアップロードを開始または再開します。
+// id が空の場合は新しいIDを採番します。
+// 既存のアップロードを再開する場合は path, totalSize, checksum が一致している必要があります。
+func (u *UploadStore) Begin(id, path string, totalSize int64, checksum string) (*UploadSession, error) {
+	if id == "" {
+		newId, err := newUploadId()
+		if err != nil {
+			return nil, err
+		}
+		id = newId
+	}
+	if !validUploadId(id) {
+		return nil, fmt.Errorf("invalid upload id: %s", id)
+	}
+
+	// 同じIDの同時書き込みを防ぐ
+	u.mu.Lock()
+	if u.active[id] {
+		u.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrUploadInUse, id)
+	}
+	u.active[id] = true
+	u.mu.Unlock()
+
+	session, err := u.open(id, path, totalSize, strings.ToLower(checksum))
+	if err != nil {
+		u.release(id)
+		return nil, err
+	}
+	return session, nil
+}
+
+// open はアップロードのメタデータと一時ファイルを開きます
+func (u *UploadStore) open(id, path string, totalSize int64, checksum string) (*UploadSession, error) {
+	info := UploadInfo{
+		Id:        id,
+		Path:      filepath.ToSlash(path),
+		TotalSize: totalSize,
+		Checksum:  checksum,
+		Created:   time.Now(),
+	}
+
+	// 既存のアップロードがある場合は内容を照合
+	if prev, err := u.readInfo(id); err == nil {
+		if prev.Path != info.Path || prev.TotalSize != info.TotalSize || prev.Checksum != info.Checksum {
+			return nil, fmt.Errorf("%w: %s", ErrUploadMismatch, id)
+		}
+		info = *prev
+	} else if os.IsNotExist(err) {
+		if err := u.writeInfo(info); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(u.partPath(id), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &UploadSession{store: u, info: info, file: file, received: fi.Size()}, nil
+}
+
+// Discard はアップロードの一時ファイルとメタデータを削除します
+func (u *UploadStore) Discard(id string) error {
+	if !validUploadId(id) {
+		return fmt.Errorf("invalid upload id: %s", id)
+	}
+	var errs []error
+	for _, path := range []string{u.partPath(id), u.metaPath(id)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PurgeOlderThan は cutoff より前に開始され、使用中でないアップロードを削除します
+// 戻り値は削除したアップロード数です
+func (u *UploadStore) PurgeOlderThan(cutoff time.Time) (int, error) {
+	entries, err := os.ReadDir(u.folder)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, uploadMetaSuffix) {
+			continue
+		}
+		id := strings.TrimSuffix(name, uploadMetaSuffix)
+		info, err := u.readInfo(id)
+		if err != nil || !info.Created.Before(cutoff) {
+			continue
+		}
+
+		u.mu.Lock()
+		inUse := u.active[id]
+		u.mu.Unlock()
+		if inUse {
+			continue
+		}
+
+		if err := u.Discard(id); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
+
+// release はアップロードIDの使用中状態を解除します
+func (u *UploadStore) release(id string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.active, id)
+}
+
+// readInfo はアップロードのメタデータを読み込みます
+func (u *UploadStore) readInfo(id string) (*UploadInfo, error) {
+	data, err := os.ReadFile(u.metaPath(id))
+	if err != nil {
+		return nil, err
+	}
+	info := &UploadInfo{}
+	if err := yaml.Unmarshal(data, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// writeInfo はアップロードのメタデータを保存します
+func (u *UploadStore) writeInfo(info UploadInfo) error {
+	data, err := yaml.Marshal(&info)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(u.metaPath(info.Id), data, 0644)
+}
+
+// partPath はアップロード途中のデータファイルのパスを返します
+func (u *UploadStore) partPath(id string) string {
+	return filepath.Join(u.folder, id+uploadPartSuffix)
+}
+
+// metaPath はアップロードのメタデータファイルのパスを返します
+func (u *UploadStore) metaPath(id string) string {
+	return filepath.Join(u.folder, id+uploadMetaSuffix)
+}
+
+// Info はアップロードのメタデータを返します
+func (s *UploadSession) Info() UploadInfo {
+	return s.info
+}
+
+// Received は受信済みのサイズを返します
+func (s *UploadSession) Received() int64 {
+	return s.received
+}
+
+// Write は offset の位置にデータを追記します。
+// offset は受信済みサイズと一致している必要があります。
+func (s *UploadSession) Write(offset int64, data []byte) error {
+	if offset != s.received {
+		return fmt.Errorf("%w: offset=%d received=%d", ErrUploadOffset, offset, s.received)
+	}
+	if s.info.TotalSize >= 0 && s.received+int64(len(data)) > s.info.TotalSize {
+		return fmt.Errorf("%w: data exceeds total size %d", ErrUploadOffset, s.info.TotalSize)
+	}
+	n, err := s.file.Write(data)
+	s.received += int64(n)
+	return err
+}
+
+// Complete は全体を受信済みであればチェックサムを検証し、一時ファイルを閉じてパスを返します。
+// 戻り値 completed が false の場合はまだ受信途中です。
+// 検証に失敗した場合は一時ファイルを破棄して ErrUploadChecksum を返します。
+func (s *UploadSession) Complete() (partPath string, completed bool, err error) {
+	if s.received < s.info.TotalSize {
+		return "", false, nil
+	}
+	if err := s.file.Sync(); err != nil {
+		return "", false, err
+	}
+
+	partPath = s.store.partPath(s.info.Id)
+	if s.info.Checksum != "" {
+		sum, err := Blake2bHexFromFile(partPath)
+		if err != nil {
+			return "", false, err
+		}
+		if sum != s.info.Checksum {
+			s.file.Close()
+			s.store.Discard(s.info.Id)
+			return "", false, fmt.Errorf("%w: expected=%s actual=%s", ErrUploadChecksum, s.info.Checksum, sum)
+		}
+	}
+
+	// 開いたままでは移動できない環境（Windows）があるため、配置前に閉じる
+	if err := s.file.Close(); err != nil {
+		return "", false, err
+	}
+	return partPath, true, nil
+}
+
+// Close は一時ファイルを閉じてアップロードIDの使用中状態を解除します。
+// finished が true の場合はメタデータも削除します（一時ファイルは移動済みであることが前提です）。
+func (s *UploadSession) Close(finished bool) error {
+	err := s.file.Close()
+	if errors.Is(err, os.ErrClosed) {
+		err = nil
+	}
+	if finished {
+		err = errors.Join(err, s.store.Discard(s.info.Id))
+	}
+	s.store.release(s.info.Id)
+	return err
+}
+
+// newUploadId はアップロードの識別子を作成します
+func newUploadId() (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(random), nil
+}
+
+// validUploadId は id がファイル名として安全な識別子かをチェックします
+func validUploadId(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
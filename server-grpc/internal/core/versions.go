@@ -0,0 +1,333 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+	"gopkg.in/yaml.v3"
+)
+
+// VersionFolderName はルートフォルダー直下に作成する過去の版の保存フォルダー名です
+const VersionFolderName = PathistSystemPrefix + "-versions"
+
+// versionMetaSuffix は過去の版のメタデータファイルの拡張子です
+const versionMetaSuffix = ".yaml"
+
+// ErrVersionNotFound は指定された過去の版が存在しないことを示します
+var ErrVersionNotFound = fmt.Errorf("file version %w", os.ErrNotExist)
+
+// VersionReasonOverwrite, VersionReasonRestore は過去の版を保存した理由です
+const (
+	VersionReasonOverwrite = "overwrite"
+	VersionReasonRestore   = "restore"
+)
+
+// VersionEntry は上書き前に保存したファイルの過去の版のメタデータです
+type VersionEntry struct {
+	// Id は過去の版の識別子
+	Id string `yaml:"id"`
+
+	// OriginalPath はルートフォルダーからの相対パス
+	OriginalPath string `yaml:"original_path"`
+
+	// Reason は保存した理由（VersionReasonOverwrite など）
+	Reason string `yaml:"reason"`
+
+	// SavedAt は保存した（上書きされた）時刻
+	SavedAt time.Time `yaml:"saved_at"`
+
+	// ModTime は保存した内容の更新日時
+	ModTime time.Time `yaml:"mod_time"`
+
+	// Size はファイルサイズ
+	Size int64 `yaml:"size"`
+}
+
+// VersionStore はルートフォルダー配下のファイルの過去の版の保存領域です。
+//   - 過去の版は {folder}/{パスのハッシュ}/{id}/{元の名前} に移動し、メタデータを {id}.yaml に保存します。
+//   - ファイル毎に maxCount 件を超えた古い版と、保存から maxAge を過ぎた版を削除します。
+//   - 同一ボリューム内の移動のため、大きなファイルでも保存は即座に完了します。
+type VersionStore struct {
+	// folder は過去の版の保存フォルダーの絶対パス
+	folder string
+
+	// maxCount はファイル毎に保持する版の数（0以下の場合は版を保存しません）
+	maxCount int
+
+	// maxAge は版を保持する期間（0以下の場合は期間で削除しません）
+	maxAge time.Duration
+}
+
+// OpenVersionStore は folder を過去の版の保存領域として開きます。フォルダーが存在しない場合は作成します。
+func OpenVersionStore(folder string, maxCount int, maxAge time.Duration) (*VersionStore, error) {
+	if err := os.MkdirAll(folder, 0755); err != nil {
+		return nil, err
+	}
+	return &VersionStore{folder: folder, maxCount: maxCount, maxAge: maxAge}, nil
+}
+
+// Enabled は過去の版を保存するかどうかを返します
+func (v *VersionStore) Enabled() bool {
+	return v != nil && v.maxCount > 0
+}
+
+// Put は absPath のファイルを過去の版として保存領域に移動します
+// relPath: ルートフォルダーからの相対パス
+// reason: 保存した理由
+func (v *VersionStore) Put(absPath, relPath, reason string) (VersionEntry, error) {
+	fi, err := os.Lstat(absPath)
+	if err != nil {
+		return VersionEntry{}, err
+	}
+	if !fi.Mode().IsRegular() {
+		return VersionEntry{}, fmt.Errorf("not a regular file: %s", absPath)
+	}
+
+	id, err := newTrashId(time.Now())
+	if err != nil {
+		return VersionEntry{}, err
+	}
+	entry := VersionEntry{
+		Id:           id,
+		OriginalPath: versionPathOf(relPath),
+		Reason:       reason,
+		SavedAt:      time.Now(),
+		ModTime:      fi.ModTime(),
+		Size:         fi.Size(),
+	}
+
+	// メタデータを先に保存し、移動に失敗した場合は削除
+	if err := os.MkdirAll(v.entryFolder(entry), 0755); err != nil {
+		return VersionEntry{}, err
+	}
+	if err := v.writeMeta(entry); err != nil {
+		os.RemoveAll(v.entryFolder(entry))
+		return VersionEntry{}, err
+	}
+	if err := os.Rename(absPath, v.contentPath(entry)); err != nil {
+		v.remove(entry)
+		return VersionEntry{}, err
+	}
+
+	// 保持数を超えた古い版を削除
+	if _, err := v.prune(entry.OriginalPath, time.Time{}); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// List は relPath の過去の版を保存日時の新しい順に返します
+func (v *VersionStore) List(relPath string) ([]VersionEntry, error) {
+	relPath = versionPathOf(relPath)
+	dirs, err := os.ReadDir(v.keyFolder(relPath))
+	if errors.Is(err, os.ErrNotExist) {
+		return []VersionEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]VersionEntry, 0, len(dirs))
+	for _, dir := range dirs {
+		name := dir.Name()
+		if dir.IsDir() || !strings.HasSuffix(name, versionMetaSuffix) {
+			continue
+		}
+		// ハッシュが衝突した別のファイルの版は Get で除外
+		entry, err := v.Get(relPath, strings.TrimSuffix(name, versionMetaSuffix))
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].SavedAt.After(entries[b].SavedAt)
+	})
+	return entries, nil
+}
+
+// Get は relPath の id の版を返します
+func (v *VersionStore) Get(relPath, id string) (VersionEntry, error) {
+	relPath = versionPathOf(relPath)
+	if !validTrashId(id) {
+		return VersionEntry{}, ErrVersionNotFound
+	}
+	data, err := os.ReadFile(v.metaPath(relPath, id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return VersionEntry{}, ErrVersionNotFound
+		}
+		return VersionEntry{}, err
+	}
+	entry := VersionEntry{}
+	if err := yaml.Unmarshal(data, &entry); err != nil {
+		return VersionEntry{}, err
+	}
+	if entry.OriginalPath != relPath {
+		return VersionEntry{}, ErrVersionNotFound
+	}
+	entry.Id = id
+	return entry, nil
+}
+
+// Restore は relPath の id の版を absDst に戻し、保存領域から削除します。
+// absDst が既に存在する場合は os.ErrExist を返します。
+func (v *VersionStore) Restore(relPath, id, absDst string) (VersionEntry, error) {
+	entry, err := v.Get(relPath, id)
+	if err != nil {
+		return VersionEntry{}, err
+	}
+	if _, err := os.Lstat(absDst); err == nil {
+		return entry, fmt.Errorf("%w: %s", os.ErrExist, absDst)
+	}
+
+	// 元の親フォルダーが削除されている場合は作成
+	if err := os.MkdirAll(filepath.Dir(absDst), 0755); err != nil {
+		return entry, err
+	}
+	if err := os.Rename(v.contentPath(entry), absDst); err != nil {
+		return entry, err
+	}
+	return entry, v.remove(entry)
+}
+
+// PurgeExpired は保存から maxAge を過ぎた版を全て削除します
+// 戻り値は削除した版の数です
+func (v *VersionStore) PurgeExpired(now time.Time) (int, error) {
+	if v.maxAge <= 0 {
+		return 0, nil
+	}
+	keys, err := os.ReadDir(v.folder)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, key := range keys {
+		if !key.IsDir() {
+			continue
+		}
+		n, err := v.pruneKey(filepath.Join(v.folder, key.Name()), now.Add(-v.maxAge))
+		purged += n
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return purged, errors.Join(errs...)
+}
+
+// prune は relPath の版のうち保持数を超えたもの、cutoff より前に保存したものを削除します
+func (v *VersionStore) prune(relPath string, cutoff time.Time) (int, error) {
+	if v.maxAge > 0 && cutoff.IsZero() {
+		cutoff = time.Now().Add(-v.maxAge)
+	}
+	return v.pruneKey(v.keyFolder(relPath), cutoff)
+}
+
+// pruneKey はハッシュ毎のフォルダー内の版を元のパス毎に整理します
+func (v *VersionStore) pruneKey(keyFolder string, cutoff time.Time) (int, error) {
+	dirs, err := os.ReadDir(keyFolder)
+	if err != nil {
+		return 0, err
+	}
+
+	// 元のパス毎にまとめる（ハッシュの衝突に備える）
+	byPath := map[string][]VersionEntry{}
+	for _, dir := range dirs {
+		name := dir.Name()
+		if dir.IsDir() || !strings.HasSuffix(name, versionMetaSuffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(keyFolder, name))
+		if err != nil {
+			continue
+		}
+		entry := VersionEntry{}
+		if err := yaml.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+		entry.Id = strings.TrimSuffix(name, versionMetaSuffix)
+		byPath[entry.OriginalPath] = append(byPath[entry.OriginalPath], entry)
+	}
+
+	purged := 0
+	var errs []error
+	for _, entries := range byPath {
+		sort.Slice(entries, func(a, b int) bool {
+			return entries[a].SavedAt.After(entries[b].SavedAt)
+		})
+		for i, entry := range entries {
+			if i < v.maxCount && (cutoff.IsZero() || !entry.SavedAt.Before(cutoff)) {
+				continue
+			}
+			if err := v.remove(entry); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			purged++
+		}
+	}
+
+	// 空になったフォルダーを削除
+	if rest, err := os.ReadDir(keyFolder); err == nil && len(rest) == 0 {
+		os.Remove(keyFolder)
+	}
+	return purged, errors.Join(errs...)
+}
+
+// remove は版の内容とメタデータを削除します
+func (v *VersionStore) remove(entry VersionEntry) error {
+	if err := os.RemoveAll(v.entryFolder(entry)); err != nil {
+		return err
+	}
+	if err := os.Remove(v.metaPath(entry.OriginalPath, entry.Id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// keyFolder は relPath の版を保存するフォルダーのパスを返します
+// 深い階層や長いファイル名でもパス長を超えないよう、相対パスのハッシュをフォルダー名とします
+func (v *VersionStore) keyFolder(relPath string) string {
+	sum := blake2b.Sum256([]byte(relPath))
+	return filepath.Join(v.folder, hex.EncodeToString(sum[:16]))
+}
+
+// metaPath は版のメタデータファイルのパスを返します
+func (v *VersionStore) metaPath(relPath, id string) string {
+	return filepath.Join(v.keyFolder(relPath), id+versionMetaSuffix)
+}
+
+// entryFolder は版の内容を保存するフォルダーのパスを返します
+func (v *VersionStore) entryFolder(entry VersionEntry) string {
+	return filepath.Join(v.keyFolder(entry.OriginalPath), entry.Id)
+}
+
+// contentPath は版の内容の保存先パスを返します
+func (v *VersionStore) contentPath(entry VersionEntry) string {
+	return filepath.Join(v.entryFolder(entry), path.Base(entry.OriginalPath))
+}
+
+// writeMeta はメタデータファイルを保存します
+func (v *VersionStore) writeMeta(entry VersionEntry) error {
+	data, err := yaml.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(v.metaPath(entry.OriginalPath, entry.Id), data, 0644)
+}
+
+// versionPathOf は相対パスをスラッシュ区切りに正規化します
+func versionPathOf(relPath string) string {
+	return path.Clean(filepath.ToSlash(relPath))
+}
@@ -127,7 +127,7 @@ func (w *Watcher) handleInternalEvent(event fsnotify.Event) {
 
 func (w *Watcher) addWatchIfDirectory(path string) error {
 	info, err := os.Stat(path)
-	if err != nil || !info.IsDir() {
+	if err != nil || !info.IsDir() || FilenameIsPathistSystem(info.Name()) {
 		return nil
 	}
 
@@ -161,7 +161,8 @@ func (w *Watcher) addWatchersRecursively(dir string, depth int) error {
 	}
 
 	for _, entry := range entries {
-		if entry.IsDir() {
+		// Pathist の内部管理用フォルダーは監視しない
+		if entry.IsDir() && !FilenameIsPathistSystem(entry.Name()) {
 			if err := w.addWatchersRecursively(filepath.Join(cleanDir, entry.Name()), depth+1); err != nil {
 				return err
 			}
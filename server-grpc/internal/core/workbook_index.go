@@ -0,0 +1,425 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// workbookIndexMaxCells はブック毎に索引するセルの最大数
+const workbookIndexMaxCells = 50_000
+
+// workbookIndexMaxFileSize は索引する xlsx ファイルの最大サイズ
+const workbookIndexMaxFileSize = 32 << 20
+
+// WorkbookMatch は検索語を含むセルです
+type WorkbookMatch struct {
+	// Sheet はシート名
+	Sheet string
+
+	// Ref はセル番地
+	Ref string
+
+	// Text はセルの値
+	Text string
+}
+
+// WorkbookHit はブック内容の検索結果の1件です
+type WorkbookHit struct {
+	// Path は一致した xlsx ファイルの絶対パス
+	Path string
+
+	// Score は一致度、大きいほど上位
+	Score float64
+
+	// Matches は検索語を含むセル（最大 maxMatches 件）
+	Matches []WorkbookMatch
+
+	// MatchCount は検索語を含むセルの総数
+	MatchCount int
+}
+
+// workbookIndexCell は索引に登録したセルです
+type workbookIndexCell struct {
+	sheet  string
+	ref    string
+	text   string
+	folded string
+}
+
+// workbookIndexDoc は索引に登録した xlsx ファイルです
+type workbookIndexDoc struct {
+	// size, modTime は読み込み時のファイル情報、変更がなければ再構築時に再利用します
+	size    int64
+	modTime int64
+
+	// cells は値のあるセル
+	cells []workbookIndexCell
+
+	// grams はセルの値の N-gram（重複なし）
+	grams []string
+}
+
+// WorkbookIndex は xlsx ファイルのセルの値の N-gram 索引です。
+//   - セルの値を FoldForSearch で変換し、SearchIndex と同じ N-gram で索引します。
+//   - 検索語は全てブック内のいずれかのセルに含まれるものに一致します。
+type WorkbookIndex struct {
+	mu sync.RWMutex
+
+	// root は索引対象のルートフォルダー
+	root string
+
+	// docs は絶対パスをキーとした登録済みの xlsx ファイル
+	docs map[string]*workbookIndexDoc
+
+	// grams は N-gram をキーとした絶対パスの集合
+	grams map[string]map[string]struct{}
+
+	// changed は再構築中に変更されたパス、再構築後に反映し直す（再構築中以外は nil）
+	changed map[string]struct{}
+}
+
+// NewWorkbookIndex は root を対象とする空の索引を作成します
+func NewWorkbookIndex(root string) *WorkbookIndex {
+	return &WorkbookIndex{
+		root:  root,
+		docs:  map[string]*workbookIndexDoc{},
+		grams: map[string]map[string]struct{}{},
+	}
+}
+
+// Len は登録済みの xlsx ファイル数を返します
+func (x *WorkbookIndex) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Rebuild はルートフォルダー配下の xlsx ファイルを読み込んで索引を作り直します
+//   - サイズと更新時刻が変わっていないファイルは読み込み済みの内容を再利用します。
+//   - 読み込み中に AddTree, Update, Remove で反映した変更は、差し替え後に反映し直します。
+func (x *WorkbookIndex) Rebuild(ctx context.Context) error {
+	x.mu.Lock()
+	x.changed = map[string]struct{}{}
+	x.mu.Unlock()
+
+	next, err := x.build(ctx)
+
+	x.mu.Lock()
+	if err == nil {
+		x.docs, x.grams = next.docs, next.grams
+	}
+	changed := x.changed
+	x.changed = nil
+	x.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range changedTrees(changed) {
+		x.Remove(path)
+		if _, err := os.Lstat(path); err == nil {
+			if err := x.AddTree(ctx, path); err != nil && ctx.Err() != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// build はルートフォルダー配下の xlsx ファイルを読み込んだ新しい索引を作成します
+func (x *WorkbookIndex) build(ctx context.Context) (*WorkbookIndex, error) {
+	paths, err := x.walk(ctx, x.root)
+	if err != nil {
+		return nil, err
+	}
+
+	type loaded struct {
+		path string
+		doc  *workbookIndexDoc
+	}
+	// 読み込めないファイルは結果から除外され、索引にも登録しない
+	docs, _ := ParallelMap(ctx, paths, func(_ context.Context, path string) (loaded, error) {
+		doc, err := x.load(path)
+		return loaded{path: path, doc: doc}, err
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	next := NewWorkbookIndex(x.root)
+	for _, d := range docs {
+		if d.doc != nil {
+			next.putLocked(d.path, d.doc)
+		}
+	}
+	return next, nil
+}
+
+// AddTree は absPath とその配下の xlsx ファイルを索引に追加します
+func (x *WorkbookIndex) AddTree(ctx context.Context, absPath string) error {
+	x.markChanged(absPath)
+	paths, err := x.walk(ctx, absPath)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// 読み込めないファイルは索引に登録しない
+		_ = x.Update(path)
+	}
+	return nil
+}
+
+// Update は absPath の xlsx ファイルを読み込み直して索引に反映します
+// 読み込めない場合は索引から削除してエラーを返します
+func (x *WorkbookIndex) Update(absPath string) error {
+	absPath = filepath.Clean(absPath)
+	if PathIsPathistSystem(absPath) || !FilenameIsXlsx(filepath.Base(absPath)) {
+		return nil
+	}
+	doc, err := x.load(absPath)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.changed != nil {
+		x.changed[absPath] = struct{}{}
+	}
+	x.removeLocked(absPath)
+	if doc != nil {
+		x.putLocked(absPath, doc)
+	}
+	return err
+}
+
+// Remove は absPath とその配下を索引から削除します
+func (x *WorkbookIndex) Remove(absPath string) {
+	absPath = filepath.Clean(absPath)
+	prefix := absPath + string(os.PathSeparator)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.changed != nil {
+		x.changed[absPath] = struct{}{}
+	}
+	for path := range x.docs {
+		if path == absPath || strings.HasPrefix(path, prefix) {
+			x.removeLocked(path)
+		}
+	}
+}
+
+// markChanged は再構築中の場合に absPath を変更されたパスとして記録します
+func (x *WorkbookIndex) markChanged(absPath string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.changed != nil {
+		x.changed[filepath.Clean(absPath)] = struct{}{}
+	}
+}
+
+// Search は query を含む xlsx ファイルを一致度の高い順に最大 limit 件返します。
+//   - query は空白区切りの全ての語をブック内に含むものに一致します。
+//   - under を指定した場合は、その配下のみを対象とします。
+//   - 各ファイルの一致したセルは最大 maxMatches 件返します。
+//
+// 戻り値 total は limit で切り捨てる前の一致件数です。
+func (x *WorkbookIndex) Search(query, under string, limit, maxMatches int) (hits []WorkbookHit, total int) {
+	terms := strings.Fields(FoldForSearch(query))
+	if len(terms) == 0 {
+		return nil, 0
+	}
+	prefix := ""
+	if under != "" && filepath.Clean(under) != x.root {
+		prefix = filepath.Clean(under) + string(os.PathSeparator)
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	// 最も候補の少ない N-gram から絞り込む
+	var candidates map[string]struct{}
+	for _, term := range terms {
+		for _, gram := range searchGrams(term) {
+			paths := x.grams[gram]
+			if len(paths) == 0 {
+				return []WorkbookHit{}, 0
+			}
+			if candidates == nil || len(paths) < len(candidates) {
+				candidates = paths
+			}
+		}
+	}
+
+	hits = make([]WorkbookHit, 0)
+	for path := range candidates {
+		if prefix != "" && !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if hit, ok := x.docs[path].match(terms, maxMatches); ok {
+			hit.Path = path
+			hit.Score -= 0.5 * float64(strings.Count(path, string(os.PathSeparator)))
+			hits = append(hits, hit)
+		}
+	}
+
+	slices.SortFunc(hits, func(a, b WorkbookHit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	total = len(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, total
+}
+
+// walk は absPath 配下の xlsx ファイルのパスを返します（内部管理用は除外）
+func (x *WorkbookIndex) walk(ctx context.Context, absPath string) ([]string, error) {
+	paths := make([]string, 0)
+	err := filepath.WalkDir(absPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// 読めないフォルダーは読み飛ばす
+			if entry != nil && entry.IsDir() && path != absPath {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if FilenameIsPathistSystem(entry.Name()) {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() && FilenameIsXlsx(entry.Name()) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// load は xlsx ファイルを読み込みます
+// 索引済みでサイズと更新時刻が同じ場合は読み込み済みの内容を返します
+func (x *WorkbookIndex) load(absPath string) (*workbookIndexDoc, error) {
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() || fi.Size() > workbookIndexMaxFileSize {
+		return nil, nil
+	}
+
+	x.mu.RLock()
+	cached, ok := x.docs[absPath]
+	x.mu.RUnlock()
+	if ok && cached.size == fi.Size() && cached.modTime == fi.ModTime().UnixNano() {
+		return cached, nil
+	}
+
+	wb, err := ReadWorkbook(absPath)
+	if err != nil {
+		return nil, err
+	}
+	doc := &workbookIndexDoc{size: fi.Size(), modTime: fi.ModTime().UnixNano()}
+	grams := make([]string, 0)
+	for _, sheet := range wb.Sheets {
+		for _, cell := range sheet.Cells {
+			if len(doc.cells) >= workbookIndexMaxCells {
+				break
+			}
+			folded := FoldForSearch(cell.Text)
+			if folded == "" {
+				continue
+			}
+			doc.cells = append(doc.cells, workbookIndexCell{
+				sheet: sheet.Name, ref: cell.Ref, text: cell.Text, folded: folded,
+			})
+			grams = append(grams, searchGrams(folded)...)
+		}
+	}
+	slices.Sort(grams)
+	doc.grams = slices.Compact(grams)
+	return doc, nil
+}
+
+// putLocked は doc を索引に追加します（ロック取得済みであること）
+func (x *WorkbookIndex) putLocked(absPath string, doc *workbookIndexDoc) {
+	x.docs[absPath] = doc
+	for _, gram := range doc.grams {
+		paths, ok := x.grams[gram]
+		if !ok {
+			paths = map[string]struct{}{}
+			x.grams[gram] = paths
+		}
+		paths[absPath] = struct{}{}
+	}
+}
+
+// removeLocked は absPath のみを索引から削除します（ロック取得済みであること）
+func (x *WorkbookIndex) removeLocked(absPath string) {
+	doc, ok := x.docs[absPath]
+	if !ok {
+		return
+	}
+	for _, gram := range doc.grams {
+		if paths, ok := x.grams[gram]; ok {
+			delete(paths, absPath)
+			if len(paths) == 0 {
+				delete(x.grams, gram)
+			}
+		}
+	}
+	delete(x.docs, absPath)
+}
+
+// match は全ての語がブック内に含まれるかを調べ、一致したセルと一致度を返します。
+//   - セルの値のうち検索語が占める割合が大きいほど高くなります。
+//   - セルの値と完全一致する場合は加点し、一致したセルが多いほど僅かに加点します。
+func (doc *workbookIndexDoc) match(terms []string, maxMatches int) (WorkbookHit, bool) {
+	found := make([]bool, len(terms))
+	hit := WorkbookHit{Matches: make([]WorkbookMatch, 0)}
+	best := 0.0
+	for _, cell := range doc.cells {
+		matched := 0
+		for i, term := range terms {
+			if strings.Contains(cell.folded, term) {
+				found[i] = true
+				matched += utf8.RuneCountInString(term)
+			}
+		}
+		if matched == 0 {
+			continue
+		}
+
+		hit.MatchCount++
+		if len(hit.Matches) < maxMatches {
+			hit.Matches = append(hit.Matches, WorkbookMatch{Sheet: cell.sheet, Ref: cell.ref, Text: cell.text})
+		}
+		cellLen := utf8.RuneCountInString(cell.folded)
+		score := 50 * float64(min(matched, cellLen)) / float64(cellLen)
+		if cell.folded == strings.Join(terms, " ") {
+			score += 100
+		}
+		best = max(best, score)
+	}
+	if !slices.Contains(found, false) {
+		hit.Score = best + float64(min(hit.MatchCount, 10))
+		return hit, true
+	}
+	return WorkbookHit{}, false
+}
@@ -1,7 +1,12 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"runtime"
+	"slices"
+	"sync"
 )
 
 // DecideNumWorkers は要素数とシステムリソースに基づいて最適なワーカー数を決定する
@@ -33,3 +38,140 @@ func DecideNumWorkers(itemCount int) int {
 
 	return numWorkers
 }
+
+// ParallelOption は ParallelMap の動作設定用の関数型です
+type ParallelOption func(*parallelConfig)
+
+// parallelConfig は ParallelMap の動作設定を保持します
+type parallelConfig struct {
+	// ordered は結果を入力順に並べるかどうか
+	ordered bool
+}
+
+// WithOrdered は ParallelMap の結果を入力順に並べるオプションです
+func WithOrdered() ParallelOption {
+	return func(c *parallelConfig) {
+		c.ordered = true
+	}
+}
+
+// ItemError は ParallelMap の要素毎のエラーです
+type ItemError struct {
+	// Index はエラーとなった要素の入力インデックス
+	Index int
+
+	// Err は要素の処理で発生したエラー
+	Err error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// parallelResult はワーカーから収集する要素毎の処理結果です
+type parallelResult[R any] struct {
+	index  int
+	result R
+	err    error
+}
+
+// ParallelMap は items の各要素に fn を並列に適用します。
+//   - ワーカー数は DecideNumWorkers で決定します。
+//   - ctx がキャンセルされると未処理の要素の処理を中止し、ctx.Err() をエラーに含めます。
+//   - fn のエラーは要素毎に *ItemError として errors.Join で集約し、その要素は結果に含めません。
+//   - WithOrdered を指定した場合は結果を入力順に、指定しない場合は完了順に返します。
+func ParallelMap[T, R any](
+	ctx context.Context,
+	items []T,
+	fn func(context.Context, T) (R, error),
+	opts ...ParallelOption) ([]R, error) {
+
+	// オプションの設定
+	config := &parallelConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	// 要素数が0の場合は空配列を返す
+	itemCount := len(items)
+	if itemCount == 0 {
+		return []R{}, ctx.Err()
+	}
+
+	// ワーカーグループとチャンネルを設定
+	numWorkers := DecideNumWorkers(itemCount)
+	jobs := make(chan int)
+	results := make(chan parallelResult[R], numWorkers)
+	var wg sync.WaitGroup
+
+	// ワーカーを起動
+	for range numWorkers {
+		wg.Go(func() {
+			for idx := range jobs {
+				// キャンセル済みの場合は処理しない
+				if ctx.Err() != nil {
+					continue
+				}
+				result, err := fn(ctx, items[idx])
+				results <- parallelResult[R]{index: idx, result: result, err: err}
+			}
+		})
+	}
+
+	// ジョブの投入（キャンセル時は投入を中止）
+	go func() {
+		defer close(jobs)
+		for idx := range items {
+			select {
+			case jobs <- idx:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// ワーカーの完了を待つ
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// 結果を収集
+	var errs []error
+	var collected []parallelResult[R]
+	if config.ordered {
+		collected = make([]parallelResult[R], 0, itemCount)
+	}
+	out := make([]R, 0, itemCount)
+	for r := range results {
+		if r.err != nil {
+			errs = append(errs, &ItemError{Index: r.index, Err: r.err})
+			continue
+		}
+		if config.ordered {
+			collected = append(collected, r)
+		} else {
+			out = append(out, r.result)
+		}
+	}
+
+	// 入力順に並べ替え
+	if config.ordered {
+		slices.SortFunc(collected, func(a, b parallelResult[R]) int {
+			return a.index - b.index
+		})
+		for _, r := range collected {
+			out = append(out, r.result)
+		}
+	}
+
+	// キャンセルされた場合はコンテキストのエラーを先頭にする
+	if err := ctx.Err(); err != nil {
+		errs = append([]error{err}, errs...)
+	}
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,450 @@
+package core
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ErrWorkbookInvalid は xlsx ファイルとして読み込めない場合のエラーです
+var ErrWorkbookInvalid = errors.New("invalid xlsx workbook")
+
+// xlsxMaxPartSize は展開後の1パーツ（XML）の最大サイズ、圧縮爆弾対策です
+const xlsxMaxPartSize = 64 << 20
+
+// xlsxMaxRows と xlsxMaxColumns は Excel のシートの最大行数・最大列数（XFD）です
+const (
+	xlsxMaxRows    = 1_048_576
+	xlsxMaxColumns = 16_384
+)
+
+// xlsxMaxCells はブック全体で読み込むセルの最大数、これを超えるセルは読み飛ばします
+const xlsxMaxCells = 1_000_000
+
+// WorkbookCell は値のあるセルです
+type WorkbookCell struct {
+	// Ref はセル番地（例: "B3"）
+	Ref string
+
+	// Row は行番号（1始まり）
+	Row int
+
+	// Column は列番号（1始まり）
+	Column int
+
+	// Text はセルの値の文字列（数値は書式を適用しない値、真偽値は TRUE/FALSE）
+	Text string
+}
+
+// WorkbookSheet はワークシートです
+type WorkbookSheet struct {
+	// Name はシート名
+	Name string
+
+	// Hidden は非表示のシートかどうか
+	Hidden bool
+
+	// Rows は値のある最大の行番号
+	Rows int
+
+	// Columns は値のある最大の列番号
+	Columns int
+
+	// Cells は値のあるセル（行、列の順）
+	Cells []WorkbookCell
+}
+
+// Workbook は xlsx ファイルから読み込んだシートとセルの値です
+type Workbook struct {
+	// Sheets はブック内の順序のワークシート
+	Sheets []WorkbookSheet
+
+	// Truncated は xlsxMaxCells を超えたためセルを読み飛ばしたかどうか
+	Truncated bool
+}
+
+// ReadWorkbook は xlsx ファイルのシート名とセルの値を読み込みます。
+//   - 外部ライブラリを使わず、zip と XML を直接読み込みます。
+//   - 数式のセルは最後に計算された値を返します。
+//   - グラフシートなどワークシート以外のシートは除外します。
+func ReadWorkbook(filename string) (*Workbook, error) {
+	r, err := zip.OpenReader(filename)
+	if err != nil {
+		if errors.Is(err, zip.ErrFormat) {
+			return nil, fmt.Errorf("%w: %v", ErrWorkbookInvalid, err)
+		}
+		return nil, err
+	}
+	defer r.Close()
+
+	parts := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		parts[strings.TrimPrefix(f.Name, "/")] = f
+	}
+
+	// シートの一覧と実体のパス
+	sheets, err := readXlsxSheets(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 共有文字列
+	shared, err := readXlsxSharedStrings(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	wb := &Workbook{Sheets: make([]WorkbookSheet, 0, len(sheets))}
+	budget := xlsxMaxCells
+	for _, sheet := range sheets {
+		part, ok := parts[sheet.part]
+		if !ok {
+			continue
+		}
+		ws := WorkbookSheet{Name: sheet.name, Hidden: sheet.hidden}
+		if err := readXlsxSheetCells(part, shared, &ws, &budget); err != nil {
+			return nil, fmt.Errorf("sheet %q: %w", sheet.name, err)
+		}
+		if budget < 0 {
+			wb.Truncated = true
+		}
+		wb.Sheets = append(wb.Sheets, ws)
+	}
+	return wb, nil
+}
+
+// xlsxSheetRef はブックに登録されたシートとその XML パーツのパスです
+type xlsxSheetRef struct {
+	name   string
+	hidden bool
+	part   string
+}
+
+// readXlsxSheets は xl/workbook.xml とそのリレーションからシートの一覧を返します
+func readXlsxSheets(parts map[string]*zip.File) ([]xlsxSheetRef, error) {
+	var workbook struct {
+		Sheets []struct {
+			Name  string `xml:"name,attr"`
+			State string `xml:"state,attr"`
+			RelId string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := decodeXlsxPart(parts, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+
+	var rels struct {
+		Relationships []struct {
+			Id     string `xml:"Id,attr"`
+			Type   string `xml:"Type,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodeXlsxPart(parts, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		if !strings.HasSuffix(rel.Type, "/worksheet") {
+			continue
+		}
+		// 絶対パスはパッケージのルートから、相対パスは xl/ から解決
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.Id] = strings.TrimPrefix(path.Clean(rel.Target), "/")
+		} else {
+			targets[rel.Id] = path.Join("xl", rel.Target)
+		}
+	}
+
+	sheets := make([]xlsxSheetRef, 0, len(workbook.Sheets))
+	for _, sheet := range workbook.Sheets {
+		part, ok := targets[sheet.RelId]
+		if !ok {
+			continue
+		}
+		sheets = append(sheets, xlsxSheetRef{
+			name:   sheet.Name,
+			hidden: sheet.State == "hidden" || sheet.State == "veryHidden",
+			part:   part,
+		})
+	}
+	return sheets, nil
+}
+
+// readXlsxSharedStrings は xl/sharedStrings.xml の共有文字列を返します
+// ふりがな（rPh）は値に含めません
+func readXlsxSharedStrings(parts map[string]*zip.File) ([]string, error) {
+	part, ok := parts["xl/sharedStrings.xml"]
+	if !ok {
+		return nil, nil
+	}
+	rc, err := openXlsxPart(part)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	shared := make([]string, 0)
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return shared, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: sharedStrings.xml: %v", ErrWorkbookInvalid, err)
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "si" {
+			text, err := readXlsxRichText(dec)
+			if err != nil {
+				return nil, fmt.Errorf("%w: sharedStrings.xml: %v", ErrWorkbookInvalid, err)
+			}
+			shared = append(shared, text)
+		}
+	}
+}
+
+// readXlsxRichText は si・is 要素の終了までを読み、t 要素の文字列を連結して返します
+// ふりがな（rPh）の中の t 要素は除外します
+func readXlsxRichText(dec *xml.Decoder) (string, error) {
+	var b strings.Builder
+	depth, phonetic, inText := 1, 0, false
+	for depth > 0 {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch t.Name.Local {
+			case "rPh":
+				phonetic++
+			case "t":
+				inText = phonetic == 0
+			}
+		case xml.EndElement:
+			depth--
+			switch t.Name.Local {
+			case "rPh":
+				phonetic--
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				b.Write(t)
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// readXlsxSheetCells はワークシートの XML からセルの値を読み込みます
+// budget は読み込めるセルの残数で、超えた場合は負の値にして読み込みを打ち切ります
+func readXlsxSheetCells(part *zip.File, shared []string, ws *WorkbookSheet, budget *int) error {
+	rc, err := openXlsxPart(part)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	dec := xml.NewDecoder(rc)
+	row := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrWorkbookInvalid, err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "row":
+			// r 属性は省略されることがある
+			if r, err := strconv.Atoi(xlsxAttr(start, "r")); err == nil && r >= 1 && r <= xlsxMaxRows {
+				row = r
+			} else {
+				row++
+			}
+
+		case "c":
+			cell, err := readXlsxCell(dec, start, shared)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrWorkbookInvalid, err)
+			}
+			if cell.Text == "" {
+				continue
+			}
+			if *budget <= 0 {
+				*budget = -1
+				return nil
+			}
+			*budget--
+
+			if cell.Row == 0 {
+				cell.Row = row
+			}
+			if cell.Column == 0 {
+				cell.Column = 1
+				if n := len(ws.Cells); n > 0 && ws.Cells[n-1].Row == cell.Row {
+					cell.Column = ws.Cells[n-1].Column + 1
+				}
+			}
+			if cell.Ref == "" {
+				cell.Ref = XlsxCellRef(cell.Row, cell.Column)
+			}
+			ws.Rows = max(ws.Rows, cell.Row)
+			ws.Columns = max(ws.Columns, cell.Column)
+			ws.Cells = append(ws.Cells, cell)
+		}
+	}
+}
+
+// readXlsxCell は c 要素の終了までを読み、セルの値を返します
+func readXlsxCell(dec *xml.Decoder, start xml.StartElement, shared []string) (WorkbookCell, error) {
+	cell := WorkbookCell{Ref: xlsxAttr(start, "r")}
+	if cell.Ref != "" {
+		// 不正な番地は省略された場合と同様に直前のセルから決める
+		if cell.Row, cell.Column = parseXlsxCellRef(cell.Ref); cell.Row == 0 {
+			cell.Ref = ""
+		}
+	}
+	cellType := xlsxAttr(start, "t")
+
+	var value, inline string
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return cell, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "v":
+				var v string
+				if err := dec.DecodeElement(&v, &t); err != nil {
+					return cell, err
+				}
+				value = v
+			case "is":
+				text, err := readXlsxRichText(dec)
+				if err != nil {
+					return cell, err
+				}
+				inline = text
+			default:
+				// 数式（f）などは読み飛ばす
+				if err := dec.Skip(); err != nil {
+					return cell, err
+				}
+			}
+		case xml.EndElement:
+			switch cellType {
+			case "s":
+				if i, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && i >= 0 && i < len(shared) {
+					cell.Text = shared[i]
+				}
+			case "inlineStr":
+				cell.Text = inline
+			case "b":
+				if strings.TrimSpace(value) == "1" {
+					cell.Text = "TRUE"
+				} else if value != "" {
+					cell.Text = "FALSE"
+				}
+			default:
+				// n（数値）、str（数式の文字列）、e（エラー）、d（日付）
+				cell.Text = value
+			}
+			return cell, nil
+		}
+	}
+}
+
+// XlsxCellRef は行番号と列番号（1始まり）からセル番地を返します
+func XlsxCellRef(row, column int) string {
+	var letters []byte
+	for n := column; n > 0; n = (n - 1) / 26 {
+		letters = append([]byte{byte('A' + (n-1)%26)}, letters...)
+	}
+	return string(letters) + strconv.Itoa(row)
+}
+
+// parseXlsxCellRef はセル番地（例: "AB12"）を行番号と列番号に変換します
+// 変換できない場合、及び Excel のシートの範囲（A1〜XFD1048576）外の場合は 0 を返します
+func parseXlsxCellRef(ref string) (row, column int) {
+	i := 0
+	for ; i < len(ref); i++ {
+		c := ref[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		// 列は最大3文字（XFD）
+		if i >= 3 {
+			return 0, 0
+		}
+		column = column*26 + int(c-'A'+1)
+	}
+	row, err := strconv.Atoi(ref[i:])
+	if err != nil || i == 0 || column > xlsxMaxColumns || row < 1 || row > xlsxMaxRows {
+		return 0, 0
+	}
+	return row, column
+}
+
+// xlsxAttr は要素の属性値を名前空間を無視して返します
+func xlsxAttr(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// decodeXlsxPart はパーツの XML を v にデコードします
+func decodeXlsxPart(parts map[string]*zip.File, name string, v any) error {
+	part, ok := parts[name]
+	if !ok {
+		return fmt.Errorf("%w: %s not found", ErrWorkbookInvalid, name)
+	}
+	rc, err := openXlsxPart(part)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrWorkbookInvalid, name, err)
+	}
+	return nil
+}
+
+// openXlsxPart はパーツを展開して読み込みます（xlsxMaxPartSize で打ち切り）
+func openXlsxPart(part *zip.File) (io.ReadCloser, error) {
+	if part.UncompressedSize64 > xlsxMaxPartSize {
+		return nil, fmt.Errorf("%w: %s is too large", ErrWorkbookInvalid, part.Name)
+	}
+	rc, err := part.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrWorkbookInvalid, part.Name, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, xlsxMaxPartSize), rc}, nil
+}
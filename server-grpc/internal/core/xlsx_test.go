@@ -0,0 +1,208 @@
+package core
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// writeXlsx はパーツ名と XML の内容から xlsx ファイルを作成します
+func writeXlsx(t *testing.T, parts map[string]string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "book.xlsx")
+	file, err := os.Create(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	w := zip.NewWriter(file)
+	for name, content := range parts {
+		part, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := part.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+const testXlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets>
+<sheet name="見積" sheetId="1" r:id="rId1"/>
+<sheet name="グラフ" sheetId="2" r:id="rId2"/>
+<sheet name="控え" sheetId="3" state="hidden" r:id="rId3"/>
+</sheets>
+</workbook>`
+
+const testXlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/chartsheet" Target="chartsheets/sheet1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet2.xml"/>
+</Relationships>`
+
+const testXlsxSharedStrings = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="3" uniqueCount="3">
+<si><t>工事名</t></si>
+<si><r><t>豊田</t></r><r><rPr><b/></rPr><t>建設</t></r><rPh sb="0" eb="2"><t>トヨタ</t></rPh></si>
+<si><t xml:space="preserve"> 金額 </t></si>
+</sst>`
+
+// testXlsxSheet1 は共有文字列、インライン文字列、数値、真偽値、数式のセルと
+// r 属性のない行・セルを含むワークシートです
+const testXlsxSheet1 = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3"><v>1200.5</v></c></row>
+<row><c t="inlineStr"><is><t>手入力</t></is></c><c t="b"><v>1</v></c><c r="E4" t="str"><f>A1&amp;B1</f><v>工事名豊田建設</v></c></row>
+<row r="6"><c r="A6" t="s"><v>99</v></c><c r="B6"/></row>
+</sheetData>
+</worksheet>`
+
+const testXlsxSheet2 = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<sheetData><row r="2"><c r="B2" t="inlineStr"><is><t>非表示</t></is></c></row></sheetData>
+</worksheet>`
+
+func TestReadWorkbook(t *testing.T) {
+	filename := writeXlsx(t, map[string]string{
+		"xl/workbook.xml":            testXlsxWorkbook,
+		"xl/_rels/workbook.xml.rels": testXlsxRels,
+		"xl/sharedStrings.xml":       testXlsxSharedStrings,
+		"xl/worksheets/sheet1.xml":   testXlsxSheet1,
+		"xl/worksheets/sheet2.xml":   testXlsxSheet2,
+	})
+
+	wb, err := ReadWorkbook(filename)
+	if err != nil {
+		t.Fatalf("ReadWorkbook() error = %v", err)
+	}
+
+	// グラフシートは除外
+	names := make([]string, 0, len(wb.Sheets))
+	for _, sheet := range wb.Sheets {
+		names = append(names, sheet.Name)
+	}
+	if !slices.Equal(names, []string{"見積", "控え"}) {
+		t.Fatalf("sheets = %v", names)
+	}
+
+	// 範囲外の共有文字列と値のないセルは除外
+	sheet := wb.Sheets[0]
+	want := []WorkbookCell{
+		{Ref: "A1", Row: 1, Column: 1, Text: "工事名"},
+		{Ref: "B1", Row: 1, Column: 2, Text: "豊田建設"},
+		{Ref: "A3", Row: 3, Column: 1, Text: " 金額 "},
+		{Ref: "C3", Row: 3, Column: 3, Text: "1200.5"},
+		{Ref: "A4", Row: 4, Column: 1, Text: "手入力"},
+		{Ref: "B4", Row: 4, Column: 2, Text: "TRUE"},
+		{Ref: "E4", Row: 4, Column: 5, Text: "工事名豊田建設"},
+	}
+	if !slices.Equal(sheet.Cells, want) {
+		t.Errorf("cells = %+v\nwant %+v", sheet.Cells, want)
+	}
+	if sheet.Rows != 4 || sheet.Columns != 5 || sheet.Hidden {
+		t.Errorf("Rows, Columns, Hidden = %d, %d, %v", sheet.Rows, sheet.Columns, sheet.Hidden)
+	}
+
+	hidden := wb.Sheets[1]
+	if !hidden.Hidden || len(hidden.Cells) != 1 || hidden.Cells[0].Ref != "B2" {
+		t.Errorf("hidden sheet = %+v", hidden)
+	}
+}
+
+func TestParseXlsxCellRef(t *testing.T) {
+	tests := []struct {
+		ref    string
+		row    int
+		column int
+	}{
+		{"A1", 1, 1},
+		{"ab12", 12, 28},
+		{"XFD1048576", 1048576, 16384},
+		// シートの範囲外
+		{"XFE1", 0, 0},
+		{"A1048577", 0, 0},
+		{"A0", 0, 0},
+		{"A-1", 0, 0},
+		// 4文字以上の列（桁あふれするものを含む）
+		{"AAAA1", 0, 0},
+		{"AAAAAAAAAAAAAAAA1", 0, 0},
+		// 不正な形式
+		{"", 0, 0},
+		{"12", 0, 0},
+		{"A", 0, 0},
+		{"A1B", 0, 0},
+	}
+	for _, tt := range tests {
+		row, column := parseXlsxCellRef(tt.ref)
+		if row != tt.row || column != tt.column {
+			t.Errorf("parseXlsxCellRef(%q) = %d, %d, want %d, %d", tt.ref, row, column, tt.row, tt.column)
+		}
+	}
+}
+
+// 範囲外の番地は省略された場合と同様に直前のセルの隣とする
+func TestReadWorkbookOutOfRangeRef(t *testing.T) {
+	filename := writeXlsx(t, map[string]string{
+		"xl/workbook.xml":            testXlsxWorkbook,
+		"xl/_rels/workbook.xml.rels": testXlsxRels,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+<row r="2"><c r="B2"><v>1</v></c><c r="AAAAAAAAAAAAAAAA2"><v>2</v></c></row>
+<row r="99999999999"><c r="A0"><v>3</v></c></row>
+</sheetData></worksheet>`,
+	})
+
+	wb, err := ReadWorkbook(filename)
+	if err != nil {
+		t.Fatalf("ReadWorkbook() error = %v", err)
+	}
+	want := []WorkbookCell{
+		{Ref: "B2", Row: 2, Column: 2, Text: "1"},
+		{Ref: "C2", Row: 2, Column: 3, Text: "2"},
+		{Ref: "A3", Row: 3, Column: 1, Text: "3"},
+	}
+	if got := wb.Sheets[0].Cells; !slices.Equal(got, want) {
+		t.Errorf("cells = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestReadWorkbookInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts map[string]string
+	}{
+		{"missing workbook", map[string]string{"xl/_rels/workbook.xml.rels": testXlsxRels}},
+		{"broken sheet", map[string]string{
+			"xl/workbook.xml":            testXlsxWorkbook,
+			"xl/_rels/workbook.xml.rels": testXlsxRels,
+			"xl/worksheets/sheet1.xml":   `<worksheet><sheetData><row><c r="A1"><v>1</c></row>`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadWorkbook(writeXlsx(t, tt.parts)); err == nil {
+				t.Fatal("ReadWorkbook() error = nil")
+			}
+		})
+	}
+
+	// zip でないファイル
+	filename := filepath.Join(t.TempDir(), "text.xlsx")
+	if err := os.WriteFile(filename, []byte("not a zip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadWorkbook(filename); err == nil {
+		t.Fatal("ReadWorkbook(text) error = nil")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -49,8 +50,8 @@ func (m *Company) ParseFrom(pathistFolder ...string) error {
 	folder := filepath.Join(pathistFolder...)
 
 	// 引数 target からフォルダー名取得とチェック
-	// "[0-9] [会社名]"の解析
-	folderName := filepath.Base(folder)
+	// "[0-9] [会社名]"の解析（全角数字・全角スペース・NFD の名前も統一してから解析）
+	folderName := core.NormalizeName(filepath.Base(folder))
 	if len(folderName) < 3 {
 		return errors.New("targetのファイル名形式が無効です（長さが短い）")
 	} else if folderName[1] != ' ' {
@@ -107,11 +108,14 @@ func (m *Company) ImportFrom(src *Company) error {
 	}
 
 	// 新しいパラメータを元に管理フォルダーパスを生成
-	newPathistFolder := GenerateCompanyPathistFolder(
+	newPathistFolder, err := GenerateCompanyPathistFolder(
 		filepath.Dir(m.GetPathistFolder()),
 		src.GetCategoryIndex(),
 		src.GetShortName(),
 	)
+	if err != nil {
+		return err
+	}
 
 	// ファイル名変更の必要がある場合は管理フォルダー名を更新
 	if newPathistFolder != m.GetPathistFolder() {
@@ -129,8 +133,29 @@ func (m *Company) ImportFrom(src *Company) error {
 // GenerateCompanyTarget はパラメータをもとに管理フォルダー名変更します
 // base: 基本パス(原則として　O:/.../1 会社 などの親フォルダー)
 // idx: カテゴリーインデックス
-// name: 省略会社名
-func GenerateCompanyPathistFolder(base string, idx int32, name string) string {
-	folderName := strconv.Itoa(int(idx)) + " " + name
-	return filepath.Join(base, folderName)
+// name: 省略会社名（フォルダー名は core.NormalizeName で統一します）
+//
+// フォルダー名が Windows・macOS・Linux のいずれかで使用できない場合は core.ErrUnsafeFilename を返します
+func GenerateCompanyPathistFolder(base string, idx int32, name string) (string, error) {
+	folderName := core.NormalizeName(strconv.Itoa(int(idx)) + " " + name)
+	if err := core.ValidateFilename(folderName); err != nil {
+		return "", err
+	}
+	return filepath.Join(base, folderName), nil
+}
+
+// TemplateData は会社フォルダーのテンプレートのファイル名・フォルダー名に埋め込む値を返します
+//   - FolderName: 会社フォルダー名
+//   - ShortName, LongName: 省略会社名、正式名称
+//   - Category, CategoryIndex: 業種カテゴリーのラベルと番号
+//   - Today: 作成日（"2006-01-02" 形式）
+func (m *Company) TemplateData() core.TemplateData {
+	return core.TemplateData{
+		"FolderName":    filepath.Base(m.GetPathistFolder()),
+		"ShortName":     m.GetShortName(),
+		"LongName":      m.GetPersistLongName(),
+		"Category":      CompanyCategoryMap[int(m.GetCategoryIndex())],
+		"CategoryIndex": strconv.Itoa(int(m.GetCategoryIndex())),
+		"Today":         time.Now().Format("2006-01-02"),
+	}
 }
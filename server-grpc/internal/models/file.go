@@ -2,7 +2,10 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	grpcv1 "server-grpc/gen/grpc/v1"
 	"server-grpc/internal/core"
@@ -27,20 +30,41 @@ func NewFile() *File {
 }
 
 // ParseFromPath は指定されたフルパスからファイル情報を解析して設定します
-func (m *File) ParseFrom(pathistFolder string) error {
+// シンボリックリンクの場合はリンク自身のパスとリンク先を設定し、サイズ等はリンク先の情報を使用します
+// ID は root からの相対パス（スラッシュ区切り）から作成するため、サーバー上のルートの場所に依存しません
+func (m *File) ParseFrom(root, pathistFolder string) error {
 	var err error
 
-	// 絶対パスの正規化
-	normalized, err := core.NormalizeAbsPath(pathistFolder)
+	// ルートからの相対パス（リンクを解決する前のパス）
+	cleaned := filepath.Clean(pathistFolder)
+	relPath, err := filepath.Rel(root, cleaned)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%s: %w", pathistFolder, core.ErrPathOutsideRoot)
+	}
+
+	// 絶対パスの正規化（リンク自身を扱うため親フォルダーのみ解決）
+	normalizedDir, err := core.NormalizeAbsPath(filepath.Dir(cleaned))
 	if err != nil {
 		return err
 	}
+	normalized := filepath.Join(normalizedDir, filepath.Base(cleaned))
 
 	// ファイル情報の取得
-	osFi, err := os.Stat(normalized)
+	osLfi, err := os.Lstat(normalized)
 	if err != nil {
 		return err
 	}
+	osFi := osLfi
+	symlinkTarget := ""
+	if osLfi.Mode()&os.ModeSymlink != 0 {
+		if symlinkTarget, err = os.Readlink(normalized); err != nil {
+			return err
+		}
+		// リンク切れの場合はリンク自身の情報を使用
+		if target, err := os.Stat(normalized); err == nil {
+			osFi = target
+		}
+	}
 
 	// 最終更新時刻の取得
 	osModTime := osFi.ModTime()
@@ -49,13 +73,64 @@ func (m *File) ParseFrom(pathistFolder string) error {
 	}
 	modifiedTime := timestamppb.New(osModTime)
 
+	// 隠し属性・システム属性の取得
+	hidden, system := core.FileAttributesOf(normalized, osLfi)
+
 	// フィールドの設定
+	name := filepath.Base(normalized)
+	m.SetId(core.GenerateIdFromString(string(m.ProtoReflect().Descriptor().FullName()) + filepath.ToSlash(relPath)))
 	m.SetPathistFolder(normalized)
-	m.SetSize(osFi.Size())
+	m.SetName(name)
+	m.SetIsDir(osFi.IsDir())
+	m.SetSymlinkTarget(symlinkTarget)
+	m.SetHidden(hidden)
+	m.SetSystem(system)
 	m.SetModifiedTime(modifiedTime)
+
+	if osFi.IsDir() {
+		// フォルダーは直下の項目数を設定（内部管理用は除外）
+		m.SetSize(osFi.Size())
+		m.SetChildCount(countChildren(normalized))
+		return nil
+	}
+
+	// ドットで始まるだけの名前（.gitignore 等）は拡張子なし
+	if ext := filepath.Ext(name); ext != name {
+		m.SetExtension(strings.ToLower(ext))
+	}
+	m.SetSize(osFi.Size())
+	if osFi.Mode().IsRegular() {
+		// MIMEタイプは内容から判定し、読み込めない場合は拡張子から判定
+		mimeType, err := core.DetectMimeType(normalized)
+		if err != nil {
+			mimeType = core.MimeTypeByExtension(normalized)
+		}
+		m.SetMimeType(mimeType)
+	}
 	return nil
 }
 
+// countChildren はフォルダー直下の項目数を返します（内部管理用は除外）
+func countChildren(folder string) int32 {
+	dir, err := os.Open(folder)
+	if err != nil {
+		return 0
+	}
+	defer dir.Close()
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return 0
+	}
+	count := int32(0)
+	for _, name := range names {
+		if !core.FilenameIsPathistSystem(name) {
+			count++
+		}
+	}
+	return count
+}
+
 // GetPersistData は永続化対象のオブジェクトを取得します
 // Persistable インターフェースの実装
 func (m *File) GetPersistJsonMap() (map[string]any, error) {
@@ -45,8 +45,8 @@ func (m *Koji) ParseFrom(pathistFolder string) error {
 		locationName string
 	)
 
-	// フォルダー名を取得
-	foldername := core.GetBaseName(pathistFolder)
+	// フォルダー名を取得（全角数字・全角スペース・NFD の名前も統一してから解析）
+	foldername := core.NormalizeName(core.GetBaseName(pathistFolder))
 
 	// ファイル名から工事開始日の取得と日付除外文字列の取得
 	dateRemoved, err := ParseTimestamp(foldername, start)
@@ -116,28 +116,46 @@ func (m *Koji) ImportFrom(src *Koji) (*Koji, error) {
 }
 
 // UpdateFolderPath は工事フォルダー名を更新します
+// フォルダー名を変更する必要がある場合は true を返します
+// 新しいフォルダー名が Windows・macOS・Linux のいずれかで使用できない場合は、変更前に core.ErrUnsafeFilename を返します
 //
 // # Id 及び Target の情報は無視されます
 //
 // TODO: 不完全です、実際にはまだ更新処理していません
-func (m *Koji) UpdateFolderPath(src *Koji) bool {
+func (m *Koji) UpdateFolderPath(src *Koji) (bool, error) {
 	if src == nil {
-		return false
+		return false, nil
 	}
 
 	// 開始日が無効な場合の早期リターン
 	start := &Timestamp{Timestamp: src.GetStart()}
 	if !start.IsValid() {
-		return false
+		return false, nil
 	}
 
-	startString, err := start.FormatTime("2006-0102")
+	dir := filepath.Dir(m.GetPathistFolder())
+	if dir == "." {
+		return false, nil
+	}
+	prevTarget := m.GetPathistFolder()
+	folder, err := GenerateKojiPathistFolder(dir, start, src.GetCompanyName(), src.GetLocationName())
 	if err != nil {
-		return false
+		return false, err
 	}
+	src.SetPathistFolder(folder)
 
-	companyName := src.GetCompanyName()
-	locationName := src.GetLocationName()
+	return prevTarget != folder, nil
+}
+
+// GenerateKojiPathistFolder はパラメータをもとに工事フォルダーのパスを生成します
+// base: 基本パス(原則として　O:/.../2 工事 などの親フォルダー)
+// フォルダー名は "YYYY-MMDD 会社名 現場名" 形式で、現場名が空の場合は "YYYY-MMDD 会社名" とします
+// 名前は core.NormalizeName で統一し、Windows・macOS・Linux のいずれかで使用できない場合は core.ErrUnsafeFilename を返します
+func GenerateKojiPathistFolder(base string, start *Timestamp, companyName, locationName string) (string, error) {
+	startString, err := start.FormatTime("2006-0102")
+	if err != nil {
+		return "", err
+	}
 
 	// 事前に容量を計算してstrings.Builderを初期化（再アロケーション回避）
 	// 日付(9文字) + スペース(1文字) + 会社名 + スペース(1文字) + 現場名 の概算
@@ -150,16 +168,34 @@ func (m *Koji) UpdateFolderPath(src *Koji) bool {
 	// 会社名と現場名を追加
 	builder.WriteByte(' ')
 	builder.WriteString(companyName)
-	builder.WriteByte(' ')
-	builder.WriteString(locationName)
+	if locationName != "" {
+		builder.WriteByte(' ')
+		builder.WriteString(locationName)
+	}
 
-	dir := filepath.Dir(m.GetPathistFolder())
-	if dir == "." {
-		return false
+	folderName := core.NormalizeName(builder.String())
+	if err := core.ValidateFilename(folderName); err != nil {
+		return "", err
 	}
-	prevTarget := m.GetPathistFolder()
-	target := builder.String()
-	src.SetPathistFolder(filepath.Join(dir, target))
+	return filepath.Join(base, folderName), nil
+}
 
-	return prevTarget != target
+// TemplateData は工事フォルダーのテンプレートのファイル名・フォルダー名に埋め込む値を返します
+//   - FolderName: 工事フォルダー名
+//   - Start, StartDate, StartYear: 工事開始日（"2006-0102", "2006-01-02", "2006" 形式）
+//   - CompanyName, LocationName: 会社名、現場名
+//   - Today: 作成日（"2006-01-02" 形式）
+func (m *Koji) TemplateData() core.TemplateData {
+	data := core.TemplateData{
+		"FolderName":   filepath.Base(m.GetPathistFolder()),
+		"CompanyName":  m.GetCompanyName(),
+		"LocationName": m.GetLocationName(),
+		"Today":        time.Now().Format("2006-01-02"),
+	}
+	if start := (&Timestamp{Timestamp: m.GetStart()}); m.HasStart() && start.IsValid() {
+		data["Start"] = start.AsTime().Format("2006-0102")
+		data["StartDate"] = start.AsTime().Format("2006-01-02")
+		data["StartYear"] = start.AsTime().Format("2006")
+	}
+	return data
 }
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+
+	grpcv1 "server-grpc/gen/grpc/v1"
+	grpcv1connect "server-grpc/gen/grpc/v1/grpcv1connect"
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// changesDefaultLimit は GetChanges で limit 未指定時に返す最大件数
+const changesDefaultLimit = 1000
+
+// changesMaxLimit は GetChanges で一度に返す最大件数
+const changesMaxLimit = 10000
+
+// ChangeService は変更ジャーナルを公開するサービスです。
+// 切断中に発生した変更をカーソル指定で取得できます。
+type ChangeService struct {
+	// Embed the unimplemented handler for forward compatibility
+	grpcv1connect.UnimplementedChangeServiceHandler
+
+	// services は任意のgrpcサービスハンドラーへの参照
+	services *Services
+
+	// serviceFolder はファイル変更を監視するルートフォルダー
+	serviceFolder string
+
+	// watcher はファイルシステム監視オブジェクト
+	watcher *core.Watcher
+}
+
+// Start は ChangeService を初期化して開始します
+func (srv *ChangeService) Start(services *Services, options *map[string]string) error {
+	srv.services = services
+
+	// 監視フォルダーの設定
+	optFolder, exists := (*options)["FileServiceTarget"]
+	if !exists {
+		return errors.New("FileServiceTarget option is required")
+	}
+	folder, err := core.NormalizeAbsPath(optFolder)
+	if err != nil {
+		return err
+	}
+	srv.serviceFolder = folder
+
+	// watcherの開始
+	optMaxDepth, exists := (*options)["ChangeWatcherMaxDepth"]
+	if !exists {
+		optMaxDepth = "3"
+	}
+	maxDepth, err := strconv.Atoi(optMaxDepth)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := core.NewWatcher(srv.serviceFolder, maxDepth)
+	if err != nil {
+		return err
+	}
+	srv.watcher = watcher
+
+	if err = srv.watcher.Start(); err != nil {
+		return err
+	}
+
+	// ゴルーチンで監視イベントを処理
+	go srv.consumeWatcherEvents()
+
+	return nil
+}
+
+func (srv *ChangeService) Cleanup() {
+	if srv.watcher != nil {
+		srv.watcher.Close()
+	}
+}
+
+// consumeWatcherEvents はファイルシステム監視イベントをジャーナルに記録します
+// 会社・工事フォルダー配下の変更も含め、1つのイベントはここで1回だけ記録します
+func (srv *ChangeService) consumeWatcherEvents() {
+	for {
+		select {
+		case event, ok := <-srv.watcher.Events():
+			if !ok {
+				return
+			}
+			kind, entityId := srv.services.changeEntityOf(event.Name)
+			srv.services.RecordChange(kind, event, entityId)
+
+		case err, ok := <-srv.watcher.Errors():
+			if !ok {
+				return
+			}
+			log.Printf("ChangeService: File system watcher error: %v", err)
+		}
+	}
+}
+
+// GetChanges はカーソル以降の変更一覧を取得します
+// gRPCサービスの実装です
+func (srv *ChangeService) GetChanges(
+	_ context.Context, req *grpcv1.GetChangesRequest) (
+	*grpcv1.GetChangesResponse, error) {
+
+	// ジャーナルが無効な場合
+	if srv.services == nil || srv.services.Journal == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("change journal is not available"))
+	}
+	journal := srv.services.Journal
+
+	// リクエスト情報の取得
+	since, err := core.ParseJournalCursor(req.GetSinceCursor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid since_cursor"))
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = changesDefaultLimit
+	}
+	limit = min(limit, changesMaxLimit)
+
+	// ジャーナルから変更を取得
+	entries, truncated, err := journal.ReadSince(since, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// 欠落がある場合は全件再取得が必要なため変更一覧は返さない
+	if truncated {
+		entries = nil
+	}
+
+	// レスポンスの作成
+	res := grpcv1.GetChangesResponse_builder{}.Build()
+	changes := make([]*grpcv1.ChangeEntry, 0, len(entries))
+	next := since
+	for _, entry := range entries {
+		changes = append(changes, grpcv1.ChangeEntry_builder{
+			Seq:           entry.Seq,
+			Time:          timestamppb.New(entry.Time),
+			Kind:          entry.Kind,
+			Op:            entry.Op,
+			PathistFolder: entry.Path,
+			EntityId:      entry.EntityId,
+		}.Build())
+		next = entry.Seq
+	}
+
+	// 再取得が必要な場合は最新位置のカーソルを返す
+	if truncated {
+		next = journal.LastSeq()
+	}
+
+	res.SetChanges(changes)
+	res.SetNextCursor(core.FormatJournalCursor(next))
+	res.SetResetRequired(truncated)
+	return res, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"log"
+	"maps"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	grpcv1 "server-grpc/gen/grpc/v1"
 	grpcv1connect "server-grpc/gen/grpc/v1/grpcv1connect"
@@ -24,12 +26,18 @@ type CompanyService struct {
 	// Embed the unimplemented handler for forward compatibility
 	grpcv1connect.UnimplementedCompanyServiceHandler
 
-	// companies は会社データのキャッシュマップ
-	companies map[string]*models.Company
+	// companies は会社データのキャッシュ、書き込み時コピーのスナップショットで保持
+	companies *core.SnapshotStore[*models.Company]
 
 	// serviceFolder はこのサービスが管理する会社データのルートフォルダー
 	serviceFolder string
 
+	// templateFolder は新しい会社フォルダーの作成時にコピーするテンプレートフォルダー
+	templateFolder string
+
+	// archiveFolder はアーカイブした会社フォルダーの移動先
+	archiveFolder string
+
 	// watcher はファイルシステム監視オブジェクト
 	watcher *core.Watcher
 }
@@ -52,9 +60,17 @@ func (srv *CompanyService) Start(services *Services, options *map[string]string)
 		return err
 	}
 	srv.serviceFolder = folder
+	srv.templateFolder = (*options)["CompanyTemplateFolder"]
+
+	// アーカイブフォルダーの設定、未指定の場合はサービスフォルダーの隣に作成
+	optArchive, exists := (*options)["CompanyArchiveFolder"]
+	if !exists {
+		optArchive = filepath.Join(filepath.Dir(srv.serviceFolder), core.PathistSystemPrefix+"-archive", filepath.Base(srv.serviceFolder))
+	}
+	srv.archiveFolder = filepath.Clean(optArchive)
 
 	// companiesの情報を取得
-	srv.companies = map[string]*models.Company{}
+	srv.companies = core.NewSnapshotStore[*models.Company]()
 	if err = srv.UpdateCompanies(); err != nil {
 		return err
 	}
@@ -97,6 +113,10 @@ func (srv *CompanyService) consumeWatcherEvents() {
 			if !ok {
 				return
 			}
+			// Pathist の内部管理用ファイル（ロックファイル等）の変更は無視
+			if core.PathIsPathistSystem(event.Name) {
+				continue
+			}
 			log.Printf("CompanyService: File system event: %s", event)
 
 			// 会社キャッシュの更新
@@ -113,65 +133,117 @@ func (srv *CompanyService) consumeWatcherEvents() {
 	}
 }
 
+// companyIdFromPath はサービスフォルダー配下のパスから会社IDを取得します
+// 会社フォルダーとして解析できない場合は空文字列を返します
+func (srv *CompanyService) companyIdFromPath(path string) string {
+	rel, err := filepath.Rel(srv.serviceFolder, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+
+	// サービスフォルダー直下のフォルダー名を会社フォルダーとして解析
+	folderName := strings.Split(filepath.ToSlash(rel), "/")[0]
+	company := models.NewCompany()
+	if err := company.ParseFrom(srv.serviceFolder, folderName); err != nil {
+		return ""
+	}
+	return company.GetId()
+}
+
 // UpdateCompanies 会社のキャッシュデータを更新します
+// 走査中に UpdateNewCompany 等でキャッシュが更新された場合は走査し直します
 func (srv *CompanyService) UpdateCompanies() error {
+	_, err := srv.companies.Rebuild(srv.scanCompanies)
+	return err
+}
+
+// scanCompanies はサービスフォルダーを走査して会社のキャッシュデータを作成します
+func (srv *CompanyService) scanCompanies() (map[string]*models.Company, error) {
 	// ファイルシステムから会社フォルダー一覧を取得
 	entries, err := os.ReadDir(srv.serviceFolder)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// キャッシュデータの初期化
-	srv.companies = make(map[string]*models.Company, len(entries))
+	// 新しいキャッシュデータの作成
+	companies := make(map[string]*models.Company, len(entries))
 
 	// 全てのCompanyインスタンスを作成
 	for _, entry := range entries {
 		// Companyインスタンスの作成と初期化
 		company := models.NewCompany()
 		if err := company.ParseFrom(srv.serviceFolder, entry.Name()); err == nil {
-			srv.companies[company.GetId()] = company
+			companies[company.GetId()] = company
 		}
 	}
 
 	// 会社の内部情報の取得
-	for _, company := range srv.companies {
+	for _, company := range companies {
 		// persist情報の読み込み
 		if err := company.Pathist.LoadPersists(); err != nil {
 			log.Printf("Failed to load persist info for company ShortName %s: %v", company.GetShortName(), err)
 		}
 	}
-	return nil
+	return companies, nil
 }
 
 // UpdateCompanyCache は指定 id のキャッシュ情報を新しい会社情報で更新します
 // prevId: 更新対象の会社ID、存在しない場合は追加
 // newCompany: 更新後の会社情報
+//
+// 会社フォルダー名を変更する場合はサービスフォルダーを、永続化ファイルの書き込みには会社フォルダーをロックします。
+// フォルダーの操作はキャッシュの更新（書き込みの直列化）の外で行います。
 func (srv *CompanyService) UpdateNewCompany(prevId string, newCompany *models.Company) (*models.Company, error) {
-	// Idから更新前の会社情報を取得
-	prevCompany, exist := srv.companies[prevId]
-
-	// キャッシュから削除
-	if exist {
-		delete(srv.companies, prevId)
-	}
+	const owner = "CompanyService.UpdateCompany"
+	prev, exist := srv.companies.Load().Get(prevId)
 
 	// 新しい会社情報の管理フォルダー名を生成
-	newTarget := models.GenerateCompanyPathistFolder(
+	newTarget, err := models.GenerateCompanyPathistFolder(
 		filepath.Dir(newCompany.GetPathistFolder()),
 		newCompany.GetCategoryIndex(),
 		newCompany.GetShortName())
+	if err != nil {
+		return nil, err
+	}
 
-	// 管理フォルダーの変更がある場合はフォルダー移動を実施
-	if exist && prevCompany.GetPathistFolder() != newCompany.GetPathistFolder() {
-		// 管理フォルダーの変更がある場合はフォルダー移動を実施
-		prevTarget := prevCompany.GetPathistFolder()
-		if err := os.Rename(prevTarget, newTarget); err != nil {
+	// 管理フォルダーの変更がある場合は親フォルダーのロックを取得してフォルダー移動を実施
+	persistFolder := newCompany.GetPathistFolder()
+	if exist {
+		persistFolder = prev.GetPathistFolder()
+	}
+	if exist && prev.GetPathistFolder() != newCompany.GetPathistFolder() {
+		locks, err := lockParentFolders(owner, prev.GetPathistFolder(), newTarget)
+		if err != nil {
 			return nil, err
 		}
+		defer releaseCompanyLocks(locks)
+		if err := os.Rename(prev.GetPathistFolder(), newTarget); err != nil {
+			return nil, err
+		}
+		persistFolder = newTarget
 	}
 
-	// 既存の情報を更新
-	srv.companies[newCompany.GetId()] = newCompany
+	// 永続化ファイルを書き込む会社フォルダーのロックを取得
+	lock, err := core.LockFolder(persistFolder, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseCompanyLocks(core.FolderLocks{lock})
+
+	// 書き込み時コピーでキャッシュを更新
+	var prevCompany *models.Company
+	_, err = srv.companies.Update(func(companies map[string]*models.Company) error {
+		// キャッシュから削除して新しい情報を登録
+		if prev, exist := companies[prevId]; exist {
+			delete(companies, prevId)
+			prevCompany = prev
+		}
+		companies[newCompany.GetId()] = newCompany
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// persist情報の書き込み
 	if err := newCompany.Pathist.SavePersists(); err != nil {
@@ -181,30 +253,104 @@ func (srv *CompanyService) UpdateNewCompany(prevId string, newCompany *models.Co
 	return prevCompany, nil
 }
 
-// GetCompanies は管理されている会社情報の一覧を取得します
+// releaseCompanyLocks はフォルダーのロックを解放し、失敗した場合はログに出力します
+func releaseCompanyLocks(locks core.FolderLocks) {
+	if err := locks.Release(); err != nil {
+		log.Printf("CompanyService: Failed to release folder lock: %v", err)
+	}
+}
+
+// GetCompanies は管理されている会社情報の一覧を取得します。
+//   - 業種カテゴリー、省略会社名・読み・正式名称・住所・電話番号の部分一致（表記ゆれを吸収）、フィールドが空かどうかで絞り込めます。
+//   - ordered_companies には並べ替えた1ページ分を返します。
+//     companies には page_size・page_token を指定しない場合は条件に一致した全ての会社を、指定した場合は同じページの会社を返します。
+//   - include_folder_sizes を指定した場合は companies の会社IDをキーとした会社フォルダーの合計サイズも返します。
+//   - ページトークンは発行時と会社の一覧の世代（generation）が異なる場合はエラーとなります。
+//     page_token を指定した場合は refresh を指定してもキャッシュを更新しません。
+//   - archived を指定した場合はアーカイブした会社の一覧を返します（generation は返しません）。
+//
 // gRPCサービスの実装です
 func (srv *CompanyService) GetCompanies(
 	ctx context.Context, req *grpcv1.GetCompaniesRequest) (
 	*grpcv1.GetCompaniesResponse, error) {
 
+	// 検索条件を作成
+	query, err := newCompanyQuery(req)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// レスポンスを初期化
 	res := grpcv1.GetCompaniesResponse_builder{}.Build()
 
-	// 必要に応じてキャッシュを更新
-	if req.GetRefresh() {
+	// 必要に応じてキャッシュを更新（ページの途中で一覧が変わらないよう、page_token を指定した場合は更新しない）
+	if req.GetRefresh() && req.GetPageToken() == "" {
 		if err := srv.UpdateCompanies(); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
 	}
 
 	// 会社データモデルを作成
-	grpcv1Companies := make(map[string]*grpcv1.Company, len(srv.companies))
-	for _, v := range srv.companies {
-		grpcv1Companies[v.Company.GetId()] = v.Company
+	snapshot := srv.companies.Load()
+	companies := snapshot.All()
+	generation := uint64(0)
+	if req.GetArchived() {
+		archives, err := srv.archivedCompanies()
+		if err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+		companies = maps.All(archives)
+	} else {
+		generation = snapshot.Generation()
+		res.SetGeneration(generation)
+	}
+
+	// ページトークンは同じ世代のスナップショットで発行されたもののみ有効
+	if err := query.resume(req, generation); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	items := []companyListItem{}
+	for _, v := range companies {
+		if item := newCompanyListItem(v); query.match(item) {
+			items = append(items, item)
+		}
+	}
+
+	// 並べ替え、ページ分割
+	query.sort(items)
+	page, nextPageToken := query.page(items)
+	ordered := make([]*grpcv1.Company, 0, len(page))
+	for _, item := range page {
+		ordered = append(ordered, item.company)
+	}
+
+	// ページを指定した場合は companies・フォルダーサイズも同じページの会社のみ
+	listed := items
+	if query.paged {
+		listed = page
+	}
+	grpcv1Companies := make(map[string]*grpcv1.Company, len(listed))
+	folders := make(map[string]string, len(listed))
+	for _, item := range listed {
+		grpcv1Companies[item.company.GetId()] = item.company
+		folders[item.company.GetId()] = item.company.GetPathistFolder()
+	}
+
+	// 必要に応じて会社フォルダーの合計サイズを取得
+	if req.GetIncludeFolderSizes() {
+		sizes, err := folderSizesFrom(ctx, srv.services, folders)
+		if err != nil {
+			return nil, err
+		}
+		res.SetFolderSizes(sizes)
 	}
 
 	// Responseの更新とリターン
 	res.SetCompanies(grpcv1Companies)
+	res.SetOrderedCompanies(ordered)
+	res.SetNextPageToken(nextPageToken)
+	res.SetTotalCount(int32(len(items)))
 	return res, nil
 }
 
@@ -223,7 +369,7 @@ func (srv *CompanyService) GetCompany(
 	id := req.GetId()
 
 	// 会社情報を取得
-	company, exist := srv.companies[id]
+	company, exist := srv.companies.Load().Get(id)
 	if !exist {
 		err = connect.NewError(connect.CodeNotFound, errors.New("company not found"))
 		return
@@ -250,7 +396,7 @@ func (srv *CompanyService) UpdateCompany(
 
 	prevCompany, err := srv.UpdateNewCompany(prevId, newCompany)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("updated company is nil"))
+		return nil, connectError(err, connect.CodeInvalidArgument)
 	}
 
 	// Responseの作成
@@ -260,6 +406,81 @@ func (srv *CompanyService) UpdateCompany(
 	return res, nil
 }
 
+// CreateCompany は業種カテゴリーと省略会社名から会社フォルダーを作成します。
+//   - フォルダー名は "N 省略会社名" 形式です（GenerateCompanyPathistFolder）。
+//   - テンプレートフォルダー（CompanyTemplateFolder）の内容を、ファイル名・フォルダー名の {{.ShortName}} 等を置き換えてコピーします。
+//   - persist_ フィールドは永続化ファイル（@company.yaml）に保存します。
+//
+// gRPCサービスの実装です
+func (srv *CompanyService) CreateCompany(
+	ctx context.Context, req *grpcv1.CreateCompanyRequest) (
+	*grpcv1.CreateCompanyResponse, error) {
+
+	// リクエスト情報の取得
+	if !req.HasNewCompany() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("new_company is required"))
+	}
+	src := models.NewCompany()
+	src.Company = req.GetNewCompany()
+	shortName := strings.TrimSpace(src.GetShortName())
+	if shortName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("short_name is required"))
+	}
+	if err := models.ErrorCompanyCategoryIndex(int(src.GetCategoryIndex())); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 会社フォルダーのパスを生成して会社情報を解析
+	folder, err := models.GenerateCompanyPathistFolder(srv.serviceFolder, src.GetCategoryIndex(), shortName)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	company := models.NewCompany()
+	if err := company.ParseFrom(folder); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// スペース・ハイフンを含む省略会社名は解析し直すと別の名前になるため作成しない
+	if company.GetShortName() != core.NormalizeName(shortName) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("short_name must not contain spaces or hyphens: "+shortName))
+	}
+	if _, exist := srv.companies.Load().Get(company.GetId()); exist {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("company already exists"))
+	}
+	if err := company.Pathist.ImportPersists(src.Pathist); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// サービスフォルダーのロックを取得し、テンプレートをコピーして会社フォルダーを作成
+	locks, err := lockParentFolders("CompanyService.CreateCompany", folder)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer releaseCompanyLocks(locks)
+	copied, err := core.CopyTemplate(ctx, srv.templateFolder, folder, company.TemplateData())
+	if errors.Is(err, core.ErrTemplateName) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 永続化データを保存
+	if err := company.Pathist.SavePersists(); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 監視イベントを待たずにキャッシュを更新
+	if err := srv.UpdateCompanies(); err != nil {
+		log.Printf("CompanyService: Failed to update company cache map: %v", err)
+	}
+
+	res := grpcv1.CreateCompanyResponse_builder{}.Build()
+	res.SetCompany(company.Company)
+	res.SetTemplateFileCount(int32(copied))
+	return res, nil
+}
+
 // GetCompanyCategories は業種カテゴリーの一覧を取得します
 func (srv *CompanyService) GetCompanyCategories(
 	_ context.Context, _ *grpcv1.GetCompanyCategoriesRequest) (
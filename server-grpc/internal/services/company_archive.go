@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	grpcv1 "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+)
+
+// ArchiveCompany は会社フォルダーをアーカイブフォルダー（CompanyArchiveFolder）に移動します。
+//   - フォルダーは削除せず、フォルダー名も変更しないため RestoreCompany で同じ ID のまま元に戻せます。
+//   - アーカイブフォルダーは Pathist の内部管理用フォルダーのため、一覧・検索には表示されません。
+//   - 同じ名前の会社フォルダーが既にアーカイブされている場合はエラーです。
+//
+// gRPCサービスの実装です
+func (srv *CompanyService) ArchiveCompany(
+	_ context.Context, req *grpcv1.ArchiveCompanyRequest) (
+	*grpcv1.ArchiveCompanyResponse, error) {
+
+	company, exist := srv.companies.Load().Get(req.GetId())
+	if !exist {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("company not found"))
+	}
+	if err := os.MkdirAll(srv.archiveFolder, 0755); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	archived, err := srv.moveCompanyFolder(company.GetPathistFolder(), srv.archiveFolder, "CompanyService.ArchiveCompany")
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("CompanyService: Archived company %s to %s", company.GetShortName(), archived.GetPathistFolder())
+
+	res := grpcv1.ArchiveCompanyResponse_builder{}.Build()
+	res.SetCompany(archived.Company)
+	return res, nil
+}
+
+// RestoreCompany はアーカイブした会社フォルダーを会社フォルダーの一覧に戻します
+// 同じ名前の会社フォルダーが既に存在する場合はエラーです
+// gRPCサービスの実装です
+func (srv *CompanyService) RestoreCompany(
+	_ context.Context, req *grpcv1.RestoreCompanyRequest) (
+	*grpcv1.RestoreCompanyResponse, error) {
+
+	archives, err := srv.archivedCompanies()
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	company, exist := archives[req.GetId()]
+	if !exist {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("archived company not found"))
+	}
+
+	restored, err := srv.moveCompanyFolder(company.GetPathistFolder(), srv.serviceFolder, "CompanyService.RestoreCompany")
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("CompanyService: Restored company %s from archive", restored.GetShortName())
+
+	res := grpcv1.RestoreCompanyResponse_builder{}.Build()
+	res.SetCompany(restored.Company)
+	return res, nil
+}
+
+// moveCompanyFolder は移動元と移動先の親フォルダーのロックを取得して dstParent 直下に同じ名前で移動し、
+// 移動後の会社情報を返します。移動後は監視イベントを待たずにキャッシュを更新します
+func (srv *CompanyService) moveCompanyFolder(folder, dstParent, owner string) (*models.Company, error) {
+	dst := filepath.Join(dstParent, filepath.Base(folder))
+	locks, err := lockParentFolders(owner, folder, dst)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer releaseCompanyLocks(locks)
+
+	if _, err := os.Lstat(dst); err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("company folder already exists: %s", filepath.Base(folder)))
+	}
+	if err := os.Rename(folder, dst); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	if err := srv.UpdateCompanies(); err != nil {
+		log.Printf("CompanyService: Failed to update company cache map: %v", err)
+	}
+
+	company := models.NewCompany()
+	if err := company.ParseFrom(dst); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	if err := company.Pathist.LoadPersists(); err != nil {
+		log.Printf("Failed to load persist info for company ShortName %s: %v", company.GetShortName(), err)
+	}
+	return company, nil
+}
+
+// archivedCompanies はアーカイブフォルダーの会社情報を会社IDをキーとして返します
+// アーカイブはキャッシュせず、呼び出しの度にフォルダーを読み込みます
+func (srv *CompanyService) archivedCompanies() (map[string]*models.Company, error) {
+	entries, err := os.ReadDir(srv.archiveFolder)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]*models.Company{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	companies := make(map[string]*models.Company, len(entries))
+	for _, entry := range entries {
+		company := models.NewCompany()
+		if !entry.IsDir() || company.ParseFrom(srv.archiveFolder, entry.Name()) != nil {
+			continue
+		}
+		if err := company.Pathist.LoadPersists(); err != nil {
+			log.Printf("Failed to load persist info for company ShortName %s: %v", company.GetShortName(), err)
+		}
+		companies[company.GetId()] = company
+	}
+	return companies, nil
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"cmp"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	grpcv1 "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// companyQueryDefaultPageSize は GetCompanies で page_size 未指定時に返す最大件数
+const companyQueryDefaultPageSize = 1000
+
+// companyQueryMaxPageSize は GetCompanies で一度に返す最大件数
+const companyQueryMaxPageSize = 1000
+
+// companyQueryFields は GetCompanies の query で検索するフィールド
+var companyQueryFields = []func(*grpcv1.Company) string{
+	(*grpcv1.Company).GetShortName,
+	(*grpcv1.Company).GetPersistReading,
+	(*grpcv1.Company).GetPersistLongName,
+	(*grpcv1.Company).GetPersistAddress,
+	(*grpcv1.Company).GetPersistTel,
+}
+
+// companyListItem は会社情報と並べ替え・検索用の情報です
+type companyListItem struct {
+	company *grpcv1.Company
+
+	// folded は検索対象のフィールドを FoldForSearch で変換した値
+	folded []string
+
+	// reading は並べ替え用の読み（未設定の場合は省略会社名）を FoldForSearch で変換した値
+	reading string
+}
+
+// companyQuery は GetCompanies のリクエストから作成した検索条件です
+type companyQuery struct {
+	categories     []int32
+	terms          []string
+	emptyFields    []protoreflect.FieldDescriptor
+	nonEmptyFields []protoreflect.FieldDescriptor
+	sortKey        grpcv1.CompanySortKey
+	descending     bool
+	pageSize       int
+	offset         int
+
+	// paged は page_size または page_token が指定されたかどうか
+	paged bool
+
+	// fingerprint はページトークンが同じ検索条件で発行されたかを確認するための値
+	fingerprint string
+}
+
+// newCompanyQuery はリクエストから検索条件を作成します
+func newCompanyQuery(req *grpcv1.GetCompaniesRequest) (*companyQuery, error) {
+	q := &companyQuery{
+		categories: req.GetCategoryIndexes(),
+		terms:      strings.Fields(core.FoldForSearch(req.GetQuery())),
+		sortKey:    req.GetSortKey(),
+		descending: req.GetDescending(),
+	}
+
+	// 業種カテゴリー
+	for _, idx := range q.categories {
+		if err := models.ErrorCompanyCategoryIndex(int(idx)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 空かどうかで絞り込むフィールド
+	var err error
+	if q.emptyFields, err = companyFieldsFrom(req.GetEmptyFields()); err != nil {
+		return nil, err
+	}
+	if q.nonEmptyFields, err = companyFieldsFrom(req.GetNonEmptyFields()); err != nil {
+		return nil, err
+	}
+
+	// ページサイズ
+	q.pageSize = int(req.GetPageSize())
+	if q.pageSize <= 0 {
+		q.pageSize = companyQueryDefaultPageSize
+	}
+	q.pageSize = min(q.pageSize, companyQueryMaxPageSize)
+	q.paged = req.GetPageSize() > 0 || req.GetPageToken() != ""
+	return q, nil
+}
+
+// resume は検索対象のスナップショットの世代番号を含めて検索条件のハッシュを設定し、
+// ページトークンから開始位置を取得します
+// 別の世代で発行されたページトークンは会社の一覧が変わっているためエラーとします
+func (q *companyQuery) resume(req *grpcv1.GetCompaniesRequest, generation uint64) error {
+	fingerprint, err := companyQueryFingerprint(req, generation)
+	if err != nil {
+		return err
+	}
+	q.fingerprint = fingerprint
+	if token := req.GetPageToken(); token != "" {
+		offset, err := q.parsePageToken(token)
+		if err != nil {
+			return err
+		}
+		q.offset = offset
+	}
+	return nil
+}
+
+// companyFieldsFrom は Company の文字列フィールド名をフィールド定義に変換します
+func companyFieldsFrom(names []string) ([]protoreflect.FieldDescriptor, error) {
+	fields := grpcv1.Company_builder{}.Build().ProtoReflect().Descriptor().Fields()
+	result := make([]protoreflect.FieldDescriptor, 0, len(names))
+	for _, name := range names {
+		field := fields.ByName(protoreflect.Name(strings.TrimSpace(name)))
+		if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+			return nil, fmt.Errorf("invalid company field %q", name)
+		}
+		result = append(result, field)
+	}
+	return result, nil
+}
+
+// newCompanyListItem は会社情報から並べ替え・検索用の情報を作成します
+func newCompanyListItem(company *models.Company) companyListItem {
+	item := companyListItem{company: company.Company}
+	for _, field := range companyQueryFields {
+		item.folded = append(item.folded, core.FoldForSearch(field(company.Company)))
+	}
+	item.reading = core.FoldForSearch(company.GetPersistReading())
+	if item.reading == "" {
+		item.reading = core.FoldForSearch(company.GetShortName())
+	}
+	return item
+}
+
+// match は検索条件に一致するかを返します
+// query の語は全て、いずれかの検索対象のフィールドに含まれる必要があります
+func (q *companyQuery) match(item companyListItem) bool {
+	if len(q.categories) > 0 && !slices.Contains(q.categories, item.company.GetCategoryIndex()) {
+		return false
+	}
+	for _, term := range q.terms {
+		if !slices.ContainsFunc(item.folded, func(folded string) bool {
+			return strings.Contains(folded, term)
+		}) {
+			return false
+		}
+	}
+	message := item.company.ProtoReflect()
+	for _, field := range q.emptyFields {
+		if strings.TrimSpace(message.Get(field).String()) != "" {
+			return false
+		}
+	}
+	for _, field := range q.nonEmptyFields {
+		if strings.TrimSpace(message.Get(field).String()) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// sort は検索条件に従って並べ替えます
+// 同順位の場合は会社フォルダー名、IDの順で並べるため、ページ間で順序が安定します
+func (q *companyQuery) sort(items []companyListItem) {
+	slices.SortFunc(items, func(a, b companyListItem) int {
+		var c int
+		switch q.sortKey {
+		case grpcv1.CompanySortKey_COMPANY_SORT_KEY_CATEGORY:
+			c = cmp.Or(
+				cmp.Compare(a.company.GetCategoryIndex(), b.company.GetCategoryIndex()),
+				strings.Compare(a.reading, b.reading))
+		case grpcv1.CompanySortKey_COMPANY_SORT_KEY_READING:
+			c = strings.Compare(a.reading, b.reading)
+		default:
+			c = strings.Compare(a.company.GetShortName(), b.company.GetShortName())
+		}
+		c = cmp.Or(c,
+			strings.Compare(a.company.GetPathistFolder(), b.company.GetPathistFolder()),
+			strings.Compare(a.company.GetId(), b.company.GetId()))
+		if q.descending {
+			c = -c
+		}
+		return c
+	})
+}
+
+// page は offset から1ページ分を切り出し、次ページのトークンを返します
+func (q *companyQuery) page(items []companyListItem) ([]companyListItem, string) {
+	start := min(q.offset, len(items))
+	end := min(start+q.pageSize, len(items))
+	next := ""
+	if end < len(items) {
+		next = q.pageToken(end)
+	}
+	return items[start:end], next
+}
+
+// pageToken は offset を検索条件と共にエンコードしたページトークンを返します
+func (q *companyQuery) pageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + q.fingerprint))
+}
+
+// parsePageToken はページトークンから offset を取得します
+func (q *companyQuery) parsePageToken(token string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page_token")
+	}
+	offsetText, fingerprint, ok := strings.Cut(string(data), ":")
+	if !ok || fingerprint != q.fingerprint {
+		return 0, errors.New("page_token does not match the request or the companies have changed")
+	}
+	offset, err := strconv.Atoi(offsetText)
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page_token")
+	}
+	return offset, nil
+}
+
+// companyQueryFingerprint はページ・キャッシュ更新に関する項目を除いた検索条件と
+// スナップショットの世代番号のハッシュを返します
+func companyQueryFingerprint(req *grpcv1.GetCompaniesRequest, generation uint64) (string, error) {
+	clone := proto.CloneOf(req)
+	clone.SetPageSize(0)
+	clone.SetPageToken("")
+	clone.SetRefresh(false)
+	clone.SetIncludeFolderSizes(false)
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+	data = strconv.AppendUint(append(data, ':'), generation, 10)
+	return core.ParseIdFromBytes(data), nil
+}
@@ -3,16 +3,22 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"log"
 	"os"
 	"path/filepath"
-	"strings"
-	"sync"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
 
 	grpc "server-grpc/gen/grpc/v1"
 	grpcConnect "server-grpc/gen/grpc/v1/grpcv1connect"
 	"server-grpc/internal/core"
 	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
 )
 
 // FileService の実装
@@ -27,6 +33,51 @@ type FileService struct {
 
 	// PathistFolder はファイルサービスの絶対パスフォルダー
 	PathistFolder string `json:"pathistFolder" yaml:"pathist_folder" example:"/penguin/豊田築炉"`
+
+	// jail は相対パスを PathistFolder 配下に制限して解決します
+	jail *core.PathJail
+
+	// trash は削除したファイル・フォルダーの移動先
+	trash *core.Trash
+
+	// batchFolder は一括操作のジャーナルの保存先
+	batchFolder string
+
+	// versions は上書きしたファイルの過去の版の保存領域
+	versions *core.VersionStore
+
+	// uploads はアップロード途中のファイルを保存する一時領域
+	uploads *core.UploadStore
+
+	// uploadExpire は放棄されたアップロードを削除するまでの期間
+	uploadExpire time.Duration
+
+	// hashes はファイル内容のハッシュのキャッシュ
+	hashes *core.HashCache
+
+	// index はファイル名・フォルダー名の検索索引
+	index *core.SearchIndex
+
+	// indexReady は索引の初回構築が完了したかどうか
+	indexReady atomic.Bool
+
+	// workbooks は xlsx ファイルのセルの値の検索索引
+	workbooks *core.WorkbookIndex
+
+	// workbooksReady はブック内容の索引の初回構築が完了したかどうか
+	workbooksReady atomic.Bool
+
+	// usage はフォルダー毎の使用量のキャッシュ
+	usage *core.DiskUsageCache
+
+	// listings は GetFiles の次ページ用に保持する一覧
+	listings *fileListings
+
+	// indexWatcher は検索索引を最新に保つためのファイルシステム監視オブジェクト
+	indexWatcher *core.Watcher
+
+	// cancel はバックグラウンド処理（ゴミ箱の自動削除、ハッシュ計算、索引の再構築）を終了します
+	cancel context.CancelFunc
 }
 
 func (srv *FileService) Start(services *Services, options *map[string]string) error {
@@ -42,17 +93,120 @@ func (srv *FileService) Start(services *Services, options *map[string]string) er
 		return err
 	}
 
+	// シンボリックリンクの扱いを設定
+	policy, err := core.ParseSymlinkPolicy((*options)["FileServiceSymlinkPolicy"])
+	if err != nil {
+		return err
+	}
+	jail, err := core.NewPathJail(target, policy)
+	if err != nil {
+		return err
+	}
+
+	// ゴミ箱を開く
+	trash, err := core.OpenTrash(filepath.Join(target, core.TrashFolderName))
+	if err != nil {
+		return err
+	}
+
+	// 過去の版の保存領域を開く
+	versions, err := openVersionStoreFrom(target, options)
+	if err != nil {
+		return err
+	}
+
+	// アップロード一時領域を開く
+	uploads, err := core.OpenUploadStore(filepath.Join(target, core.UploadFolderName))
+	if err != nil {
+		return err
+	}
+	optExpire, exists := (*options)["UploadExpireHours"]
+	if !exists {
+		optExpire = "24"
+	}
+	expireHours, err := strconv.Atoi(optExpire)
+	if err != nil {
+		return err
+	}
+
 	srv.services = services
 	srv.PathistFolder = target
+	srv.jail = jail
+	srv.trash = trash
+	srv.versions = versions
+	srv.batchFolder = (*options)["BatchJournalFolder"]
+	if srv.batchFolder == "" {
+		srv.batchFolder = filepath.Join(target, core.PathistSystemPrefix+"-state", "batch")
+	}
+	srv.reportBatchJournals()
+	srv.uploads = uploads
+	srv.uploadExpire = time.Duration(expireHours) * time.Hour
+	srv.hashes = core.OpenHashCache((*options)["HashCacheFile"])
+	srv.listings = newFileListings()
+	srv.purgeExpiredUploads()
+
+	// バックグラウンド処理の設定
+	retention, interval, err := trashRetentionFrom(options)
+	if err != nil {
+		return err
+	}
+	optHashInterval, exists := (*options)["HashScanIntervalSec"]
+	if !exists {
+		optHashInterval = "900"
+	}
+	hashIntervalSec, err := strconv.Atoi(optHashInterval)
+	if err != nil {
+		return err
+	}
+	versionInterval, err := versionPurgeIntervalFrom(options)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.cancel = cancel
+
+	// ゴミ箱の保持期間を過ぎたエントリーを定期的に削除
+	if retention > 0 {
+		go srv.purgeTrashLoop(ctx.Done(), retention, interval)
+	}
+
+	// 保持期間を過ぎた過去の版を定期的に削除
+	go srv.purgeVersionsLoop(ctx.Done(), versionInterval)
+
+	// ファイル内容のハッシュを定期的に計算
+	if hashIntervalSec > 0 {
+		go srv.hashLoop(ctx, time.Duration(hashIntervalSec)*time.Second)
+	}
+
+	// ファイル名とブック内容の検索索引を構築して監視を開始
+	if err := srv.startSearchIndex(ctx, options); err != nil {
+		cancel()
+		return err
+	}
 
 	return nil
 }
 
 func (s *FileService) Cleanup() {
-	// 現在はクリーンアップ処理は不要
+	if s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+	if s.indexWatcher != nil {
+		s.indexWatcher.Close()
+		s.indexWatcher = nil
+	}
+	if s.hashes != nil {
+		if err := s.hashes.Save(); err != nil {
+			log.Printf("FileService: Failed to save hash cache: %v", err)
+		}
+	}
 }
 
-func (s *FileService) GetFileBasePath(
+// GetFilePathistFolder はファイルサービスのルートフォルダーを返す
+// gRPCサービスの実装です
+func (s *FileService) GetFilePathistFolder(
 	ctx context.Context, req *grpc.GetFilePathistFolderRequest) (
 	*grpc.GetFilePathistFolderResponse, error) {
 	// コンテキストを無視
@@ -65,91 +219,133 @@ func (s *FileService) GetFileBasePath(
 }
 
 // GetFiles は指定されたパスのファイル情報一覧を返す
+// 再帰の深さ、ファイル名・拡張子・サイズ・更新日時での絞り込み、並べ替え、ページ分割に対応
+// 次ページは最初のページの時点の一覧から返すため、走査はページ分割した一覧毎に1回のみ
 func (s *FileService) GetFiles(
 	ctx context.Context, req *grpc.GetFilesRequest) (
 	*grpc.GetFilesResponse, error) {
 
-	// 無視する引数
-	_ = ctx
-
 	// リクエスト情報の取得
 	reqTarget := req.GetPathistFolder()
+	query, err := newFileQuery(req)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
 	// 絶対パスを取得
 	absPath, err := s.GetAbsPathFrom(reqTarget)
 	if err != nil {
-		return nil, err
+		return nil, connectError(err, connect.CodeInvalidArgument)
 	}
 
-	// ファイルエントリ配列を取得
-	dirs, err := os.ReadDir(absPath)
-	if err != nil {
-		return nil, err
+	// 次ページは最初のページで作成した一覧から切り出す（フォルダーは走査し直さない）
+	var items []fileListItem
+	if query.listing != "" {
+		items, err = s.listings.get(query.listing, query.fingerprint)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		items, err = listFiles(ctx, absPath, query)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// ファイルエントリが0の場合は空配列を返す
-	res := grpc.GetFilesResponse_builder{}.Build()
-	files := make([]*grpc.File, 0)
-	dirsNum := len(dirs)
-	if dirsNum == 0 {
-		res.SetFiles(files)
-		return res, nil
-	}
-
-	// ワーカーグループとチャンネルを設定
-	workerNum := core.DecideNumWorkers(dirsNum)
-	var wg sync.WaitGroup
-	channelIn := make(chan int, dirsNum)
-	channelOut := make(chan *grpc.File, dirsNum)
-
-	// ワーカーを起動
-	for range workerNum {
-		wg.Go(func() {
-			for idx := range channelIn {
-				dir := dirs[idx]
-				fullpath := filepath.Join(absPath, dir.Name())
-
-				fi := models.NewFile()
-				if err := fi.ParseFrom(fullpath); err == nil {
-					channelOut <- fi.File
-				}
+	// ページ分割（次ページがある場合のみ一覧を保持）
+	listing := query.listing
+	if listing == "" && len(items) > query.pageSize {
+		listing, err = s.listings.put(query.fingerprint, items)
+		if err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+	}
+	page, nextPageToken := query.page(items, listing)
+
+	// ページ内の項目のみファイル情報を作成
+	files, err := core.ParallelMap(ctx, page,
+		func(_ context.Context, item fileListItem) (*grpc.File, error) {
+			fi := models.NewFile()
+			if err := fi.ParseFrom(s.PathistFolder, item.absPath); err != nil {
+				return nil, err
 			}
-		})
+			return fi.File, nil
+		}, core.WithOrdered())
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, connectError(ctxErr, connect.CodeCanceled)
 	}
-
-	// ジョブを送信
-	for idx := range dirs {
-		channelIn <- idx
+	if err != nil {
+		// 走査後に削除された等で取得できなかったエントリは除外して続行
+		log.Printf("FileService: Failed to parse some entries in %s: %v", absPath, err)
 	}
-	close(channelIn)
 
-	// ワーカーの完了を待つ
-	go func() {
-		wg.Wait()
-		close(channelOut)
-	}()
+	// レスポンスを作成して返す
+	res := grpc.GetFilesResponse_builder{}.Build()
+	res.SetFiles(files)
+	res.SetNextPageToken(nextPageToken)
+	res.SetTotalCount(int32(len(items)))
+	return res, nil
+}
 
-	// 結果を収集
-	files = make([]*grpc.File, 0, len(dirs))
-	for fi := range channelOut {
-		files = append(files, fi)
+// listFiles は absPath 配下を走査し、検索条件で絞り込み・並べ替えた一覧を返します
+func listFiles(ctx context.Context, absPath string, query *fileQuery) ([]fileListItem, error) {
+	// ファイルエントリ配列を取得（内部管理用のファイル・フォルダーは除外）
+	entries, err := collectEntries(ctx, absPath, query.depth)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	entries = slices.DeleteFunc(entries, func(entry fileEntry) bool {
+		return !query.matchName(filepath.Base(entry.absPath), entry.isDir)
+	})
+
+	// 絞り込み・並べ替えに必要な情報のみを並列に取得（RPC がキャンセルされた場合は走査を中止）
+	items, err := core.ParallelMap(ctx, entries,
+		func(_ context.Context, entry fileEntry) (fileListItem, error) {
+			return statEntry(entry)
+		})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, connectError(ctxErr, connect.CodeCanceled)
+	}
+	if err != nil {
+		// 取得できなかったエントリは除外して続行
+		log.Printf("FileService: Failed to stat some entries in %s: %v", absPath, err)
 	}
 
-	// レスポンスを更新して返す
-	res.SetFiles(files)
-	return res, nil
+	// 絞り込み、並べ替え
+	items = slices.DeleteFunc(items, func(item fileListItem) bool {
+		return !query.matchInfo(item)
+	})
+	query.sort(items)
+	return items, nil
 }
 
 // GetAbsPathFrom BasePathに引数の相対パスを追加した絶対パスを返す
+//   - ルート外を指すパスやポリシーに反するシンボリックリンクは core.ErrPathOutsideRoot を返す
+//   - 内部管理用のファイル・フォルダー（リンク先を含む）は core.ErrSystemPath を返す
 func (s *FileService) GetAbsPathFrom(relPath string) (res string, err error) {
-	// 絶対パスがある場合はエラーを返す
-	if strings.HasPrefix(relPath, "~/") || filepath.IsAbs(relPath) {
-		return "", errors.New("絶対パスは使用できません")
+	if s.jail == nil {
+		return "", errors.New("FileService is not started")
+	}
+	absPath, err := s.jail.Resolve(relPath)
+	if err != nil {
+		return "", err
+	}
+	if core.PathIsPathistSystem(s.relPathFrom(absPath)) {
+		return "", fmt.Errorf("%w: %s", core.ErrSystemPath, relPath)
 	}
 
-	res = filepath.Join(s.PathistFolder, relPath)
-
-	return // naked return
+	// 存在する最も深い要素のリンク先が内部管理用のフォルダーでないことを確認
+	for existing := absPath; s.jail.Verify(existing) == nil; existing = filepath.Dir(existing) {
+		resolved, err := filepath.EvalSymlinks(existing)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(s.jail.Root(), resolved); err == nil && core.PathIsPathistSystem(rel) {
+			return "", fmt.Errorf("%w: %s", core.ErrSystemPath, relPath)
+		}
+		break
+	}
+	return absPath, nil
 }
 
 // CopyFile はファイルまたはディレクトリをコピーする
@@ -174,6 +370,13 @@ func (s *FileService) CopyFile(relSrc, relDst string) (err error) {
 		return
 	}
 
+	// コピー先フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.CopyFile", absDst)
+	if err != nil {
+		return
+	}
+	defer locks.Release()
+
 	// ディレクトリの場合
 	if srcOsFi.IsDir() {
 		err = s.absCopyDir(absSrc, absDst)
@@ -244,6 +447,13 @@ func (s *FileService) absCopyDir(absSrc, absDst string) error {
 		srcPath := filepath.Join(absSrc, entry.Name())
 		dstPath := filepath.Join(absDst, entry.Name())
 
+		// シンボリックリンクはリンク先がルート配下であることを確認
+		if entry.Type()&os.ModeSymlink != 0 {
+			if err := s.jail.Verify(srcPath); err != nil {
+				return err
+			}
+		}
+
 		if entry.IsDir() {
 			// サブディレクトリの場合、再帰的にコピー
 			if err := s.absCopyDir(srcPath, dstPath); err != nil {
@@ -274,7 +484,16 @@ func (s *FileService) MoveFile(relSrc, relDst string) error {
 	// 移動先のディレクトリが存在するかチェック
 	if _, err := os.Stat(absSrc); os.IsNotExist(err) {
 		return errors.New("移動元のファイル/ディレクトリが存在しません: " + relSrc)
+	} else if err != nil {
+		return err
+	}
+
+	// 移動元と移動先の親フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.MoveFile", absSrc, absDst)
+	if err != nil {
+		return err
 	}
+	defer locks.Release()
 
 	// 移動先の親ディレクトリを作成（必要に応じて）
 	dstParent := filepath.Dir(absDst)
@@ -285,12 +504,93 @@ func (s *FileService) MoveFile(relSrc, relDst string) error {
 	return os.Rename(absSrc, absDst)
 }
 
-// DeleteFile はファイルを削除する
-func (s *FileService) DeleteFile(relPath string) error {
+// DeleteFile はファイルまたはディレクトリをゴミ箱に移動する
+// deletedBy: 削除を行ったユーザーまたは接続元
+func (s *FileService) DeleteFile(relPath, deletedBy string) error {
+	_, err := s.trashFile(relPath, deletedBy)
+	return err
+}
+
+// trashFile はファイルまたはディレクトリをゴミ箱に移動し、ゴミ箱のエントリーを返す
+func (s *FileService) trashFile(relPath, deletedBy string) (core.TrashEntry, error) {
 	absPath, err := s.GetAbsPathFrom(relPath)
 	if err != nil {
-		return err
+		return core.TrashEntry{}, err
+	}
+
+	// ルートフォルダーは削除できない（内部管理用のファイル・フォルダーは GetAbsPathFrom で拒否済み）
+	if absPath == s.PathistFolder {
+		return core.TrashEntry{}, connect.NewError(connect.CodePermissionDenied, errors.New("削除できないパスです: "+relPath))
 	}
 
-	return os.Remove(absPath)
+	// 親ディレクトリのロックを取得
+	locks, err := s.lockParentsOf("FileService.DeleteFile", absPath)
+	if err != nil {
+		return core.TrashEntry{}, err
+	}
+	defer locks.Release()
+
+	return s.trash.Put(absPath, s.relPathFrom(absPath), deletedBy)
+}
+
+// lockParentsOf は変更する absPaths それぞれの親フォルダーのロックを取得します。
+// 親フォルダーが存在しない場合は、存在する最も近い親フォルダーをロックします（lockParentFolders を参照）
+func (s *FileService) lockParentsOf(owner string, absPaths ...string) (core.FolderLocks, error) {
+	folders := make([]string, 0, len(absPaths))
+	for _, absPath := range absPaths {
+		folder, err := s.existingFolderOf(filepath.Dir(absPath))
+		if err != nil {
+			return nil, err
+		}
+		folders = append(folders, folder)
+	}
+	return lockFoldersFor(owner, folders, absPaths)
+}
+
+// lockParentFolders は変更する absPaths それぞれの親フォルダーのロックを取得します。
+//   - ファイル操作、会社・工事フォルダーの作成・名前の変更・移動は全て、変更するファイル・フォルダーの親フォルダーをロックします。
+//   - absPaths がフォルダーの場合は、そのフォルダー自身が他の操作にロックされていないことも確認します。
+func lockParentFolders(owner string, absPaths ...string) (core.FolderLocks, error) {
+	folders := make([]string, 0, len(absPaths))
+	for _, absPath := range absPaths {
+		folders = append(folders, filepath.Dir(absPath))
+	}
+	return lockFoldersFor(owner, folders, absPaths)
+}
+
+// lockFoldersFor は absPaths を変更するために folders のロックを取得し、
+// absPaths のうちフォルダーであるものが他の操作にロックされていないことを確認します
+func lockFoldersFor(owner string, folders, absPaths []string) (core.FolderLocks, error) {
+	locks, err := core.LockFolders(folders, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, absPath := range absPaths {
+		if fi, err := os.Lstat(absPath); err != nil || !fi.IsDir() {
+			continue
+		}
+		if err := core.CheckFolderUnlocked(absPath); err != nil {
+			locks.Release()
+			return nil, err
+		}
+	}
+	return locks, nil
+}
+
+// existingFolderOf は absFolder、または存在する最も近い親フォルダーを返します
+func (s *FileService) existingFolderOf(absFolder string) (string, error) {
+	folder := filepath.Clean(absFolder)
+	for {
+		if fi, err := os.Stat(folder); err == nil && fi.IsDir() {
+			return folder, nil
+		}
+
+		// ルートフォルダーより上には遡らない
+		parent := filepath.Dir(folder)
+		if parent == folder || folder == s.PathistFolder {
+			return "", errors.New("ロック対象のフォルダーが存在しません: " + absFolder)
+		}
+		folder = parent
+	}
 }
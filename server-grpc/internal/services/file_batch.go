@@ -0,0 +1,493 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+)
+
+// batchMaxOperations は BatchPlan で一度に指定できる操作の最大件数
+const batchMaxOperations = 1000
+
+// batchRolledBackBy は一括操作の取り消しによりゴミ箱に移動したエントリーの削除者
+const batchRolledBackBy = "FileService.BatchPlan"
+
+// BatchPlan は移動・コピー・削除・フォルダー作成の一連の操作をまとめて実行します。
+//   - 実行前に全ての操作を順に検証します。前の操作の結果（移動・作成したフォルダー等）を踏まえて検証するため、
+//     フォルダーを作成してからその中に移動するような手順も指定できます。
+//   - dry_run を指定した場合は検証結果のみを返し、ファイルは変更しません。
+//   - 移動・コピー先が既に存在する場合は上書きせずエラーとします（取り消しできるようにするため）。
+//     検証後に作成された場合も、実行時にロックを取得して確認しエラーとします。
+//   - 実行中に失敗した場合は、ジャーナルに記録した完了済みの操作を逆順に取り消します。
+//     コピーしたものはゴミ箱に移動します。
+//
+// gRPCサービスの実装です
+func (s *FileService) BatchPlan(
+	ctx context.Context, req *grpc.BatchPlanRequest) (
+	*grpc.BatchPlanResponse, error) {
+
+	operations := req.GetOperations()
+	if len(operations) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("operations is required"))
+	}
+	if len(operations) > batchMaxOperations {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("too many operations: %d", len(operations)))
+	}
+
+	// 全ての操作を検証
+	results := make([]*grpc.BatchOperationResult, len(operations))
+	planner := &batchPlanner{s: s, overlay: map[string]batchEntry{}}
+	valid := true
+	for i, op := range operations {
+		err := planner.plan(op)
+		results[i] = newBatchOperationResult(i, op, err)
+		valid = valid && err == nil
+	}
+
+	res := grpc.BatchPlanResponse_builder{}.Build()
+	res.SetResults(results)
+	res.SetOk(valid)
+	if !valid || req.GetDryRun() {
+		return res, nil
+	}
+
+	// ジャーナルに記録しながら順に実行
+	journal, err := core.NewBatchJournal(s.batchFolder, requestActor(ctx))
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	res.SetJournalId(journal.Id)
+	res.SetExecuted(true)
+
+	failed := -1
+	for i, op := range operations {
+		if err := ctx.Err(); err != nil {
+			results[i] = newBatchOperationResult(i, op, connectError(err, connect.CodeCanceled))
+			failed = i
+			break
+		}
+		if err := s.executeBatchOperation(ctx, journal, op); err != nil {
+			results[i] = newBatchOperationResult(i, op, err)
+			failed = i
+			break
+		}
+	}
+	if failed < 0 {
+		if err := journal.Remove(); err != nil {
+			log.Printf("FileService: Failed to remove batch journal %s: %v", journal.Id, err)
+		}
+		return res, nil
+	}
+
+	// 失敗した操作以降は実行しない
+	res.SetOk(false)
+	for i := failed + 1; i < len(operations); i++ {
+		results[i].SetOk(false)
+		results[i].SetSkipped(true)
+	}
+
+	// 完了した操作を逆順に取り消す
+	rollbackErr := s.rollbackBatch(journal)
+	for i := range failed {
+		results[i].SetRolledBack(journal.Steps[i].RolledBack)
+	}
+	if rollbackErr != nil {
+		// 手作業で復旧できるようジャーナルを残す
+		log.Printf("FileService: Failed to roll back batch %s: %v", journal.Id, rollbackErr)
+		return res, nil
+	}
+	res.SetRolledBack(true)
+	if err := journal.Remove(); err != nil {
+		log.Printf("FileService: Failed to remove batch journal %s: %v", journal.Id, err)
+	}
+	return res, nil
+}
+
+// executeBatchOperation は1件の操作をジャーナルに記録して実行します
+func (s *FileService) executeBatchOperation(ctx context.Context, journal *core.BatchJournal, op *grpc.BatchOperation) error {
+	step := core.BatchStep{Src: op.GetSrc(), Dst: op.GetDst()}
+	switch op.GetKind() {
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_MOVE:
+		step.Op = core.BatchOpMove
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_COPY:
+		step.Op = core.BatchOpCopy
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_DELETE:
+		step.Op, step.Dst = core.BatchOpDelete, ""
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_MKDIR:
+		step.Op, step.Src = core.BatchOpMkdir, ""
+	}
+
+	// 実行前に記録し、途中で終了した場合も対象が分かるようにする
+	index, err := journal.Append(step)
+	if err != nil {
+		return connectError(err, connect.CodeInternal)
+	}
+
+	switch step.Op {
+	case core.BatchOpMove:
+		err = s.batchMove(step.Src, step.Dst)
+	case core.BatchOpCopy:
+		err = s.batchCopy(step.Src, step.Dst)
+	case core.BatchOpDelete:
+		err = s.batchDelete(journal, index, requestActor(ctx))
+	case core.BatchOpMkdir:
+		err = s.batchMkdir(step.Dst)
+	}
+	if err != nil {
+		return err
+	}
+
+	journal.Steps[index].Done = true
+	if err := journal.Save(); err != nil {
+		log.Printf("FileService: Failed to save batch journal %s: %v", journal.Id, err)
+	}
+	return nil
+}
+
+// rollbackBatch はジャーナルの完了した操作を逆順に取り消します
+// 取り消しに失敗した操作があっても残りの取り消しを続けます
+func (s *FileService) rollbackBatch(journal *core.BatchJournal) error {
+	var errs []error
+	for i := len(journal.Steps) - 1; i >= 0; i-- {
+		step := &journal.Steps[i]
+		if !step.Done {
+			continue
+		}
+
+		var err error
+		switch step.Op {
+		case core.BatchOpMove:
+			err = s.batchMove(step.Dst, step.Src)
+		case core.BatchOpCopy:
+			// コピーしたものは誤って削除しても戻せるようゴミ箱に移動
+			_, err = s.trashFile(step.Dst, batchRolledBackBy)
+		case core.BatchOpDelete:
+			var absSrc string
+			if absSrc, err = s.GetAbsPathFrom(step.Src); err == nil {
+				_, err = s.trash.Restore(step.TrashId, absSrc)
+			}
+		case core.BatchOpMkdir:
+			var absDst string
+			if absDst, err = s.GetAbsPathFrom(step.Dst); err == nil {
+				err = os.Remove(absDst)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s%s: %w", step.Op, step.Src, step.Dst, err))
+			continue
+		}
+		step.RolledBack = true
+	}
+
+	if err := journal.Save(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// batchMove は移動元と移動先の親フォルダーのロックを取得し、移動先が存在しないことを確認して移動します
+// 検証後に移動先が作成された場合も置き換えずにエラーとします
+func (s *FileService) batchMove(relSrc, relDst string) error {
+	absSrc, err := s.GetAbsPathFrom(relSrc)
+	if err != nil {
+		return err
+	}
+	absDst, err := s.GetAbsPathFrom(relDst)
+	if err != nil {
+		return err
+	}
+	locks, err := s.lockParentsOf("FileService.BatchPlan", absSrc, absDst)
+	if err != nil {
+		return err
+	}
+	defer locks.Release()
+
+	if err := checkBatchDestination(absDst, relDst); err != nil {
+		return err
+	}
+	return os.Rename(absSrc, absDst)
+}
+
+// batchCopy はコピー先の親フォルダーのロックを取得し、コピー先が存在しないことを確認してコピーします
+// コピーに失敗した場合は途中までコピーしたものをゴミ箱に移動します
+func (s *FileService) batchCopy(relSrc, relDst string) error {
+	absSrc, err := s.GetAbsPathFrom(relSrc)
+	if err != nil {
+		return err
+	}
+	absDst, err := s.GetAbsPathFrom(relDst)
+	if err != nil {
+		return err
+	}
+	srcOsFi, err := os.Stat(absSrc)
+	if err != nil {
+		return err
+	}
+	locks, err := s.lockParentsOf("FileService.BatchPlan", absDst)
+	if err != nil {
+		return err
+	}
+	defer locks.Release()
+
+	if err := checkBatchDestination(absDst, relDst); err != nil {
+		return err
+	}
+	if srcOsFi.IsDir() {
+		err = s.absCopyDir(absSrc, absDst)
+	} else {
+		err = s.absCopyFile(absSrc, absDst)
+	}
+	if err != nil {
+		if _, statErr := os.Lstat(absDst); statErr == nil {
+			if _, trashErr := s.trash.Put(absDst, s.relPathFrom(absDst), batchRolledBackBy); trashErr != nil {
+				log.Printf("FileService: Failed to move %s to trash: %v", absDst, trashErr)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// batchDelete はゴミ箱に移動し、完了とする前に取り消しに必要なゴミ箱の識別子をジャーナルに保存します
+// 保存できない場合は取り消せなくなるため、ゴミ箱から戻してエラーとします
+func (s *FileService) batchDelete(journal *core.BatchJournal, index int, actor string) error {
+	step := &journal.Steps[index]
+	entry, err := s.trashFile(step.Src, actor)
+	if err != nil {
+		return err
+	}
+	step.TrashId = entry.Id
+	if err := journal.Save(); err != nil {
+		absSrc, pathErr := s.GetAbsPathFrom(step.Src)
+		if pathErr == nil {
+			_, pathErr = s.trash.Restore(entry.Id, absSrc)
+		}
+		if pathErr != nil {
+			log.Printf("FileService: Failed to restore %s from trash %s: %v", step.Src, entry.Id, pathErr)
+		}
+		step.TrashId = ""
+		return connectError(err, connect.CodeInternal)
+	}
+	return nil
+}
+
+// checkBatchDestination は実行時に absDst が存在しないことを確認します
+func checkBatchDestination(absDst, relDst string) error {
+	if _, err := os.Lstat(absDst); err == nil {
+		return fmt.Errorf("%w: 検証後に作成されました: %s", os.ErrExist, relDst)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// batchMkdir は親フォルダーのロックを取得してフォルダーを作成します
+func (s *FileService) batchMkdir(relPath string) error {
+	absPath, err := s.GetAbsPathFrom(relPath)
+	if err != nil {
+		return err
+	}
+	locks, err := s.lockParentsOf("FileService.BatchPlan", absPath)
+	if err != nil {
+		return err
+	}
+	defer locks.Release()
+	return os.Mkdir(absPath, 0755)
+}
+
+// reportBatchJournals は前回の起動時に完了・取り消しできなかった一括操作をログに出力します
+func (s *FileService) reportBatchJournals() {
+	journals, err := core.ListBatchJournals(s.batchFolder)
+	if err != nil {
+		log.Printf("FileService: Failed to read batch journals: %v", err)
+		return
+	}
+	for _, journal := range journals {
+		log.Printf("FileService: Batch %s by %s started at %s was not completed, see %s",
+			journal.Id, journal.Actor, journal.StartedAt.Format("2006-01-02 15:04:05"), s.batchFolder)
+	}
+}
+
+// batchEntry は検証中のファイル・フォルダーの状態です
+type batchEntry struct {
+	// absent は移動・削除により存在しないかどうか
+	absent bool
+
+	// origin は内容の実体の絶対パス（移動・コピーした場合は元のパス、新しく作成したフォルダーは空）
+	origin string
+}
+
+// batchPlanner は実際のファイルを変更せずに一括操作を順に検証します
+// 検証済みの操作による変更を overlay に保持し、実際のファイルの状態に重ねて参照します
+type batchPlanner struct {
+	s *FileService
+
+	// overlay は絶対パスをキーとした検証済みの操作による変更
+	overlay map[string]batchEntry
+}
+
+// plan は1件の操作を検証し、結果を overlay に反映します
+func (p *batchPlanner) plan(op *grpc.BatchOperation) error {
+	switch op.GetKind() {
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_MOVE, grpc.BatchOperationKind_BATCH_OPERATION_KIND_COPY:
+		absSrc, err := p.pathFrom(op.GetSrc())
+		if err != nil {
+			return err
+		}
+		absDst, err := p.pathFrom(op.GetDst())
+		if err != nil {
+			return err
+		}
+		if err := checkTransferPaths(absSrc, absDst); err != nil {
+			return err
+		}
+		src, exists, _ := p.stat(absSrc)
+		if !exists {
+			return fmt.Errorf("%w: %s", os.ErrNotExist, op.GetSrc())
+		}
+		if err := p.checkCreatable(absDst, op.GetDst()); err != nil {
+			return err
+		}
+
+		// 配下の変更も移動・コピー先に引き継ぐ
+		children := p.entriesUnder(absSrc)
+		if op.GetKind() == grpc.BatchOperationKind_BATCH_OPERATION_KIND_MOVE {
+			p.set(absSrc, batchEntry{absent: true})
+		}
+		p.set(absDst, src)
+		for rel, entry := range children {
+			p.overlay[filepath.Join(absDst, rel)] = entry
+		}
+		return nil
+
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_DELETE:
+		absSrc, err := p.pathFrom(op.GetSrc())
+		if err != nil {
+			return err
+		}
+		if _, exists, _ := p.stat(absSrc); !exists {
+			return fmt.Errorf("%w: %s", os.ErrNotExist, op.GetSrc())
+		}
+		p.set(absSrc, batchEntry{absent: true})
+		return nil
+
+	case grpc.BatchOperationKind_BATCH_OPERATION_KIND_MKDIR:
+		absDst, err := p.pathFrom(op.GetDst())
+		if err != nil {
+			return err
+		}
+		if err := p.checkCreatable(absDst, op.GetDst()); err != nil {
+			return err
+		}
+		p.set(absDst, batchEntry{})
+		return nil
+	}
+	return connect.NewError(connect.CodeInvalidArgument, errors.New("kind is required"))
+}
+
+// pathFrom は操作対象の相対パスを絶対パスに変換します
+// ルートフォルダー及び内部管理用のファイル・フォルダーは操作できません
+func (p *batchPlanner) pathFrom(relPath string) (string, error) {
+	absPath, err := p.s.GetAbsPathFrom(relPath)
+	if err != nil {
+		return "", err
+	}
+	if absPath == p.s.PathistFolder {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.New("操作できないパスです: "+relPath))
+	}
+	return absPath, nil
+}
+
+// checkCreatable は absPath が存在せず、親フォルダーが存在することを確認します
+func (p *batchPlanner) checkCreatable(absPath, relPath string) error {
+	if _, exists, _ := p.stat(absPath); exists {
+		return fmt.Errorf("%w: %s", os.ErrExist, relPath)
+	}
+	_, exists, isDir := p.stat(filepath.Dir(absPath))
+	if !exists || !isDir {
+		return fmt.Errorf("%w: 親フォルダーが存在しません: %s", os.ErrNotExist, relPath)
+	}
+	return nil
+}
+
+// stat は検証済みの操作を反映した absPath の状態を返します
+func (p *batchPlanner) stat(absPath string) (entry batchEntry, exists, isDir bool) {
+	// 最も近い変更を探す
+	for path := absPath; ; path = filepath.Dir(path) {
+		if e, ok := p.overlay[path]; ok {
+			rel, _ := filepath.Rel(path, absPath)
+			switch {
+			case e.absent:
+				return batchEntry{absent: true}, false, false
+			case e.origin == "":
+				// 新しく作成したフォルダーの配下は空
+				if rel != "." {
+					return batchEntry{absent: true}, false, false
+				}
+				return e, true, true
+			}
+			absPath = filepath.Join(e.origin, rel)
+			break
+		}
+		if path == p.s.PathistFolder || filepath.Dir(path) == path {
+			break
+		}
+	}
+
+	fi, err := os.Lstat(absPath)
+	if err != nil {
+		return batchEntry{absent: true}, false, false
+	}
+	return batchEntry{origin: absPath}, true, fi.IsDir()
+}
+
+// set は absPath の状態を設定し、配下の変更を破棄します
+func (p *batchPlanner) set(absPath string, entry batchEntry) {
+	prefix := absPath + string(os.PathSeparator)
+	for path := range p.overlay {
+		if strings.HasPrefix(path, prefix) {
+			delete(p.overlay, path)
+		}
+	}
+	p.overlay[absPath] = entry
+}
+
+// entriesUnder は absPath 配下の変更を absPath からの相対パスをキーとして返します
+func (p *batchPlanner) entriesUnder(absPath string) map[string]batchEntry {
+	prefix := absPath + string(os.PathSeparator)
+	entries := map[string]batchEntry{}
+	for path, entry := range p.overlay {
+		if strings.HasPrefix(path, prefix) {
+			entries[strings.TrimPrefix(path, prefix)] = entry
+		}
+	}
+	return entries
+}
+
+// newBatchOperationResult は1件分の一括操作の結果を作成します
+func newBatchOperationResult(index int, op *grpc.BatchOperation, err error) *grpc.BatchOperationResult {
+	result := grpc.BatchOperationResult_builder{
+		Index:     int32(index),
+		Operation: op,
+		Ok:        err == nil,
+	}.Build()
+
+	if err != nil {
+		var connectErr *connect.Error
+		errors.As(connectError(err, connect.CodeInternal), &connectErr)
+		result.SetError(grpc.FileOperationError_builder{
+			Code:    connectErr.Code().String(),
+			Message: connectErr.Message(),
+		}.Build())
+	}
+	return result
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+)
+
+// newTestBatchService は a.txt と dir/c.txt を含むルートフォルダーでファイルサービスを開始します
+func newTestBatchService(t *testing.T) *FileService {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a.txt", "dir/c.txt"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &FileService{}
+	err := s.Start(nil, &map[string]string{
+		"FileServiceTarget":  root,
+		"BatchJournalFolder": t.TempDir(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Cleanup)
+	return s
+}
+
+// batchOp は一括操作の1件を作成します
+func batchOp(kind grpc.BatchOperationKind, src, dst string) *grpc.BatchOperation {
+	return grpc.BatchOperation_builder{Kind: kind, Src: src, Dst: dst}.Build()
+}
+
+const (
+	opMove   = grpc.BatchOperationKind_BATCH_OPERATION_KIND_MOVE
+	opCopy   = grpc.BatchOperationKind_BATCH_OPERATION_KIND_COPY
+	opDelete = grpc.BatchOperationKind_BATCH_OPERATION_KIND_DELETE
+	opMkdir  = grpc.BatchOperationKind_BATCH_OPERATION_KIND_MKDIR
+)
+
+// listTree はルートフォルダー配下の相対パスを内部管理用のものを除いて返します
+func listTree(t *testing.T, root string) []string {
+	t.Helper()
+	paths := []string{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if core.FilenameIsPathistSystem(d.Name()) {
+			return filepath.SkipDir
+		}
+		if rel, _ := filepath.Rel(root, path); rel != "." {
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return paths
+}
+
+func TestBatchPlanner(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []*grpc.BatchOperation
+		// wants は操作毎の検証結果（nil、os.ErrExist、os.ErrNotExist）
+		wants []error
+	}{
+		{"mkdir then move into", []*grpc.BatchOperation{
+			batchOp(opMkdir, "", "new"),
+			batchOp(opMove, "a.txt", "new/a.txt"),
+			batchOp(opMove, "dir/c.txt", "missing/c.txt"),
+			batchOp(opCopy, "new/a.txt", "new/b.txt"),
+		}, []error{nil, nil, os.ErrNotExist, nil}},
+		{"move then delete source", []*grpc.BatchOperation{
+			batchOp(opMove, "a.txt", "b.txt"),
+			batchOp(opDelete, "a.txt", ""),
+			batchOp(opDelete, "b.txt", ""),
+			batchOp(opMove, "b.txt", "c.txt"),
+		}, []error{nil, os.ErrNotExist, nil, os.ErrNotExist}},
+		{"copy from moved", []*grpc.BatchOperation{
+			batchOp(opMove, "dir", "moved"),
+			batchOp(opCopy, "moved/c.txt", "c2.txt"),
+			batchOp(opCopy, "dir/c.txt", "c3.txt"),
+			batchOp(opCopy, "c2.txt", "moved/c.txt"),
+		}, []error{nil, nil, os.ErrNotExist, os.ErrExist}},
+		{"created folder is empty", []*grpc.BatchOperation{
+			batchOp(opMkdir, "", "new"),
+			batchOp(opCopy, "new/c.txt", "c2.txt"),
+			batchOp(opMkdir, "", "new"),
+		}, []error{nil, os.ErrNotExist, os.ErrExist}},
+		{"delete then reuse the name", []*grpc.BatchOperation{
+			batchOp(opMove, "a.txt", "dir"),
+			batchOp(opDelete, "dir", ""),
+			batchOp(opMove, "a.txt", "dir"),
+			batchOp(opMkdir, "", "dir/sub"),
+		}, []error{os.ErrExist, nil, nil, os.ErrNotExist}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestBatchService(t)
+			before := listTree(t, s.PathistFolder)
+			planner := &batchPlanner{s: s, overlay: map[string]batchEntry{}}
+			for i, op := range tt.ops {
+				err := planner.plan(op)
+				if !errors.Is(err, tt.wants[i]) {
+					t.Errorf("plan(%d) error = %v, want %v", i, err, tt.wants[i])
+				}
+			}
+
+			// 検証ではファイルを変更しない
+			if after := listTree(t, s.PathistFolder); !slices.Equal(after, before) {
+				t.Errorf("tree = %v, want %v", after, before)
+			}
+		})
+	}
+}
+
+// 完了した操作を逆順に取り消し、元の状態に戻す
+func TestRollbackBatch(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []*grpc.BatchOperation
+	}{
+		{"mkdir then move into", []*grpc.BatchOperation{
+			batchOp(opMkdir, "", "new"),
+			batchOp(opMove, "a.txt", "new/a.txt"),
+		}},
+		{"move then delete", []*grpc.BatchOperation{
+			batchOp(opMove, "a.txt", "b.txt"),
+			batchOp(opDelete, "b.txt", ""),
+		}},
+		{"copy from moved", []*grpc.BatchOperation{
+			batchOp(opMove, "dir", "moved"),
+			batchOp(opCopy, "moved/c.txt", "c2.txt"),
+		}},
+		{"copy into created folder then delete source", []*grpc.BatchOperation{
+			batchOp(opMkdir, "", "new"),
+			batchOp(opCopy, "dir", "new/dir"),
+			batchOp(opDelete, "dir", ""),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestBatchService(t)
+			before := listTree(t, s.PathistFolder)
+			journal, err := core.NewBatchJournal(s.batchFolder, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, op := range tt.ops {
+				if err := s.executeBatchOperation(context.Background(), journal, op); err != nil {
+					t.Fatalf("executeBatchOperation(%d) error = %v", i, err)
+				}
+			}
+
+			// 削除はゴミ箱の識別子と共に保存済み
+			saved, err := core.ListBatchJournals(s.batchFolder)
+			if err != nil || len(saved) != 1 {
+				t.Fatalf("ListBatchJournals() = %v, %v", saved, err)
+			}
+			for i, step := range saved[0].Steps {
+				if !step.Done || (step.Op == core.BatchOpDelete) != (step.TrashId != "") {
+					t.Errorf("saved step %d = %+v", i, step)
+				}
+			}
+
+			if err := s.rollbackBatch(journal); err != nil {
+				t.Fatalf("rollbackBatch() error = %v", err)
+			}
+			for i, step := range journal.Steps {
+				if !step.RolledBack {
+					t.Errorf("step %d was not rolled back: %+v", i, step)
+				}
+			}
+			if after := listTree(t, s.PathistFolder); !slices.Equal(after, before) {
+				t.Errorf("tree = %v, want %v", after, before)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+)
+
+// ExportHTTPPath はZIPエクスポート用HTTPハンドラーのパスです
+const ExportHTTPPath = "/export"
+
+// exportDefaultArchiveName は複数のパスをまとめた場合のZIPファイル名
+const exportDefaultArchiveName = "export.zip"
+
+// ExportArchive は指定したフォルダー・ファイル、または工事・会社のフォルダーをZIPにまとめて送信します。
+//   - ZIP全体をメモリに保持せず、書き込みながら chunk_size 毎に送信します。
+//   - 最初のメッセージで archive_name を、最後のメッセージで done と件数を返します。
+//   - 除外ルール（DefaultIgnorePatterns と各フォルダーの .pathistignore）に一致するものは含めません。
+//
+// gRPCサービスの実装です
+func (s *FileService) ExportArchive(
+	ctx context.Context, req *grpc.ExportArchiveRequest,
+	stream *connect.ServerStream[grpc.ExportArchiveResponse]) error {
+
+	roots, err := s.exportRootsFrom(req.GetPathistFolders(), req.GetKojiId(), req.GetCompanyId())
+	if err != nil {
+		return err
+	}
+	entries, err := s.exportEntriesOf(ctx, roots)
+	if err != nil {
+		return connectError(err, connect.CodeInternal)
+	}
+	chunkSize := int(req.GetChunkSize())
+	if chunkSize <= 0 {
+		chunkSize = downloadDefaultChunkSize
+	}
+	chunkSize = min(chunkSize, downloadMaxChunkSize)
+
+	// チャンク毎に送信、最初のメッセージにはZIPファイル名を含める
+	archiveName := exportArchiveNameFrom(req.GetArchiveName(), roots)
+	first := true
+	writer := &chunkWriter{
+		buf: make([]byte, 0, chunkSize),
+		send: func(data []byte) error {
+			res := grpc.ExportArchiveResponse_builder{Data: data}.Build()
+			if first {
+				res.SetArchiveName(archiveName)
+				first = false
+			}
+			return stream.Send(res)
+		},
+	}
+
+	stats, err := core.WriteZipArchive(ctx, writer, entries)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		return connectError(err, connect.CodeInternal)
+	}
+
+	res := grpc.ExportArchiveResponse_builder{
+		Done:         true,
+		FileCount:    int32(stats.Files),
+		SkippedCount: int32(stats.Skipped),
+		TotalBytes:   stats.Bytes,
+	}.Build()
+	if first {
+		res.SetArchiveName(archiveName)
+	}
+	return stream.Send(res)
+}
+
+// ServeExport は /export?path=<相対パス>&koji_id=<工事ID>&company_id=<会社ID>&name=<ファイル名> の
+// ZIPを通常のHTTPで配信します。path は複数指定できます。
+// ZIPは書き込みながら送信するため Content-Length は返しません。
+func (s *FileService) ServeExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	roots, err := s.exportRootsFrom(query["path"], query.Get("koji_id"), query.Get("company_id"))
+	if err != nil {
+		status := httpStatusFrom(err)
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			status = httpStatusFromConnect(connectErr.Code())
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	entries, err := s.exportEntriesOf(r.Context(), roots)
+	if err != nil {
+		http.Error(w, http.StatusText(httpStatusFrom(err)), httpStatusFrom(err))
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "application/zip")
+	header.Set("Content-Disposition", contentDisposition("attachment", exportArchiveNameFrom(query.Get("name"), roots)))
+	header.Set("Cache-Control", "no-store")
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	stats, err := core.WriteZipArchive(r.Context(), w, entries)
+	if err != nil {
+		// 送信を開始した後はステータスを変更できないため、接続を切断して不完全なZIPであることを伝える
+		log.Printf("FileService: Failed to export archive: %v", err)
+		panic(http.ErrAbortHandler)
+	}
+	if stats.Skipped > 0 {
+		log.Printf("FileService: Skipped %d files while exporting archive", stats.Skipped)
+	}
+}
+
+// exportRootsFrom はエクスポートするフォルダー・ファイルの絶対パスを返します
+// 相対パスに加えて、工事ID・会社IDを指定した場合はその管理フォルダーを含めます
+func (s *FileService) exportRootsFrom(relPaths []string, kojiId, companyId string) ([]string, error) {
+	roots := make([]string, 0, len(relPaths)+2)
+	for _, relPath := range relPaths {
+		absPath, err := s.GetAbsPathFrom(relPath)
+		if err != nil {
+			return nil, connectError(err, connect.CodeInvalidArgument)
+		}
+		roots = append(roots, absPath)
+	}
+
+	if kojiId != "" {
+		folder, err := s.services.kojiFolderOf(kojiId)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, folder)
+	}
+	if companyId != "" {
+		folder, err := s.services.companyFolderOf(companyId)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, folder)
+	}
+
+	if len(roots) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("pathist_folders, koji_id or company_id is required"))
+	}
+	for _, root := range roots {
+		if err := s.jail.Verify(root); err != nil {
+			return nil, connectError(err, connect.CodePermissionDenied)
+		}
+	}
+	return roots, nil
+}
+
+// exportEntriesOf は各フォルダー・ファイルをZIPの最上位に名前で格納するエントリーを返します
+// 名前が重複する場合は " (2)" 等を付けて区別します
+func (s *FileService) exportEntriesOf(ctx context.Context, roots []string) ([]core.ArchiveEntry, error) {
+	rules := core.NewIgnoreRules(s.PathistFolder, core.DefaultIgnorePatterns)
+	used := map[string]bool{}
+	entries := make([]core.ArchiveEntry, 0)
+	for _, root := range roots {
+		// ルートフォルダーまでの各フォルダーの除外ルールを適用
+		rootRules, err := rules.WithFolders(s.PathistFolder, filepath.Dir(root))
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(root)
+		if root == s.PathistFolder {
+			name = strings.TrimSuffix(exportDefaultArchiveName, ".zip")
+		}
+		ext := filepath.Ext(name)
+		stem := strings.TrimSuffix(name, ext)
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s (%d)%s", stem, n, ext)
+		}
+		used[name] = true
+
+		rootEntries, err := core.CollectArchiveEntries(ctx, root, name, rootRules)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, rootEntries...)
+	}
+	return entries, nil
+}
+
+// exportArchiveNameFrom はZIPファイル名を決定します
+// 未指定の場合は、1つのフォルダー・ファイルであればその名前、複数であれば export.zip とします
+func exportArchiveNameFrom(name string, roots []string) string {
+	name = strings.TrimSpace(filepath.Base(filepath.FromSlash(strings.TrimSpace(name))))
+	if name == "" || name == "." || name == string(filepath.Separator) {
+		name = exportDefaultArchiveName
+		if len(roots) == 1 {
+			name = filepath.Base(roots[0])
+		}
+	}
+	if !strings.EqualFold(filepath.Ext(name), ".zip") {
+		name += ".zip"
+	}
+	return name
+}
+
+// httpStatusFromConnect は Connect のエラーコードをHTTPステータスコードに変換します
+func httpStatusFromConnect(code connect.Code) int {
+	switch code {
+	case connect.CodeInvalidArgument:
+		return http.StatusBadRequest
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeUnavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// chunkWriter は書き込まれたデータを一定サイズ毎にまとめて send に渡します
+type chunkWriter struct {
+	buf  []byte
+	send func(data []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), cap(w.buf)-len(w.buf))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == cap(w.buf) {
+			if err := w.Flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Flush は残っているデータを send に渡します
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	// send 側で保持されても上書きしないよう新しいバッファに切り替える
+	data := w.buf
+	w.buf = make([]byte, 0, cap(data))
+	return w.send(data)
+}
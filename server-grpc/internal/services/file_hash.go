@@ -0,0 +1,195 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"os"
+	"slices"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+)
+
+// hashedFile はハッシュを計算したファイルです
+type hashedFile struct {
+	absPath string
+	size    int64
+	digest  string
+}
+
+// FindDuplicates は指定フォルダー配下で内容が同一のファイルをグループにして返します
+// min_size 未満のファイルは対象外です（未指定の場合は空ファイルのみ除外）
+// gRPCサービスの実装です
+func (s *FileService) FindDuplicates(
+	ctx context.Context, req *grpc.FindDuplicatesRequest) (
+	*grpc.FindDuplicatesResponse, error) {
+
+	absPath, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	minSize := max(req.GetMinSize(), 1)
+
+	// 配下の通常ファイルを取得
+	entries, err := collectEntries(ctx, absPath, fileQueryMaxDepth)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	files := regularFilesOf(entries, minSize)
+
+	// サイズが同じファイルが存在するものだけハッシュを計算
+	sizeCounts := map[int64]int{}
+	for _, file := range files {
+		sizeCounts[file.size]++
+	}
+	files = slices.DeleteFunc(files, func(file hashedFile) bool {
+		return sizeCounts[file.size] < 2
+	})
+	hashed, err := s.hashFiles(ctx, files)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, connectError(ctxErr, connect.CodeCanceled)
+	}
+	if err != nil {
+		log.Printf("FileService: Failed to hash some files in %s: %v", absPath, err)
+	}
+
+	// ハッシュでグループ化
+	byDigest := map[string][]hashedFile{}
+	for _, file := range hashed {
+		byDigest[file.digest] = append(byDigest[file.digest], file)
+	}
+
+	groups := make([]*grpc.DuplicateGroup, 0)
+	totalWasted := int64(0)
+	for digest, members := range byDigest {
+		if len(members) < 2 {
+			continue
+		}
+		slices.SortFunc(members, func(a, b hashedFile) int {
+			return cmp.Compare(a.absPath, b.absPath)
+		})
+
+		groupFiles := make([]*grpc.File, 0, len(members))
+		for _, member := range members {
+			fi := models.NewFile()
+			if err := fi.ParseFrom(s.PathistFolder, member.absPath); err != nil {
+				continue
+			}
+			groupFiles = append(groupFiles, fi.File)
+		}
+		if len(groupFiles) < 2 {
+			continue
+		}
+
+		wasted := members[0].size * int64(len(groupFiles)-1)
+		totalWasted += wasted
+		groups = append(groups, grpc.DuplicateGroup_builder{
+			Digest:      digest,
+			Size:        members[0].size,
+			Files:       groupFiles,
+			WastedBytes: wasted,
+		}.Build())
+	}
+
+	// 無駄になっている容量の大きい順
+	slices.SortFunc(groups, func(a, b *grpc.DuplicateGroup) int {
+		if c := cmp.Compare(b.GetWastedBytes(), a.GetWastedBytes()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GetDigest(), b.GetDigest())
+	})
+
+	if err := s.hashes.Save(); err != nil {
+		log.Printf("FileService: Failed to save hash cache: %v", err)
+	}
+
+	res := grpc.FindDuplicatesResponse_builder{}.Build()
+	res.SetGroups(groups)
+	res.SetWastedBytes(totalWasted)
+	res.SetScannedCount(int32(len(hashed)))
+	return res, nil
+}
+
+// hashLoop はルートフォルダー配下のファイル内容のハッシュを定期的に計算します
+// 事前に計算しておくことで FindDuplicates はキャッシュから応答できます
+func (s *FileService) hashLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.scanHashes(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("FileService: Failed to scan hashes: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scanHashes はルートフォルダー配下の全ての通常ファイルのハッシュを計算し、
+// 存在しなくなったファイルのキャッシュを削除して保存します
+func (s *FileService) scanHashes(ctx context.Context) error {
+	entries, err := collectEntries(ctx, s.PathistFolder, fileQueryMaxDepth)
+	if err != nil {
+		return err
+	}
+	files := regularFilesOf(entries, 1)
+
+	_, err = s.hashFiles(ctx, files)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if err != nil {
+		log.Printf("FileService: Failed to hash some files: %v", err)
+	}
+
+	// 走査で見つからなかったファイルのキャッシュを削除
+	exists := make(map[string]bool, len(files))
+	for _, file := range files {
+		exists[file.absPath] = true
+	}
+	s.hashes.Prune(func(path string) bool {
+		return exists[path]
+	})
+
+	return s.hashes.Save()
+}
+
+// hashFiles はファイル内容のハッシュを並列に計算します
+// ハッシュを計算できなかったファイルは結果から除外します
+func (s *FileService) hashFiles(ctx context.Context, files []hashedFile) ([]hashedFile, error) {
+	return core.ParallelMap(ctx, files,
+		func(_ context.Context, file hashedFile) (hashedFile, error) {
+			digest, err := s.hashes.Digest(file.absPath)
+			if err != nil {
+				return hashedFile{}, err
+			}
+			file.digest = digest
+			return file, nil
+		})
+}
+
+// regularFilesOf はエントリーのうち minSize 以上の通常ファイルを返します
+// シンボリックリンクはリンク先の重複を避けるため対象外です
+func regularFilesOf(entries []fileEntry, minSize int64) []hashedFile {
+	files := make([]hashedFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.isDir {
+			continue
+		}
+		fi, err := os.Lstat(entry.absPath)
+		if err != nil || !fi.Mode().IsRegular() || fi.Size() < minSize {
+			continue
+		}
+		files = append(files, hashedFile{absPath: entry.absPath, size: fi.Size()})
+	}
+	return files
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"server-grpc/internal/core"
+)
+
+// FilesHTTPPrefix はファイル配信用HTTPハンドラーのパス接頭辞です
+const FilesHTTPPrefix = "/files/"
+
+// ServeFiles は /files/<相対パス> のファイルを通常のHTTPで配信します。
+//   - Range, If-None-Match, If-Modified-Since 等の条件付きリクエストは http.ServeContent で処理します。
+//   - クエリ download=1 を指定した場合は添付ファイルとしてダウンロードさせます。
+//
+// パスは GetAbsPathFrom で解決するため、gRPC と同じ制限が適用されます。
+func (s *FileService) ServeFiles(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// 相対パスを取得
+	relPath := strings.TrimPrefix(r.URL.Path, FilesHTTPPrefix)
+	absPath, err := s.GetAbsPathFrom(relPath)
+	if err != nil {
+		http.Error(w, http.StatusText(httpStatusFrom(err)), httpStatusFrom(err))
+		return
+	}
+
+	// ファイルを開く
+	file, err := os.Open(absPath)
+	if err != nil {
+		http.Error(w, http.StatusText(httpStatusFrom(err)), httpStatusFrom(err))
+		return
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(httpStatusFrom(err)), httpStatusFrom(err))
+		return
+	}
+	if fi.IsDir() {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// キャッシュ検証用のヘッダーを設定
+	disposition := "inline"
+	if download, _ := strconv.ParseBool(r.URL.Query().Get("download")); download {
+		disposition = "attachment"
+	}
+	header := w.Header()
+	header.Set("ETag", fmt.Sprintf(`"%x-%x"`, fi.Size(), fi.ModTime().UnixNano()))
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Content-Disposition", contentDisposition(disposition, fi.Name()))
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), file)
+}
+
+// httpStatusFrom はエラーをHTTPステータスコードに変換します
+func httpStatusFrom(err error) int {
+	switch {
+	case errors.Is(err, core.ErrPathOutsideRoot), errors.Is(err, core.ErrSystemPath), errors.Is(err, os.ErrPermission):
+		return http.StatusForbidden
+	case errors.Is(err, os.ErrNotExist):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// contentDisposition は日本語等の非ASCIIファイル名を RFC 6266 / RFC 5987 形式で表した
+// Content-Disposition ヘッダーの値を返します。
+// filename には ASCII の代替名、filename* には UTF-8 でエンコードした名前を設定します。
+func contentDisposition(disposition, filename string) string {
+	var fallback, encoded strings.Builder
+	for _, r := range filename {
+		if r < 0x20 || r >= 0x7f || r == '"' || r == '\\' {
+			fallback.WriteByte('_')
+		} else {
+			fallback.WriteRune(r)
+		}
+	}
+	for _, b := range []byte(filename) {
+		if isRFC5987AttrChar(b) {
+			encoded.WriteByte(b)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return fmt.Sprintf(`%s; filename="%s"; filename*=UTF-8''%s`, disposition, fallback.String(), encoded.String())
+}
+
+// isRFC5987AttrChar は b が RFC 5987 の attr-char かどうかを判定します
+func isRFC5987AttrChar(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&+-.^_`|~", b) >= 0
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+)
+
+// NormalizeFolderNames は名前の表記が統一されていないフォルダーを検出し、統一後の名前に変更します。
+//   - macOS で作成された NFD の名前、全角数字・全角スペースを含む名前などが対象です（core.NormalizeName）。
+//   - dry_run を指定した場合は検出結果のみを返し、フォルダー名は変更しません。
+//   - 統一後の名前のフォルダーが既に存在する場合、または統一後の名前が使用できない場合は変更せず conflict を返します。
+//
+// 会社・工事の ID は統一後の名前から生成するため、名前を変更しても ID は変わりません。
+// gRPCサービスの実装です
+func (s *FileService) NormalizeFolderNames(
+	ctx context.Context, req *grpc.NormalizeFolderNamesRequest) (
+	*grpc.NormalizeFolderNamesResponse, error) {
+
+	absPath, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	if err := s.jail.Verify(absPath); err != nil {
+		return nil, connectError(err, connect.CodePermissionDenied)
+	}
+
+	issues, err := core.ScanNameIssues(ctx, absPath)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 深い階層から順に名前を変更
+	results := make([]*grpc.FolderNameIssue, 0, len(issues))
+	renamed := 0
+	for i := range issues {
+		var err error
+		if !req.GetDryRun() && !issues[i].Conflict {
+			if err = ctx.Err(); err == nil {
+				err = s.renameNameIssue(&issues[i])
+			}
+		}
+		if issues[i].Renamed {
+			renamed++
+		}
+		results = append(results, s.newFolderNameIssue(issues[i], err))
+	}
+	if renamed > 0 {
+		log.Printf("FileService: Normalized %d folder names under %s", renamed, absPath)
+	}
+
+	res := grpc.NormalizeFolderNamesResponse_builder{}.Build()
+	res.SetIssues(results)
+	res.SetRenamedCount(int32(renamed))
+	return res, nil
+}
+
+// renameNameIssue は親フォルダーのロックを取得してフォルダー名を変更します
+func (s *FileService) renameNameIssue(issue *core.NameIssue) error {
+	locks, err := s.lockParentsOf("FileService.NormalizeFolderNames", issue.Path)
+	if err != nil {
+		return err
+	}
+	defer locks.Release()
+
+	return core.FixNameIssue(issue)
+}
+
+// newFolderNameIssue は検出結果を gRPC のメッセージに変換します
+func (s *FileService) newFolderNameIssue(issue core.NameIssue, err error) *grpc.FolderNameIssue {
+	result := grpc.FolderNameIssue_builder{
+		RelativePath:   s.relPathFrom(issue.Path),
+		Name:           issue.Name,
+		NormalizedName: issue.Normalized,
+		Conflict:       issue.Conflict,
+		Renamed:        issue.Renamed,
+	}.Build()
+
+	if err != nil {
+		var connectErr *connect.Error
+		errors.As(connectError(err, connect.CodeInternal), &connectErr)
+		result.SetError(grpc.FileOperationError_builder{
+			Code:    connectErr.Code().String(),
+			Message: connectErr.Message(),
+		}.Build())
+	}
+	return result
+}
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+)
+
+// renameSuffixMax は OVERWRITE_POLICY_RENAME で試行する連番の上限
+const renameSuffixMax = 1000
+
+// overwriteTempPrefix は上書きするコピー・移動先の隣に一時的に配置する際の名前の接頭辞
+const overwriteTempPrefix = core.PathistSystemPrefix + "-overwrite-"
+
+// overwrittenBy は上書きによりゴミ箱に移動したエントリーの削除者
+const overwrittenBy = "FileService.Overwrite"
+
+// CopyFiles は複数のファイル・フォルダーをコピーします
+// gRPCサービスの実装です
+func (s *FileService) CopyFiles(
+	ctx context.Context, req *grpc.CopyFilesRequest) (
+	*grpc.CopyFilesResponse, error) {
+
+	results, err := s.transferFiles(ctx, req.GetItems(), req.GetOverwritePolicy(), s.copyWithPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	res := grpc.CopyFilesResponse_builder{}.Build()
+	res.SetResults(results)
+	return res, nil
+}
+
+// MoveFiles は複数のファイル・フォルダーを移動します
+// gRPCサービスの実装です
+func (s *FileService) MoveFiles(
+	ctx context.Context, req *grpc.MoveFilesRequest) (
+	*grpc.MoveFilesResponse, error) {
+
+	results, err := s.transferFiles(ctx, req.GetItems(), req.GetOverwritePolicy(), s.moveWithPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	res := grpc.MoveFilesResponse_builder{}.Build()
+	res.SetResults(results)
+	return res, nil
+}
+
+// DeleteFiles は複数のファイル・フォルダーを削除します
+// gRPCサービスの実装です
+func (s *FileService) DeleteFiles(
+	ctx context.Context, req *grpc.DeleteFilesRequest) (
+	*grpc.DeleteFilesResponse, error) {
+
+	results := make([]*grpc.FileOperationResult, 0, len(req.GetPathistFolders()))
+	for _, relPath := range req.GetPathistFolders() {
+		// キャンセルされた場合は残りを処理しない
+		if err := ctx.Err(); err != nil {
+			return nil, connectError(err, connect.CodeCanceled)
+		}
+
+		err := s.DeleteFile(relPath, requestActor(ctx))
+		results = append(results, newFileOperationResult(relPath, "", false, err))
+	}
+
+	res := grpc.DeleteFilesResponse_builder{}.Build()
+	res.SetResults(results)
+	return res, nil
+}
+
+// CreateFolder はフォルダーを作成します
+// gRPCサービスの実装です
+func (s *FileService) CreateFolder(
+	_ context.Context, req *grpc.CreateFolderRequest) (
+	*grpc.CreateFolderResponse, error) {
+
+	absPath, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	if absPath == s.PathistFolder {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("pathist_folder is required"))
+	}
+
+	// 親フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.CreateFolder", absPath)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer locks.Release()
+
+	// 既に存在する場合はエラー
+	if _, err := os.Lstat(absPath); err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("フォルダーが既に存在します: "+req.GetPathistFolder()))
+	}
+
+	// フォルダーの作成
+	if req.GetParents() {
+		err = os.MkdirAll(absPath, 0755)
+	} else {
+		err = os.Mkdir(absPath, 0755)
+	}
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 作成したフォルダー情報を返す
+	folder := models.NewFile()
+	if err := folder.ParseFrom(s.PathistFolder, absPath); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	res := grpc.CreateFolderResponse_builder{}.Build()
+	res.SetFolder(folder.File)
+	return res, nil
+}
+
+// transferFunc はコピー・移動の1件分の処理を表す関数型です
+// 戻り値 relDst は実際のコピー・移動先、skipped は処理を行わなかったことを示します
+type transferFunc func(relSrc, relDst string, policy grpc.OverwritePolicy) (string, bool, error)
+
+// transferFiles はコピー・移動を順番に実行して要素毎の結果を返します
+func (s *FileService) transferFiles(
+	ctx context.Context,
+	items []*grpc.FileTransfer,
+	policy grpc.OverwritePolicy,
+	transfer transferFunc) ([]*grpc.FileOperationResult, error) {
+
+	results := make([]*grpc.FileOperationResult, 0, len(items))
+	for _, item := range items {
+		// キャンセルされた場合は残りを処理しない
+		if err := ctx.Err(); err != nil {
+			return nil, connectError(err, connect.CodeCanceled)
+		}
+
+		relDst, skipped, err := transfer(item.GetSrc(), item.GetDst(), policy)
+		if relDst == "" {
+			relDst = item.GetDst()
+		}
+		results = append(results, newFileOperationResult(item.GetSrc(), relDst, skipped, err))
+	}
+	return results, nil
+}
+
+// copyWithPolicy は上書きポリシーに従ってファイルまたはディレクトリをコピーします
+func (s *FileService) copyWithPolicy(relSrc, relDst string, policy grpc.OverwritePolicy) (string, bool, error) {
+	absSrc, err := s.GetAbsPathFrom(relSrc)
+	if err != nil {
+		return "", false, err
+	}
+	absDst, err := s.GetAbsPathFrom(relDst)
+	if err != nil {
+		return "", false, err
+	}
+	if err := checkTransferPaths(absSrc, absDst); err != nil {
+		return "", false, err
+	}
+
+	// コピー元の存在確認
+	srcOsFi, err := os.Stat(absSrc)
+	if err != nil {
+		return "", false, err
+	}
+
+	// コピー先フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.CopyFiles", absDst)
+	if err != nil {
+		return "", false, err
+	}
+	defer locks.Release()
+
+	// 上書きポリシーに従ってコピー先を決定
+	absDst, skip, err := s.resolveDestination(absDst, policy)
+	if err != nil || skip {
+		return s.relPathFrom(absDst), skip, err
+	}
+
+	err = s.placeAt(absDst, func(absPath string) error {
+		if srcOsFi.IsDir() {
+			return s.absCopyDir(absSrc, absPath)
+		}
+		return s.absCopyFile(absSrc, absPath)
+	}, os.RemoveAll)
+	return s.relPathFrom(absDst), false, err
+}
+
+// moveWithPolicy は上書きポリシーに従ってファイルまたはディレクトリを移動します
+func (s *FileService) moveWithPolicy(relSrc, relDst string, policy grpc.OverwritePolicy) (string, bool, error) {
+	absSrc, err := s.GetAbsPathFrom(relSrc)
+	if err != nil {
+		return "", false, err
+	}
+	absDst, err := s.GetAbsPathFrom(relDst)
+	if err != nil {
+		return "", false, err
+	}
+	if err := checkTransferPaths(absSrc, absDst); err != nil {
+		return "", false, err
+	}
+
+	// 移動元の存在確認
+	if _, err := os.Stat(absSrc); err != nil {
+		return "", false, err
+	}
+
+	// 移動元と移動先の親フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.MoveFiles", absSrc, absDst)
+	if err != nil {
+		return "", false, err
+	}
+	defer locks.Release()
+
+	// 上書きポリシーに従って移動先を決定
+	absDst, skip, err := s.resolveDestination(absDst, policy)
+	if err != nil || skip {
+		return s.relPathFrom(absDst), skip, err
+	}
+
+	// 移動先の親ディレクトリを作成（必要に応じて）
+	if err := os.MkdirAll(filepath.Dir(absDst), 0755); err != nil {
+		return "", false, err
+	}
+	err = s.placeAt(absDst, func(absPath string) error {
+		return os.Rename(absSrc, absPath)
+	}, func(absPath string) error {
+		return os.Rename(absPath, absSrc)
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return s.relPathFrom(absDst), false, nil
+}
+
+// resolveDestination は上書きポリシーに従ってコピー・移動先を決定します
+// 戻り値 skip が true の場合は処理を行いません
+func (s *FileService) resolveDestination(absDst string, policy grpc.OverwritePolicy) (string, bool, error) {
+	// 移動先が存在しない場合はそのまま
+	if _, err := os.Lstat(absDst); os.IsNotExist(err) {
+		return absDst, false, nil
+	} else if err != nil {
+		return absDst, false, err
+	}
+
+	switch policy {
+	case grpc.OverwritePolicy_OVERWRITE_POLICY_SKIP:
+		return absDst, true, nil
+
+	case grpc.OverwritePolicy_OVERWRITE_POLICY_OVERWRITE:
+		// 既存のものは placeAt で配置に成功した後に置き換える
+		return absDst, false, nil
+
+	case grpc.OverwritePolicy_OVERWRITE_POLICY_RENAME:
+		// "名前 (n).拡張子" 形式で空いている名前を探す
+		dir := filepath.Dir(absDst)
+		ext := filepath.Ext(absDst)
+		stem := strings.TrimSuffix(filepath.Base(absDst), ext)
+		for n := 1; n <= renameSuffixMax; n++ {
+			candidate := filepath.Join(dir, fmt.Sprintf("%s (%d)%s", stem, n, ext))
+			if _, err := os.Lstat(candidate); os.IsNotExist(err) {
+				return candidate, false, nil
+			}
+		}
+		return absDst, false, fmt.Errorf("%w: 空いている名前が見つかりません: %s", os.ErrExist, filepath.Base(absDst))
+	}
+
+	// OVERWRITE_POLICY_FAIL 及び未指定の場合はエラー
+	return absDst, false, fmt.Errorf("%w: %s", os.ErrExist, filepath.Base(absDst))
+}
+
+// placeAt は place で absDst にファイルまたはフォルダーを配置します。
+//   - absDst が存在しない場合はそのまま配置します。
+//   - absDst が存在する場合は隣の一時的な名前に配置し、成功した後に既存のものと置き換えます。
+//   - 置き換えに失敗した場合は既存のものを元に戻し、配置したものを unplace で取り除きます。
+func (s *FileService) placeAt(absDst string, place, unplace func(absPath string) error) error {
+	if _, err := os.Lstat(absDst); os.IsNotExist(err) {
+		return place(absDst)
+	} else if err != nil {
+		return err
+	}
+
+	// 既存のものを残したまま一時的な名前に配置
+	random := make([]byte, 8)
+	if _, err := rand.Read(random); err != nil {
+		return err
+	}
+	absTemp := filepath.Join(filepath.Dir(absDst), overwriteTempPrefix+hex.EncodeToString(random))
+	if err := place(absTemp); err != nil {
+		if removeErr := os.RemoveAll(absTemp); removeErr != nil {
+			log.Printf("FileService: Failed to remove %s: %v", absTemp, removeErr)
+		}
+		return err
+	}
+
+	// 既存のものを退避して置き換える
+	restore, err := s.setAside(absDst)
+	if err == nil {
+		if err = os.Rename(absTemp, absDst); err != nil {
+			restore()
+		}
+	}
+	if err != nil {
+		if undoErr := unplace(absTemp); undoErr != nil {
+			log.Printf("FileService: Failed to undo %s: %v", absTemp, undoErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// setAside は置き換える absPath をファイルの場合は過去の版として保存し、
+// それ以外（フォルダー、または過去の版を保存しない設定の場合）はゴミ箱に移動します。
+// 戻り値 restore は退避したものを absPath に戻します
+func (s *FileService) setAside(absPath string) (restore func(), err error) {
+	saved, err := s.saveVersion(absPath, core.VersionReasonOverwrite)
+	if err != nil {
+		return nil, err
+	}
+	if saved.Id != "" {
+		return func() {
+			if _, err := s.versions.Restore(saved.OriginalPath, saved.Id, absPath); err != nil {
+				log.Printf("FileService: Failed to restore %s from file versions: %v", absPath, err)
+			}
+		}, nil
+	}
+	entry, err := s.trash.Put(absPath, s.relPathFrom(absPath), overwrittenBy)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		if _, err := s.trash.Restore(entry.Id, absPath); err != nil {
+			log.Printf("FileService: Failed to restore %s from trash: %v", absPath, err)
+		}
+	}, nil
+}
+
+// relPathFrom は PathistFolder からの相対パスを返します
+func (s *FileService) relPathFrom(absPath string) string {
+	rel, err := filepath.Rel(s.PathistFolder, absPath)
+	if err != nil {
+		return absPath
+	}
+	return filepath.ToSlash(rel)
+}
+
+// checkTransferPaths はコピー・移動元と先の組み合わせが有効かをチェックします
+func checkTransferPaths(absSrc, absDst string) error {
+	if absSrc == absDst {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("コピー・移動元と先が同じです"))
+	}
+	// フォルダーを自身の配下にコピー・移動することはできない
+	if strings.HasPrefix(absDst, absSrc+string(os.PathSeparator)) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("フォルダーを自身の配下にコピー・移動することはできません"))
+	}
+	return nil
+}
+
+// newFileOperationResult は1件分の操作結果を作成します
+func newFileOperationResult(relSrc, relDst string, skipped bool, err error) *grpc.FileOperationResult {
+	result := grpc.FileOperationResult_builder{
+		Src:     relSrc,
+		Dst:     relDst,
+		Ok:      err == nil,
+		Skipped: skipped,
+	}.Build()
+
+	if err != nil {
+		var connectErr *connect.Error
+		errors.As(connectError(err, connect.CodeInternal), &connectErr)
+		result.SetError(grpc.FileOperationError_builder{
+			Code:    connectErr.Code().String(),
+			Message: connectErr.Message(),
+		}.Build())
+	}
+	return result
+}
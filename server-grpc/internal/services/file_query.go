@@ -0,0 +1,394 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// fileQueryDefaultPageSize は GetFiles で page_size 未指定時に返す最大件数
+const fileQueryDefaultPageSize = 1000
+
+// fileQueryMaxPageSize は GetFiles で一度に返す最大件数
+const fileQueryMaxPageSize = 10000
+
+// fileQueryMaxDepth は GetFiles で再帰する最大の深さ
+const fileQueryMaxDepth = 32
+
+// fileListingExpire は GetFiles の一覧を最後に参照してから破棄するまでの期間
+const fileListingExpire = 10 * time.Minute
+
+// fileListingMaxCount は保持する GetFiles の一覧の最大数（超えた場合は最後に参照した日時の古いものから破棄）
+const fileListingMaxCount = 16
+
+// fileEntry は走査で見つかったファイル・フォルダーです
+type fileEntry struct {
+	// absPath はエントリーの絶対パス
+	absPath string
+
+	// isDir はフォルダーかどうか（シンボリックリンクは辿らない）
+	isDir bool
+}
+
+// fileListItem は絞り込み・並べ替え用のファイル情報です
+// 重い models.File の作成（MIMEタイプの判定等）はページ分割後の項目のみに行います
+type fileListItem struct {
+	absPath string
+	name    string
+
+	// isDir, size, modTime はシンボリックリンクの場合はリンク先（リンク切れの場合はリンク自身）の情報
+	isDir   bool
+	size    int64
+	modTime time.Time
+}
+
+// fileQuery は GetFiles のリクエストから作成した検索条件です
+type fileQuery struct {
+	depth        int
+	globs        []string
+	extensions   []string
+	minSize      int64
+	maxSize      int64
+	after        time.Time
+	before       time.Time
+	sortKey      grpc.FileSortKey
+	descending   bool
+	foldersFirst bool
+	pageSize     int
+
+	// listing, offset はページトークンが指す一覧と開始位置（最初のページでは空と 0）
+	listing string
+	offset  int
+
+	// fingerprint はページトークンが同じ検索条件で発行されたかを確認するための値
+	fingerprint string
+}
+
+// newFileQuery はリクエストから検索条件を作成します
+func newFileQuery(req *grpc.GetFilesRequest) (*fileQuery, error) {
+	q := &fileQuery{
+		minSize:      req.GetMinSize(),
+		maxSize:      req.GetMaxSize(),
+		sortKey:      req.GetSortKey(),
+		descending:   req.GetDescending(),
+		foldersFirst: req.GetFoldersFirst(),
+	}
+
+	// 再帰の深さ
+	switch depth := int(req.GetDepth()); {
+	case depth < 0:
+		q.depth = fileQueryMaxDepth
+	case depth == 0:
+		q.depth = 1
+	default:
+		q.depth = min(depth, fileQueryMaxDepth)
+	}
+
+	// ファイル名のパターン
+	for _, glob := range req.GetGlobs() {
+		if _, err := filepath.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", glob, err)
+		}
+		q.globs = append(q.globs, glob)
+	}
+
+	// 拡張子（大文字小文字を区別せず、先頭のドットは省略可）
+	for _, ext := range req.GetExtensions() {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if ext == "" {
+			continue
+		}
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		q.extensions = append(q.extensions, ext)
+	}
+
+	// サイズと更新日時の範囲
+	if q.minSize < 0 || q.maxSize < 0 || (q.maxSize > 0 && q.minSize > q.maxSize) {
+		return nil, errors.New("invalid size range")
+	}
+	if req.HasModifiedAfter() {
+		q.after = req.GetModifiedAfter().AsTime()
+	}
+	if req.HasModifiedBefore() {
+		q.before = req.GetModifiedBefore().AsTime()
+	}
+
+	// ページサイズ
+	q.pageSize = int(req.GetPageSize())
+	if q.pageSize <= 0 {
+		q.pageSize = fileQueryDefaultPageSize
+	}
+	q.pageSize = min(q.pageSize, fileQueryMaxPageSize)
+
+	// ページトークン
+	fingerprint, err := fileQueryFingerprint(req)
+	if err != nil {
+		return nil, err
+	}
+	q.fingerprint = fingerprint
+	if token := req.GetPageToken(); token != "" {
+		listing, offset, err := q.parsePageToken(token)
+		if err != nil {
+			return nil, err
+		}
+		q.listing = listing
+		q.offset = offset
+	}
+	return q, nil
+}
+
+// matchName はファイル名で判定できる条件に一致するかを返します
+// 拡張子が指定された場合、フォルダーは一致しません
+func (q *fileQuery) matchName(name string, isDir bool) bool {
+	if len(q.globs) > 0 && !slices.ContainsFunc(q.globs, func(glob string) bool {
+		matched, _ := filepath.Match(glob, name)
+		return matched
+	}) {
+		return false
+	}
+	if len(q.extensions) > 0 {
+		if isDir || !slices.Contains(q.extensions, strings.ToLower(filepath.Ext(name))) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchInfo はファイル情報で判定する条件に一致するかを返します
+// サイズの範囲が指定された場合、フォルダーは一致しません
+func (q *fileQuery) matchInfo(item fileListItem) bool {
+	if q.minSize > 0 || q.maxSize > 0 {
+		if item.isDir || item.size < q.minSize || (q.maxSize > 0 && item.size > q.maxSize) {
+			return false
+		}
+	}
+	if !q.after.IsZero() && item.modTime.Before(q.after) {
+		return false
+	}
+	if !q.before.IsZero() && !item.modTime.Before(q.before) {
+		return false
+	}
+	return true
+}
+
+// sort は検索条件に従って並べ替えます
+// 同順位の場合はパスで並べるため、ページ間で順序が安定します
+func (q *fileQuery) sort(items []fileListItem) {
+	slices.SortFunc(items, func(a, b fileListItem) int {
+		// フォルダーを先頭にする場合は並び順に関係なく先頭
+		if q.foldersFirst && a.isDir != b.isDir {
+			if a.isDir {
+				return -1
+			}
+			return 1
+		}
+
+		var c int
+		switch q.sortKey {
+		case grpc.FileSortKey_FILE_SORT_KEY_SIZE:
+			c = cmp.Compare(a.size, b.size)
+		case grpc.FileSortKey_FILE_SORT_KEY_MODIFIED_TIME:
+			c = a.modTime.Compare(b.modTime)
+		case grpc.FileSortKey_FILE_SORT_KEY_PATH:
+			c = 0
+		default:
+			c = strings.Compare(a.name, b.name)
+		}
+		if c == 0 {
+			c = strings.Compare(a.absPath, b.absPath)
+		}
+		if q.descending {
+			c = -c
+		}
+		return c
+	})
+}
+
+// page は offset から1ページ分を切り出し、次ページのトークンを返します
+// listing は次ページで参照する一覧の ID です
+func (q *fileQuery) page(items []fileListItem, listing string) ([]fileListItem, string) {
+	start := min(q.offset, len(items))
+	end := min(start+q.pageSize, len(items))
+	next := ""
+	if end < len(items) {
+		next = q.pageToken(listing, end)
+	}
+	return items[start:end], next
+}
+
+// pageToken は一覧の ID と offset を検索条件と共にエンコードしたページトークンを返します
+func (q *fileQuery) pageToken(listing string, offset int) string {
+	text := listing + ":" + strconv.Itoa(offset) + ":" + q.fingerprint
+	return base64.RawURLEncoding.EncodeToString([]byte(text))
+}
+
+// parsePageToken はページトークンから一覧の ID と offset を取得します
+func (q *fileQuery) parsePageToken(token string) (string, int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, errors.New("invalid page_token")
+	}
+	parts := strings.SplitN(string(data), ":", 3)
+	if len(parts) != 3 || parts[2] != q.fingerprint {
+		return "", 0, errors.New("page_token does not match the request")
+	}
+	offset, err := strconv.Atoi(parts[1])
+	if err != nil || offset < 0 || parts[0] == "" {
+		return "", 0, errors.New("invalid page_token")
+	}
+	return parts[0], offset, nil
+}
+
+// fileQueryFingerprint はページに関する項目を除いた検索条件のハッシュを返します
+func fileQueryFingerprint(req *grpc.GetFilesRequest) (string, error) {
+	clone := proto.CloneOf(req)
+	clone.SetPageSize(0)
+	clone.SetPageToken("")
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+	return core.ParseIdFromBytes(data), nil
+}
+
+// errFileListingExpired はページトークンが指す一覧が破棄された場合のエラー
+var errFileListingExpired = errors.New("page_token has expired, request the first page again")
+
+// fileListing は GetFiles の最初のページで作成した、絞り込み・並べ替え済みの一覧です
+type fileListing struct {
+	items       []fileListItem
+	fingerprint string
+	lastUsed    time.Time
+}
+
+// fileListings は次ページの要求に備えて GetFiles の一覧を保持します
+// 次ページではフォルダーを走査し直さず、最初のページの時点の一覧からページを切り出します
+type fileListings struct {
+	mu       sync.Mutex
+	listings map[string]*fileListing
+}
+
+// newFileListings は空の一覧の保持領域を作成します
+func newFileListings() *fileListings {
+	return &fileListings{listings: make(map[string]*fileListing)}
+}
+
+// put は一覧を保持し、ページトークンに含める ID を返します
+// 期限切れの一覧を破棄し、上限を超える場合は最後に参照した日時の古いものから破棄します
+func (l *fileListings) put(fingerprint string, items []fileListItem) (string, error) {
+	random := make([]byte, 12)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(random)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	maps.DeleteFunc(l.listings, func(_ string, listing *fileListing) bool {
+		return now.Sub(listing.lastUsed) > fileListingExpire
+	})
+	for len(l.listings) >= fileListingMaxCount {
+		oldest := ""
+		for key, listing := range l.listings {
+			if oldest == "" || listing.lastUsed.Before(l.listings[oldest].lastUsed) {
+				oldest = key
+			}
+		}
+		delete(l.listings, oldest)
+	}
+	l.listings[id] = &fileListing{items: items, fingerprint: fingerprint, lastUsed: now}
+	return id, nil
+}
+
+// get は ID の一覧を返します
+// 破棄された場合や別の検索条件で作成された場合は errFileListingExpired を返します
+func (l *fileListings) get(id, fingerprint string) ([]fileListItem, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	listing, ok := l.listings[id]
+	if !ok || listing.fingerprint != fingerprint || now.Sub(listing.lastUsed) > fileListingExpire {
+		delete(l.listings, id)
+		return nil, errFileListingExpired
+	}
+	listing.lastUsed = now
+	return listing.items, nil
+}
+
+// statEntry は絞り込み・並べ替えに必要なファイル情報のみを取得します
+func statEntry(entry fileEntry) (fileListItem, error) {
+	fi, err := os.Lstat(entry.absPath)
+	if err != nil {
+		return fileListItem{}, err
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		// リンク切れの場合はリンク自身の情報を使用
+		if target, err := os.Stat(entry.absPath); err == nil {
+			fi = target
+		}
+	}
+	return fileListItem{
+		absPath: entry.absPath,
+		name:    fi.Name(),
+		isDir:   fi.IsDir(),
+		size:    fi.Size(),
+		modTime: fi.ModTime(),
+	}, nil
+}
+
+// collectEntries は absFolder 配下のエントリーを depth 階層まで走査します
+// 内部管理用のファイル・フォルダーは除外し、シンボリックリンクのフォルダーは辿りません
+func collectEntries(ctx context.Context, absFolder string, depth int) ([]fileEntry, error) {
+	entries := make([]fileEntry, 0)
+	folders := []string{absFolder}
+	for level := 0; level < depth && len(folders) > 0; level++ {
+		next := make([]string, 0)
+		for _, folder := range folders {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			dirs, err := os.ReadDir(folder)
+			if err != nil {
+				// 最上位のフォルダーが読めない場合のみエラー
+				if level == 0 {
+					return nil, err
+				}
+				continue
+			}
+			for _, dir := range dirs {
+				if core.FilenameIsPathistSystem(dir.Name()) {
+					continue
+				}
+				entry := fileEntry{absPath: filepath.Join(folder, dir.Name()), isDir: dir.IsDir()}
+				entries = append(entries, entry)
+				if entry.isDir {
+					next = append(next, entry.absPath)
+				}
+			}
+		}
+		folders = next
+	}
+	return entries, nil
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+	"github.com/fsnotify/fsnotify"
+)
+
+// searchDefaultLimit は SearchFiles で limit 未指定時に返す最大件数
+const searchDefaultLimit = 100
+
+// searchMaxLimit は SearchFiles で一度に返す最大件数
+const searchMaxLimit = 1000
+
+// SearchFiles はファイル名・フォルダー名を検索して一致度の高い順に返します
+// 全角・半角、カタカナ・ひらがな、大文字・小文字の違いは区別しません
+// gRPCサービスの実装です
+func (s *FileService) SearchFiles(
+	_ context.Context, req *grpc.SearchFilesRequest) (
+	*grpc.SearchFilesResponse, error) {
+
+	if core.FoldForSearch(req.GetQuery()) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
+	absFolder, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = searchDefaultLimit
+	}
+	limit = min(limit, searchMaxLimit)
+
+	// 索引から検索
+	found, total := s.index.Search(req.GetQuery(), absFolder, limit)
+
+	hits := make([]*grpc.FileSearchHit, 0, len(found))
+	for _, hit := range found {
+		fi := models.NewFile()
+		if err := fi.ParseFrom(s.PathistFolder, hit.Path); err != nil {
+			// 索引の更新前に削除されたファイルは除外
+			total--
+			continue
+		}
+		hits = append(hits, grpc.FileSearchHit_builder{
+			File:         fi.File,
+			RelativePath: s.relPathFrom(hit.Path),
+			Score:        hit.Score,
+		}.Build())
+	}
+
+	res := grpc.SearchFilesResponse_builder{}.Build()
+	res.SetHits(hits)
+	res.SetTotalCount(int32(total))
+	res.SetIndexReady(s.indexReady.Load())
+	return res, nil
+}
+
+// startSearchIndex は検索索引の構築と監視を開始します
+func (s *FileService) startSearchIndex(ctx context.Context, options *map[string]string) error {
+	optMaxDepth, exists := (*options)["SearchWatcherMaxDepth"]
+	if !exists {
+		optMaxDepth = "8"
+	}
+	maxDepth, err := strconv.Atoi(optMaxDepth)
+	if err != nil {
+		return err
+	}
+	optInterval, exists := (*options)["SearchRebuildIntervalSec"]
+	if !exists {
+		optInterval = "3600"
+	}
+	intervalSec, err := strconv.Atoi(optInterval)
+	if err != nil {
+		return err
+	}
+
+	s.index = core.NewSearchIndex(s.PathistFolder)
+	s.workbooks = core.NewWorkbookIndex(s.PathistFolder)
+	s.usage = core.NewDiskUsageCache(s.PathistFolder)
+
+	// watcherの開始
+	watcher, err := core.NewWatcher(s.PathistFolder, maxDepth)
+	if err != nil {
+		return err
+	}
+	if err := watcher.Start(); err != nil {
+		watcher.Close()
+		return err
+	}
+	s.indexWatcher = watcher
+
+	// ゴルーチンで監視イベントを処理し、索引を構築
+	go s.consumeIndexEvents(ctx, watcher)
+	go s.rebuildIndexLoop(ctx, time.Duration(intervalSec)*time.Second)
+	return nil
+}
+
+// rebuildIndexLoop はファイル名とブック内容の索引を構築し、監視範囲外の変更を反映するため定期的に再構築します
+func (s *FileService) rebuildIndexLoop(ctx context.Context, interval time.Duration) {
+	for {
+		if err := s.index.Rebuild(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("FileService: Failed to build search index: %v", err)
+		} else if !s.indexReady.Swap(true) {
+			log.Printf("FileService: Search index is ready (%d entries)", s.index.Len())
+		}
+
+		// ブック内容は読み込みに時間がかかるため、ファイル名の索引の後に構築
+		if err := s.workbooks.Rebuild(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("FileService: Failed to build workbook index: %v", err)
+		} else if !s.workbooksReady.Swap(true) {
+			log.Printf("FileService: Workbook index is ready (%d workbooks)", s.workbooks.Len())
+		}
+
+		if interval <= 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		// 監視範囲外の変更を反映するため使用量のキャッシュも破棄
+		s.usage.Reset()
+	}
+}
+
+// consumeIndexEvents はファイルシステム監視イベントを索引に反映します
+func (s *FileService) consumeIndexEvents(ctx context.Context, watcher *core.Watcher) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-watcher.Events():
+			if !ok {
+				return
+			}
+			if core.PathIsPathistSystem(event.Name) {
+				continue
+			}
+			switch {
+			case event.Op.Has(fsnotify.Create):
+				// フォルダーが移動してきた場合は配下も追加
+				s.usage.Invalidate(event.Name)
+				if err := s.index.AddTree(ctx, event.Name); err != nil && ctx.Err() == nil {
+					log.Printf("FileService: Failed to index %s: %v", event.Name, err)
+				}
+				if err := s.workbooks.AddTree(ctx, event.Name); err != nil && ctx.Err() == nil {
+					log.Printf("FileService: Failed to index workbooks in %s: %v", event.Name, err)
+				}
+			case event.Op.Has(fsnotify.Write):
+				// 保存途中の読み込み失敗は次の書き込みまたは再構築で反映
+				s.usage.Invalidate(event.Name)
+				_ = s.workbooks.Update(event.Name)
+			case event.Op.Has(fsnotify.Remove), event.Op.Has(fsnotify.Rename):
+				s.index.Remove(event.Name)
+				s.workbooks.Remove(event.Name)
+				s.usage.Remove(event.Name)
+			}
+
+		case err, ok := <-watcher.Errors():
+			if !ok {
+				return
+			}
+			log.Printf("FileService: File system watcher error: %v", err)
+		}
+	}
+}
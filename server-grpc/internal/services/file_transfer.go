@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// downloadDefaultChunkSize は DownloadFile で chunk_size 未指定時のチャンクサイズ
+const downloadDefaultChunkSize = 256 * 1024
+
+// downloadMaxChunkSize は DownloadFile で指定可能な最大チャンクサイズ
+const downloadMaxChunkSize = 4 * 1024 * 1024
+
+// DownloadFile はファイルの内容をチャンクに分割して送信します
+// offset と length で取得範囲を指定できます（length が0以下の場合は末尾まで）
+// gRPCサービスの実装です
+func (s *FileService) DownloadFile(
+	ctx context.Context, req *grpc.DownloadFileRequest,
+	stream *connect.ServerStream[grpc.DownloadFileResponse]) error {
+
+	// 絶対パスを取得
+	absPath, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return connectError(err, connect.CodeInvalidArgument)
+	}
+
+	// ファイルを開く
+	file, err := os.Open(absPath)
+	if err != nil {
+		return connectError(err, connect.CodeInternal)
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return connectError(err, connect.CodeInternal)
+	}
+	if fi.IsDir() {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("フォルダーはダウンロードできません: "+req.GetPathistFolder()))
+	}
+
+	// 取得範囲を決定
+	size := fi.Size()
+	offset := req.GetOffset()
+	if offset < 0 || offset > size {
+		return connect.NewError(connect.CodeOutOfRange, errors.New("offset is out of range"))
+	}
+	length := req.GetLength()
+	if length <= 0 || offset+length > size {
+		length = size - offset
+	}
+	chunkSize := int(req.GetChunkSize())
+	if chunkSize <= 0 {
+		chunkSize = downloadDefaultChunkSize
+	}
+	chunkSize = min(chunkSize, downloadMaxChunkSize)
+
+	// チャンク毎に送信（空の範囲でもファイル情報を返すため最低1回は送信）
+	reader := io.NewSectionReader(file, offset, length)
+	buf := make([]byte, chunkSize)
+	modified := timestamppb.New(fi.ModTime())
+	for sent := int64(0); ; {
+		if err := ctx.Err(); err != nil {
+			return connectError(err, connect.CodeCanceled)
+		}
+
+		n, readErr := io.ReadFull(reader, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return connectError(readErr, connect.CodeInternal)
+		}
+		if n > 0 || sent == 0 {
+			err := stream.Send(grpc.DownloadFileResponse_builder{
+				Data:         buf[:n],
+				Offset:       offset + sent,
+				TotalSize:    size,
+				ModifiedTime: modified,
+			}.Build())
+			if err != nil {
+				return err
+			}
+		}
+		sent += int64(n)
+		if readErr != nil || sent >= length {
+			return nil
+		}
+	}
+}
+
+// UploadFile はクライアントから受信したチャンクを一時ファイルに書き込み、
+// 全体の受信とチェックサムの検証が完了した時点で指定パスに配置します
+// 最初のメッセージでアップロード先とサイズ等を指定し、data は全てのメッセージで送信できます
+// 受信途中で切断された場合は、同じ upload_id と received_size からの offset で再開できます
+// gRPCサービスの実装です
+func (s *FileService) UploadFile(
+	ctx context.Context, stream *connect.ClientStream[grpc.UploadFileRequest]) (
+	*grpc.UploadFileResponse, error) {
+
+	// 最初のメッセージからアップロード情報を取得
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, connectError(err, connect.CodeUnknown)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("upload header is required"))
+	}
+	header := stream.Msg()
+
+	absDst, err := s.GetAbsPathFrom(header.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	if absDst == s.PathistFolder {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("アップロードできないパスです: "+header.GetPathistFolder()))
+	}
+	if header.GetTotalSize() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("total_size must not be negative"))
+	}
+	checksum := header.GetChecksumBlake2B()
+	if checksum != "" {
+		if sum, err := hex.DecodeString(checksum); err != nil || len(sum) != 32 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("checksum_blake2b must be a hex encoded BLAKE2b-256 hash"))
+		}
+	}
+
+	// 新規のアップロードの場合は放棄されたアップロードを削除
+	if header.GetUploadId() == "" {
+		s.purgeExpiredUploads()
+	}
+
+	// アップロードを開始または再開
+	session, err := s.uploads.Begin(header.GetUploadId(), s.relPathFrom(absDst), header.GetTotalSize(), checksum)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	finished := false
+	defer func() {
+		if err := session.Close(finished); err != nil {
+			log.Printf("FileService: Failed to close upload %s: %v", session.Info().Id, err)
+		}
+	}()
+
+	// チャンクを受信して一時ファイルに追記
+	for msg := header; ; msg = stream.Msg() {
+		if len(msg.GetData()) > 0 {
+			if err := session.Write(msg.GetOffset(), msg.GetData()); err != nil {
+				return nil, connectError(err, connect.CodeInternal)
+			}
+		}
+		if !stream.Receive() {
+			break
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, connectError(err, connect.CodeUnknown)
+	}
+
+	res := grpc.UploadFileResponse_builder{
+		UploadId:     session.Info().Id,
+		ReceivedSize: session.Received(),
+	}.Build()
+
+	// 全体を受信していない場合は再開できるよう一時ファイルを残す
+	partPath, completed, err := session.Complete()
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	if !completed {
+		return res, nil
+	}
+
+	// 配置先フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.UploadFile", absDst)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer locks.Release()
+
+	// 上書きポリシーに従って配置先を決定
+	absDst, skip, err := s.resolveDestination(absDst, header.GetOverwritePolicy())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	if !skip {
+		if err := os.MkdirAll(filepath.Dir(absDst), 0755); err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+		err := s.placeAt(absDst, func(absPath string) error {
+			return os.Rename(partPath, absPath)
+		}, func(absPath string) error {
+			return os.Rename(absPath, partPath)
+		})
+		if err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+	}
+	finished = true
+
+	// 配置したファイル情報を返す
+	fi := models.NewFile()
+	if err := fi.ParseFrom(s.PathistFolder, absDst); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	res.SetCompleted(true)
+	res.SetFile(fi.File)
+	return res, nil
+}
+
+// purgeExpiredUploads は期限を過ぎた放棄されたアップロードを削除します
+func (s *FileService) purgeExpiredUploads() {
+	if s.uploadExpire <= 0 {
+		return
+	}
+	purged, err := s.uploads.PurgeOlderThan(time.Now().Add(-s.uploadExpire))
+	if err != nil {
+		log.Printf("FileService: Failed to purge uploads: %v", err)
+	}
+	if purged > 0 {
+		log.Printf("FileService: Purged %d expired uploads", purged)
+	}
+}
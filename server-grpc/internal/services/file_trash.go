@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListTrash はゴミ箱のエントリー一覧を返します
+// gRPCサービスの実装です
+func (s *FileService) ListTrash(
+	_ context.Context, _ *grpc.ListTrashRequest) (
+	*grpc.ListTrashResponse, error) {
+
+	entries, err := s.trash.List()
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	items := make([]*grpc.TrashItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, grpc.TrashItem_builder{
+			Id:                    entry.Id,
+			OriginalPathistFolder: entry.OriginalPath,
+			DeletedBy:             entry.DeletedBy,
+			DeletedTime:           timestamppb.New(entry.DeletedAt),
+			IsDir:                 entry.IsDir,
+			Size:                  entry.Size,
+		}.Build())
+	}
+
+	res := grpc.ListTrashResponse_builder{}.Build()
+	res.SetItems(items)
+	return res, nil
+}
+
+// RestoreFromTrash はゴミ箱のエントリーを元の場所に戻します
+// gRPCサービスの実装です
+func (s *FileService) RestoreFromTrash(
+	ctx context.Context, req *grpc.RestoreFromTrashRequest) (
+	*grpc.RestoreFromTrashResponse, error) {
+
+	results := make([]*grpc.FileOperationResult, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		// キャンセルされた場合は残りを処理しない
+		if err := ctx.Err(); err != nil {
+			return nil, connectError(err, connect.CodeCanceled)
+		}
+
+		relDst, skipped, err := s.restoreFromTrash(id, req.GetOverwritePolicy())
+		results = append(results, newFileOperationResult(id, relDst, skipped, err))
+	}
+
+	res := grpc.RestoreFromTrashResponse_builder{}.Build()
+	res.SetResults(results)
+	return res, nil
+}
+
+// PurgeTrash はゴミ箱のエントリーを完全に削除します
+// all が指定された場合はゴミ箱を空にします
+// gRPCサービスの実装です
+func (s *FileService) PurgeTrash(
+	ctx context.Context, req *grpc.PurgeTrashRequest) (
+	*grpc.PurgeTrashResponse, error) {
+
+	ids := req.GetIds()
+	if req.GetAll() {
+		entries, err := s.trash.List()
+		if err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+		ids = make([]string, 0, len(entries))
+		for _, entry := range entries {
+			ids = append(ids, entry.Id)
+		}
+	}
+
+	results := make([]*grpc.FileOperationResult, 0, len(ids))
+	for _, id := range ids {
+		// キャンセルされた場合は残りを処理しない
+		if err := ctx.Err(); err != nil {
+			return nil, connectError(err, connect.CodeCanceled)
+		}
+
+		// 存在しないエントリーはエラーとする
+		_, err := s.trash.Get(id)
+		if err == nil {
+			err = s.trash.Purge(id)
+		}
+		results = append(results, newFileOperationResult(id, "", false, err))
+	}
+
+	res := grpc.PurgeTrashResponse_builder{}.Build()
+	res.SetResults(results)
+	return res, nil
+}
+
+// restoreFromTrash は上書きポリシーに従ってゴミ箱のエントリーを元の場所に戻します
+func (s *FileService) restoreFromTrash(id string, policy grpc.OverwritePolicy) (string, bool, error) {
+	entry, err := s.trash.Get(id)
+	if err != nil {
+		return "", false, err
+	}
+
+	// 元の場所がルート配下であることを確認
+	absDst, err := s.GetAbsPathFrom(entry.OriginalPath)
+	if err != nil {
+		return "", false, err
+	}
+
+	// 復元先フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.RestoreFromTrash", absDst)
+	if err != nil {
+		return "", false, err
+	}
+	defer locks.Release()
+
+	// 上書きポリシーに従って復元先を決定
+	absDst, skip, err := s.resolveDestination(absDst, policy)
+	if err != nil || skip {
+		return s.relPathFrom(absDst), skip, err
+	}
+
+	err = s.placeAt(absDst, func(absPath string) error {
+		_, err := s.trash.Restore(id, absPath)
+		return err
+	}, func(absPath string) error {
+		_, err := s.trash.Put(absPath, entry.OriginalPath, entry.DeletedBy)
+		return err
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return s.relPathFrom(absDst), false, nil
+}
+
+// purgeTrashLoop は保持期間を過ぎたゴミ箱のエントリーを定期的に削除します
+func (s *FileService) purgeTrashLoop(done <-chan struct{}, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.trash.PurgeOlderThan(time.Now().Add(-retention))
+		if err != nil {
+			log.Printf("FileService: Failed to purge trash: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("FileService: Purged %d trash entries", purged)
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// trashRetentionFrom はオプションからゴミ箱の保持期間と自動削除の間隔を取得します
+// 保持期間が0以下の場合は自動削除を行いません
+func trashRetentionFrom(options *map[string]string) (retention, interval time.Duration, err error) {
+	optDays, exists := (*options)["TrashRetentionDays"]
+	if !exists {
+		optDays = "30"
+	}
+	days, err := strconv.Atoi(optDays)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	optInterval, exists := (*options)["TrashPurgeIntervalSec"]
+	if !exists {
+		optInterval = "3600"
+	}
+	intervalSec, err := strconv.Atoi(optInterval)
+	if err != nil {
+		return 0, 0, err
+	}
+	if intervalSec <= 0 {
+		return 0, 0, errors.New("TrashPurgeIntervalSec must be positive")
+	}
+
+	return time.Duration(days) * 24 * time.Hour, time.Duration(intervalSec) * time.Second, nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	grpc "server-grpc/gen/grpc/v1"
+
+	"connectrpc.com/connect"
+)
+
+// diskUsageDefaultChildren は GetDiskUsage で top_children 未指定時に返す直下の件数
+const diskUsageDefaultChildren = 20
+
+// diskUsageMaxChildren は GetDiskUsage で返す直下の最大件数
+const diskUsageMaxChildren = 1000
+
+// GetDiskUsage はフォルダー（または工事・会社のフォルダー）配下の合計サイズ・ファイル数と、
+// 直下のファイル・フォルダーをサイズの大きい順に返します。
+//   - 集計結果はフォルダー毎にキャッシュし、ファイルシステム監視イベントで変更のあったフォルダーのみ再集計します。
+//   - refresh を指定した場合は対象フォルダー配下のキャッシュを破棄して集計し直します。
+//
+// gRPCサービスの実装です
+func (s *FileService) GetDiskUsage(
+	ctx context.Context, req *grpc.GetDiskUsageRequest) (
+	*grpc.GetDiskUsageResponse, error) {
+
+	// 対象フォルダーを決定
+	var absPath string
+	var err error
+	switch {
+	case req.GetKojiId() != "":
+		absPath, err = s.services.kojiFolderOf(req.GetKojiId())
+	case req.GetCompanyId() != "":
+		absPath, err = s.services.companyFolderOf(req.GetCompanyId())
+	default:
+		absPath, err = s.GetAbsPathFrom(req.GetPathistFolder())
+		err = connectError(err, connect.CodeInvalidArgument)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jail.Verify(absPath); err != nil {
+		return nil, connectError(err, connect.CodePermissionDenied)
+	}
+	limit := int(req.GetTopChildren())
+	if limit <= 0 {
+		limit = diskUsageDefaultChildren
+	}
+	limit = min(limit, diskUsageMaxChildren)
+
+	// 集計
+	if req.GetRefresh() {
+		s.usage.Remove(absPath)
+	}
+	usage, err := s.usage.Usage(ctx, absPath)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	children := make([]*grpc.DiskUsageEntry, 0, min(limit, len(usage.Children)))
+	for _, child := range usage.Children[:min(limit, len(usage.Children))] {
+		children = append(children, grpc.DiskUsageEntry_builder{
+			Name:         child.Name,
+			RelativePath: s.relPathFrom(child.Path),
+			IsDir:        child.IsDir,
+			Size:         child.Size,
+			FileCount:    child.Files,
+		}.Build())
+	}
+
+	res := grpc.GetDiskUsageResponse_builder{
+		RelativePath:    s.relPathFrom(absPath),
+		Size:            usage.Size,
+		FileCount:       usage.Files,
+		FolderCount:     usage.Folders,
+		SkippedCount:    usage.Skipped,
+		LargestChildren: children,
+		ChildrenCount:   int32(len(usage.Children)),
+	}.Build()
+	return res, nil
+}
+
+// folderSizesOf は ID をキーとしたフォルダーの絶対パスから、ID をキーとした合計サイズを返します
+// ルートフォルダー外・存在しないフォルダーは含めません
+func (s *FileService) folderSizesOf(ctx context.Context, folders map[string]string) (map[string]int64, error) {
+	absFolders := make([]string, 0, len(folders))
+	for _, folder := range folders {
+		if folder == "" || s.jail.Verify(folder) != nil {
+			continue
+		}
+		absFolders = append(absFolders, folder)
+	}
+	sizes, err := s.usage.Sizes(ctx, absFolders)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int64, len(sizes))
+	for id, folder := range folders {
+		if size, ok := sizes[folder]; ok {
+			result[id] = size
+		}
+	}
+	return result, nil
+}
+
+// folderSizesFrom は他のサービスから FileService を通してフォルダーの合計サイズを取得します
+func folderSizesFrom(ctx context.Context, services *Services, folders map[string]string) (map[string]int64, error) {
+	fileService, ok := services.fileService()
+	if !ok || fileService.usage == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("FileService is not available"))
+	}
+	sizes, err := fileService.folderSizesOf(ctx, folders)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	return sizes, nil
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListFileVersions はファイルの過去の版を保存日時の新しい順に返します
+// ファイルが現在も存在する場合は current に現在のファイル情報を返します
+// gRPCサービスの実装です
+func (s *FileService) ListFileVersions(
+	_ context.Context, req *grpc.ListFileVersionsRequest) (
+	*grpc.ListFileVersionsResponse, error) {
+
+	absPath, relPath, err := s.versionPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.versions.List(relPath)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	versions := make([]*grpc.FileVersion, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, fileVersionOf(entry))
+	}
+
+	res := grpc.ListFileVersionsResponse_builder{}.Build()
+	res.SetVersions(versions)
+	fi := models.NewFile()
+	if err := fi.ParseFrom(s.PathistFolder, absPath); err == nil {
+		res.SetCurrent(fi.File)
+	}
+	return res, nil
+}
+
+// RestoreFileVersion はファイルを過去の版に戻します。
+//   - 現在のファイルは過去の版として保存するため、戻す操作も取り消せます。
+//   - 現在のファイルが削除されている場合は元の場所に作成します。
+//
+// gRPCサービスの実装です
+func (s *FileService) RestoreFileVersion(
+	_ context.Context, req *grpc.RestoreFileVersionRequest) (
+	*grpc.RestoreFileVersionResponse, error) {
+
+	absPath, relPath, err := s.versionPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.versions.Get(relPath, req.GetVersionId()); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 対象フォルダーのロックを取得
+	locks, err := s.lockParentsOf("FileService.RestoreFileVersion", absPath)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer locks.Release()
+
+	// 現在のファイルを確認
+	current, err := os.Lstat(absPath)
+	exists := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	if exists && !current.Mode().IsRegular() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("not a regular file: %s", relPath))
+	}
+	if exists && !s.versions.Enabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("file versioning is disabled"))
+	}
+
+	// 現在のファイルを保存すると保持数を超えた古い版が削除されるため、先に一時ファイルに戻す
+	tempPath := filepath.Join(filepath.Dir(absPath), core.PathistSystemPrefix+"-restore-"+req.GetVersionId())
+	if _, err := s.versions.Restore(relPath, req.GetVersionId(), tempPath); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 現在のファイルを過去の版として保存
+	savedId := ""
+	if exists {
+		saved, err := s.versions.Put(absPath, relPath, core.VersionReasonRestore)
+		if err != nil && saved.Id == "" {
+			s.keepRestoringVersion(tempPath, relPath)
+			return nil, connectError(err, connect.CodeInternal)
+		}
+		savedId = saved.Id
+	}
+	if err := os.Rename(tempPath, absPath); err != nil {
+		// 保存した現在のファイルを元の場所に戻してから、戻す版を保存し直す
+		if savedId != "" {
+			if _, restoreErr := s.versions.Restore(relPath, savedId, absPath); restoreErr != nil {
+				log.Printf("FileService: Failed to put back %s: %v", absPath, restoreErr)
+			}
+		}
+		s.keepRestoringVersion(tempPath, relPath)
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	fi := models.NewFile()
+	if err := fi.ParseFrom(s.PathistFolder, absPath); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	res := grpc.RestoreFileVersionResponse_builder{}.Build()
+	res.SetFile(fi.File)
+	res.SetSavedVersionId(savedId)
+	return res, nil
+}
+
+// keepRestoringVersion は戻せなかった版の一時ファイルを新しい版として保存し直します
+func (s *FileService) keepRestoringVersion(tempPath, relPath string) {
+	if _, err := s.versions.Put(tempPath, relPath, core.VersionReasonRestore); err != nil {
+		log.Printf("FileService: Failed to keep file version %s: %v", tempPath, err)
+	}
+}
+
+// saveVersion は absPath がファイルの場合に過去の版として保存します
+// 戻り値 saved.Id が空でない場合は absPath は移動済みです
+func (s *FileService) saveVersion(absPath, reason string) (saved core.VersionEntry, err error) {
+	if !s.versions.Enabled() {
+		return core.VersionEntry{}, nil
+	}
+	fi, err := os.Lstat(absPath)
+	if err != nil || !fi.Mode().IsRegular() {
+		return core.VersionEntry{}, nil
+	}
+	entry, err := s.versions.Put(absPath, s.relPathFrom(absPath), reason)
+	if entry.Id == "" {
+		return core.VersionEntry{}, err
+	}
+	if err != nil {
+		// 古い版の削除に失敗しても上書きは続ける
+		log.Printf("FileService: Failed to prune file versions of %s: %v", entry.OriginalPath, err)
+	}
+	return entry, nil
+}
+
+// versionPathFrom はリクエストの相対パスから絶対パスと正規化した相対パスを返します
+func (s *FileService) versionPathFrom(reqPath string) (absPath, relPath string, err error) {
+	absPath, err = s.GetAbsPathFrom(reqPath)
+	if err != nil {
+		return "", "", connectError(err, connect.CodeInvalidArgument)
+	}
+	if absPath == s.PathistFolder {
+		return "", "", connect.NewError(connect.CodeInvalidArgument, errors.New("pathist_folder is required"))
+	}
+	return absPath, s.relPathFrom(absPath), nil
+}
+
+// purgeVersionsLoop は保持期間を過ぎた過去の版を定期的に削除します
+func (s *FileService) purgeVersionsLoop(done <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.versions.PurgeExpired(time.Now())
+		if err != nil {
+			log.Printf("FileService: Failed to purge file versions: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("FileService: Purged %d file versions", purged)
+		}
+
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// openVersionStoreFrom はオプションの保持数・保持期間で過去の版の保存領域を開きます
+func openVersionStoreFrom(target string, options *map[string]string) (*core.VersionStore, error) {
+	optCount, exists := (*options)["FileVersionMaxCount"]
+	if !exists {
+		optCount = "10"
+	}
+	maxCount, err := strconv.Atoi(optCount)
+	if err != nil {
+		return nil, err
+	}
+	optDays, exists := (*options)["FileVersionRetentionDays"]
+	if !exists {
+		optDays = "90"
+	}
+	days, err := strconv.Atoi(optDays)
+	if err != nil {
+		return nil, err
+	}
+	return core.OpenVersionStore(filepath.Join(target, core.VersionFolderName), maxCount, time.Duration(days)*24*time.Hour)
+}
+
+// versionPurgeIntervalFrom はオプションから過去の版を削除する間隔を返します
+func versionPurgeIntervalFrom(options *map[string]string) (time.Duration, error) {
+	optInterval, exists := (*options)["FileVersionPurgeIntervalSec"]
+	if !exists {
+		optInterval = "3600"
+	}
+	intervalSec, err := strconv.Atoi(optInterval)
+	if err != nil {
+		return 0, err
+	}
+	if intervalSec <= 0 {
+		return 0, errors.New("FileVersionPurgeIntervalSec must be positive")
+	}
+	return time.Duration(intervalSec) * time.Second, nil
+}
+
+// fileVersionOf は過去の版のメタデータを gRPC のメッセージに変換します
+func fileVersionOf(entry core.VersionEntry) *grpc.FileVersion {
+	return grpc.FileVersion_builder{
+		Id:           entry.Id,
+		RelativePath: entry.OriginalPath,
+		Reason:       entry.Reason,
+		SavedTime:    timestamppb.New(entry.SavedAt),
+		ModifiedTime: timestamppb.New(entry.ModTime),
+		Size:         entry.Size,
+	}.Build()
+}
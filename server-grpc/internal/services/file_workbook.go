@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+)
+
+// workbookDefaultPreviewRows は GetWorkbookSummary で preview_rows 未指定時に返す行数
+const workbookDefaultPreviewRows = 10
+
+// workbookMaxPreviewRows は GetWorkbookSummary で返す最大行数
+const workbookMaxPreviewRows = 100
+
+// workbookMaxPreviewColumns は GetWorkbookSummary のプレビューで返す最大列数
+const workbookMaxPreviewColumns = 50
+
+// workbookMaxMatches は SearchWorkbooks でファイル毎に返す一致したセルの最大数
+const workbookMaxMatches = 5
+
+// GetWorkbookSummary は xlsx ファイルのシート毎の行数・列数と先頭行の値を返します
+// gRPCサービスの実装です
+func (s *FileService) GetWorkbookSummary(
+	_ context.Context, req *grpc.GetWorkbookSummaryRequest) (
+	*grpc.GetWorkbookSummaryResponse, error) {
+
+	absPath, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	if !core.FilenameIsXlsx(filepath.Base(absPath)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("not an xlsx workbook"))
+	}
+	previewRows := int(req.GetPreviewRows())
+	if previewRows <= 0 {
+		previewRows = workbookDefaultPreviewRows
+	}
+	previewRows = min(previewRows, workbookMaxPreviewRows)
+
+	fi := models.NewFile()
+	if err := fi.ParseFrom(s.PathistFolder, absPath); err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	wb, err := core.ReadWorkbook(absPath)
+	if errors.Is(err, core.ErrWorkbookInvalid) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	sheets := make([]*grpc.WorkbookSheetSummary, 0, len(wb.Sheets))
+	for _, sheet := range wb.Sheets {
+		sheets = append(sheets, grpc.WorkbookSheetSummary_builder{
+			Name:        sheet.Name,
+			Hidden:      sheet.Hidden,
+			RowCount:    int32(sheet.Rows),
+			ColumnCount: int32(sheet.Columns),
+			CellCount:   int32(len(sheet.Cells)),
+			Preview:     workbookPreviewOf(sheet, previewRows),
+		}.Build())
+	}
+
+	res := grpc.GetWorkbookSummaryResponse_builder{}.Build()
+	res.SetFile(fi.File)
+	res.SetSheets(sheets)
+	res.SetTruncated(wb.Truncated)
+	return res, nil
+}
+
+// SearchWorkbooks は xlsx ファイルのセルの値を検索して一致度の高い順に返します
+// 表記ゆれの扱いは SearchFiles と同じです
+// gRPCサービスの実装です
+func (s *FileService) SearchWorkbooks(
+	_ context.Context, req *grpc.SearchWorkbooksRequest) (
+	*grpc.SearchWorkbooksResponse, error) {
+
+	if core.FoldForSearch(req.GetQuery()) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
+	absFolder, err := s.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = searchDefaultLimit
+	}
+	limit = min(limit, searchMaxLimit)
+
+	// 索引から検索
+	found, total := s.workbooks.Search(req.GetQuery(), absFolder, limit, workbookMaxMatches)
+
+	hits := make([]*grpc.WorkbookSearchHit, 0, len(found))
+	for _, hit := range found {
+		fi := models.NewFile()
+		if err := fi.ParseFrom(s.PathistFolder, hit.Path); err != nil {
+			// 索引の更新前に削除されたファイルは除外
+			total--
+			continue
+		}
+		matches := make([]*grpc.WorkbookCellMatch, 0, len(hit.Matches))
+		for _, match := range hit.Matches {
+			matches = append(matches, grpc.WorkbookCellMatch_builder{
+				Sheet: match.Sheet,
+				Cell:  match.Ref,
+				Text:  match.Text,
+			}.Build())
+		}
+		hits = append(hits, grpc.WorkbookSearchHit_builder{
+			File:         fi.File,
+			RelativePath: s.relPathFrom(hit.Path),
+			Score:        hit.Score,
+			Matches:      matches,
+			MatchCount:   int32(hit.MatchCount),
+		}.Build())
+	}
+
+	res := grpc.SearchWorkbooksResponse_builder{}.Build()
+	res.SetHits(hits)
+	res.SetTotalCount(int32(total))
+	res.SetIndexReady(s.workbooksReady.Load())
+	return res, nil
+}
+
+// workbookPreviewOf はシートの先頭 rows 行の値を返します
+// 値のない行は含めず、各行は1列目から値のある最後の列まで（最大 workbookMaxPreviewColumns 列）です
+func workbookPreviewOf(sheet core.WorkbookSheet, rows int) []*grpc.WorkbookRow {
+	preview := make([]*grpc.WorkbookRow, 0, rows)
+	var values []string
+	row := 0
+	flush := func() {
+		if row > 0 {
+			preview = append(preview, grpc.WorkbookRow_builder{Row: int32(row), Values: values}.Build())
+		}
+	}
+	for _, cell := range sheet.Cells {
+		if cell.Column < 1 || cell.Column > workbookMaxPreviewColumns {
+			continue
+		}
+		if cell.Row != row {
+			flush()
+			if len(preview) >= rows {
+				return preview
+			}
+			row, values = cell.Row, nil
+		}
+		for len(values) < cell.Column {
+			values = append(values, "")
+		}
+		values[cell.Column-1] = cell.Text
+	}
+	flush()
+	return preview
+}
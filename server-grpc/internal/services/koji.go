@@ -8,7 +8,7 @@ import (
 	"path"
 	"path/filepath"
 	"server-grpc/internal/core"
-	"sync"
+	"strings"
 
 	grpcv1 "server-grpc/gen/grpc/v1"
 	grpcv1connect "server-grpc/gen/grpc/v1/grpcv1connect"
@@ -32,15 +32,18 @@ type KojiService struct {
 	// targetWatcher は target のファイルシステム監視オブジェクト
 	targetWatcher *fsnotify.Watcher
 
-	// kojies は管理されている工事データのインデックスがIdのキャッシュマップ
-	kojies map[string]*models.Koji
+	// templateFolder は新しい工事フォルダーの作成時にコピーするテンプレートフォルダー
+	templateFolder string
+
+	// kojies は管理されている工事データのキャッシュ、書き込み時コピーのスナップショットで保持
+	kojies *core.SnapshotStore[*models.Koji]
 }
 
 func (s *KojiService) Start(services *Services, options *map[string]string) error {
 	// オプションの取得
-	optTarget, exists := (*options)["KojiServiceTarget"]
+	optTarget, exists := (*options)["KojiServiceFolder"]
 	if !exists {
-		return errors.New("KojiServiceTarget option is required")
+		return errors.New("KojiServiceFolder option is required")
 	}
 	// パスを正規化
 	target, err := core.NormalizeAbsPath(optTarget)
@@ -51,10 +54,11 @@ func (s *KojiService) Start(services *Services, options *map[string]string) erro
 	// 情報の初期化
 	s.services = services
 	s.target = target
-	s.kojies = make(map[string]*models.Koji, 1000)
+	s.templateFolder = (*options)["KojiTemplateFolder"]
+	s.kojies = core.NewSnapshotStore[*models.Koji]()
 
 	// kojiesByIdの情報を取得
-	if err = s.UpdateKojies(); err != nil {
+	if err = s.UpdateKojies(context.Background()); err != nil {
 		return err
 	}
 
@@ -96,10 +100,16 @@ func (s *KojiService) watchTarget() error {
 				if !ok {
 					return
 				}
+				// Pathist の内部管理用ファイル（ロックファイル等）の変更は無視
+				if core.PathIsPathistSystem(event.Name) {
+					continue
+				}
 				log.Printf("[target] event=%s path=%s", event.Op, event.Name)
 				if event.Op&(fsnotify.Create|fsnotify.Remove|fsnotify.Rename|fsnotify.Write) != 0 {
-					// 必要に応じてサービスへ通知する
-					// 例: reload metadata, update cache, etc.
+					// 工事キャッシュの更新
+					if err := s.UpdateKojies(context.Background()); err != nil {
+						log.Printf("KojiService: Failed to update koji cache map: %v", err)
+					}
 				}
 
 			case err := <-s.targetWatcher.Errors:
@@ -117,95 +127,117 @@ func (s *KojiService) watchTarget() error {
 	return nil
 }
 
-func (s *KojiService) UpdateKojies() error {
+// kojiIdFromPath は target 配下のパスから工事IDを取得します
+// 工事フォルダーとして解析できない場合は空文字列を返します
+func (s *KojiService) kojiIdFromPath(pathname string) string {
+	rel, err := filepath.Rel(s.target, pathname)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+
+	// target 直下のフォルダー名を工事フォルダーとして解析
+	folderName := strings.Split(filepath.ToSlash(rel), "/")[0]
+	koji := models.NewKoji()
+	if err := koji.ParseFrom(filepath.Join(s.target, folderName)); err != nil {
+		return ""
+	}
+	return koji.GetId()
+}
+
+// UpdateKojies は工事のキャッシュデータを更新します
+// ctx がキャンセルされた場合は走査を中止し、キャッシュは更新しません
+// 走査中に UpdateKoji 等でキャッシュが更新された場合は走査し直します
+func (s *KojiService) UpdateKojies(ctx context.Context) error {
+	_, err := s.kojies.Rebuild(func() (map[string]*models.Koji, error) {
+		return s.scanKojies(ctx)
+	})
+	return err
+}
+
+// scanKojies は target を走査して工事のキャッシュデータを作成します
+func (s *KojiService) scanKojies(ctx context.Context) (map[string]*models.Koji, error) {
 	// ファイルシステムから工事フォルダー一覧を取得
 	entries, err := os.ReadDir(s.target)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// 工事フォルダー一覧の要素数を取得
-	kojiesSize := len(entries)
-
-	// 並列処理用のワーカー数を決定
-	numWorkers := core.DecideNumWorkers(kojiesSize)
-
-	// バッファ付きチャンネルで効率化
-	jobs := make(chan int, kojiesSize)
-	results := make(chan *models.Koji, kojiesSize)
-
-	// ワーカープールの起動
-	var wg sync.WaitGroup
-	wg.Add(numWorkers)
-	for i := 0; i < numWorkers; i++ {
-		go func() {
-			defer wg.Done()
-			for idx := range jobs {
-				folder := path.Join(s.target, entries[idx].Name())
-				koji := models.NewKoji()
-				if err := koji.ParseFrom(folder); err == nil {
-					results <- koji
-				} else {
-					results <- nil // エラーの場合はnilを返す
-				}
+	// 工事フォルダーを並列に解析
+	results, err := core.ParallelMap(ctx, entries,
+		func(_ context.Context, entry os.DirEntry) (*models.Koji, error) {
+			koji := models.NewKoji()
+			if err := koji.ParseFrom(path.Join(s.target, entry.Name())); err != nil {
+				return nil, err
 			}
-		}()
+			return koji, nil
+		})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		// 工事フォルダーの書式に合致しないフォルダーは除外して続行
+		log.Printf("KojiService: Skipped %d folders that are not koji folders", len(joined.Unwrap()))
 	}
 
-	// ジョブの投入
-	go func() {
-		for i := range entries {
-			jobs <- i
-		}
-		close(jobs)
-	}()
-
-	// 結果収集用のゴルーチン
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
-
-	// 結果を収集（最大サイズで確保し、後でスライス）
-	for result := range results {
-		if result != nil {
-			s.kojies[result.GetId()] = result
-		}
+	// 結果を収集して新しいキャッシュデータを作成
+	kojies := make(map[string]*models.Koji, len(results))
+	for _, result := range results {
+		kojies[result.GetId()] = result
 	}
 
-	return nil
+	return kojies, nil
 }
 
 // GetKojies は管理されている工事データ一覧を返す
+// include_folder_sizes を指定した場合は工事IDをキーとした工事フォルダーの合計サイズも返す
 func (s *KojiService) GetKojies(
 	ctx context.Context,
 	req *grpcv1.GetKojiesRequest) (
 	res *grpcv1.GetKojiesResponse,
 	err error) {
-	_ = req // 現状フィルター未対応
 
-	grpcKojies := make(map[string]*grpcv1.Koji, len(s.kojies))
-	for _, v := range s.kojies {
+	// レスポンスを初期化
+	res = grpcv1.GetKojiesResponse_builder{}.Build()
+
+	snapshot := s.kojies.Load()
+	grpcKojies := make(map[string]*grpcv1.Koji, snapshot.Len())
+	folders := make(map[string]string, snapshot.Len())
+	for _, v := range snapshot.All() {
 		grpcKojies[v.GetId()] = v.Koji
+		folders[v.GetId()] = v.GetPathistFolder()
+	}
+
+	// 必要に応じて工事フォルダーの合計サイズを取得
+	if req.GetIncludeFolderSizes() {
+		sizes, sizeErr := folderSizesFrom(ctx, s.services, folders)
+		if sizeErr != nil {
+			err = sizeErr
+			return
+		}
+		res.SetFolderSizes(sizes)
 	}
 
 	res.SetKojies(grpcKojies)
+	res.SetGeneration(snapshot.Generation())
 
 	return
 }
 
-// GetKojiById は指定されたIDの工事データを返す
-func (s *KojiService) GetKojiById(
+// GetKoji は指定されたIDの工事データを返す
+func (s *KojiService) GetKoji(
 	ctx context.Context,
 	req *grpcv1.GetKojiRequest) (
 	res *grpcv1.GetKojiResponse,
 	err error) {
 
+	// レスポンスを初期化
+	res = grpcv1.GetKojiResponse_builder{}.Build()
+
 	// リクエスト情報の取得
 	id := req.GetId()
 
 	// 工事情報を取得
-	koji, exist := s.kojies[id]
+	koji, exist := s.kojies.Load().Get(id)
 	if !exist {
 		err = connect.NewError(connect.CodeNotFound, errors.New("koji not found"))
 		return
@@ -223,35 +255,123 @@ func (s *KojiService) UpdateKoji(
 
 	// 既存の工事情報を取得
 	grpcNewKoji := req.GetNewKoji()
-	prevKoji, exist := s.kojies[grpcNewKoji.GetId()]
+	current, exist := s.kojies.Load().Get(grpcNewKoji.GetId())
 	if !exist {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("koji not found"))
 	}
 
-	newKoji := &models.Koji{Koji: grpcNewKoji}
-
-	// 工事情報を更新
-	newKoji, err := prevKoji.ImportFrom(newKoji)
+	// 工事情報を保存する工事フォルダーのロックを取得（キャッシュの更新の外で行う）
+	lock, err := core.LockFolder(current.GetPathistFolder(), "KojiService.UpdateKoji")
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err, connect.CodeInternal)
 	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			log.Printf("KojiService: Failed to release folder lock: %v", err)
+		}
+	}()
+
+	// 書き込み時コピーで工事情報を更新
+	var prevKoji *models.Koji
+	_, err = s.kojies.Update(func(kojies map[string]*models.Koji) error {
+		// ロックの取得中に削除・移動された場合は更新しない
+		prev, exist := kojies[grpcNewKoji.GetId()]
+		if !exist || prev.GetPathistFolder() != current.GetPathistFolder() {
+			return connect.NewError(connect.CodeNotFound, errors.New("koji not found"))
+		}
 
-	// 工事情報のインデックスを更新
-	if _, exist := s.kojies[prevKoji.GetId()]; exist {
-		delete(s.kojies, prevKoji.GetId())
-		// 新しいIDで再登録
-		s.kojies[newKoji.GetId()] = newKoji
+		// 工事情報を更新
+		newKoji, err := prev.ImportFrom(&models.Koji{Koji: grpcNewKoji})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+
+		// 工事情報のインデックスを新しいIDで再登録
+		delete(kojies, prev.GetId())
+		kojies[newKoji.GetId()] = newKoji
+		prevKoji = prev
+		return nil
+	})
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
 	}
 
 	// Responseの作成
 	res := grpcv1.UpdateKojiResponse_builder{}.Build()
+	res.SetPrevKoji(prevKoji.Koji)
+
+	return res, nil
+}
+
+// CreateKoji は工事開始日・会社名・現場名から工事フォルダーを作成します。
+//   - フォルダー名は "YYYY-MMDD 会社名 現場名" 形式です。
+//   - テンプレートフォルダー（KojiTemplateFolder）の内容を、ファイル名・フォルダー名の {{.CompanyName}} 等を置き換えてコピーします。
+//   - persist_end が指定されている場合は永続化ファイルに保存します。
+//
+// gRPCサービスの実装です
+func (s *KojiService) CreateKoji(
+	ctx context.Context, req *grpcv1.CreateKojiRequest) (
+	*grpcv1.CreateKojiResponse, error) {
 
-	grpcv1KojiMapById := make(map[string]*grpcv1.Koji, len(s.kojies))
-	for _, v := range s.kojies {
-		grpcv1KojiMapById[v.GetId()] = v.Koji
+	// リクエスト情報の取得
+	grpcNewKoji := req.GetNewKoji()
+	start := &models.Timestamp{Timestamp: grpcNewKoji.GetStart()}
+	if !grpcNewKoji.HasStart() || !start.IsValid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start is required"))
+	}
+	companyName := strings.TrimSpace(grpcNewKoji.GetCompanyName())
+	locationName := strings.TrimSpace(grpcNewKoji.GetLocationName())
+	if companyName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("company_name is required"))
 	}
-	res.SetPrevKoji(prevKoji.Koji)
 
+	// 工事フォルダーのパスを生成して工事情報を解析
+	folder, err := models.GenerateKojiPathistFolder(s.target, start, companyName, locationName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	koji := models.NewKoji()
+	if err := koji.ParseFrom(folder); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, exist := s.kojies.Load().Get(koji.GetId()); exist {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("koji already exists"))
+	}
+
+	// サービスフォルダーのロックを取得し、テンプレートをコピーして工事フォルダーを作成
+	locks, err := lockParentFolders("KojiService.CreateKoji", folder)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	defer func() {
+		if err := locks.Release(); err != nil {
+			log.Printf("KojiService: Failed to release folder lock: %v", err)
+		}
+	}()
+	copied, err := core.CopyTemplate(ctx, s.templateFolder, folder, koji.TemplateData())
+	if errors.Is(err, core.ErrTemplateName) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	// 永続化データを保存
+	if grpcNewKoji.HasPersistEnd() {
+		koji.SetPersistEnd(grpcNewKoji.GetPersistEnd())
+		if err := koji.Pathist.SavePersists(); err != nil {
+			return nil, connectError(err, connect.CodeInternal)
+		}
+	}
+
+	// 監視イベントを待たずにキャッシュを更新
+	if err := s.UpdateKojies(ctx); err != nil {
+		log.Printf("KojiService: Failed to update koji cache map: %v", err)
+	}
+
+	res := grpcv1.CreateKojiResponse_builder{}.Build()
+	res.SetKoji(koji.Koji)
+	res.SetTemplateFileCount(int32(copied))
 	return res, nil
 }
 
@@ -1,76 +1,222 @@
 package services
 
-// このファイルは将来のMediaDataService実装時の参考例です
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	grpcConnect "server-grpc/gen/grpc/v1/grpcv1connect"
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+)
+
+// ThumbnailsHTTPPrefix はサムネイル配信用HTTPハンドラーのパス接頭辞です
+const ThumbnailsHTTPPrefix = "/thumbnails/"
+
+// thumbnailDefaultSize はサイズ未指定時のサムネイルの長辺のピクセル数
+const thumbnailDefaultSize = 256
 
 // MultiMediaService はマルチメディア管理サービスを提供する
 type MultiMediaService struct {
+	// Embed the unimplemented handler for forward compatibility
+	grpcConnect.UnimplementedMultiMediaServiceHandler
+
+	// services は任意のgrpcサービスハンドラーへの参照
+	services *Services
+
+	// MediaPath はメディアファイルを探すルートフォルダー（FileService と同じ）
+	// パスの解決とファイル内容のハッシュは FileService のものを共有します
 	MediaPath string
+
+	// thumbnails は作成したサムネイルのキャッシュ
+	thumbnails *core.ThumbnailCache
+
+	// generating はサムネイルの同時作成数を制限するセマフォ
+	generating chan struct{}
 }
 
-/*
+func (s *MultiMediaService) Start(services *Services, options *map[string]string) error {
+	// オプションの取得
+	optTarget, exists := (*options)["FileServiceTarget"]
+	if !exists {
+		return errors.New("FileServiceTarget option is required")
+	}
+	optCacheFolder, exists := (*options)["ThumbnailCacheFolder"]
+	if !exists {
+		return errors.New("ThumbnailCacheFolder option is required")
+	}
+	optCacheMax, exists := (*options)["ThumbnailCacheMaxMB"]
+	if !exists {
+		optCacheMax = "512"
+	}
+	cacheMaxMB, err := strconv.ParseInt(optCacheMax, 10, 64)
+	if err != nil {
+		return err
+	}
+	optConcurrency, exists := (*options)["ThumbnailMaxConcurrency"]
+	if !exists {
+		optConcurrency = "2"
+	}
+	concurrency, err := strconv.Atoi(optConcurrency)
+	if err != nil {
+		return err
+	}
+
+	// パスを正規化
+	target, err := core.NormalizeAbsPath(optTarget)
+	if err != nil {
+		return err
+	}
 
-// NewMultiMediaService はMultiMediaServiceを初期化する
-func NewMultiMediaService(mediaPath string) (*MultiMediaService, error) {
-	fileService, err := NewFileService(mediaPath)
+	// サムネイルのキャッシュを開く
+	thumbnails, err := core.OpenThumbnailCache(optCacheFolder, cacheMaxMB<<20)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &MultiMediaService{
-		FileService:  fileService,
-		CacheService: NewCacheService(),
-		MediaPath:    mediaPath,
-	}, nil
+	s.services = services
+	s.MediaPath = target
+	s.thumbnails = thumbnails
+	s.generating = make(chan struct{}, max(concurrency, 1))
+	return nil
+}
+
+func (s *MultiMediaService) Cleanup() {
 }
 
-// ListMediaFiles はメディアファイル一覧を取得する
-func (s *MultiMediaService) ListMediaFiles(path string) ([]models.MediaFile, error) {
-	// 実装例
-	// 1. ファイル一覧を取得
-	// 2. メディアファイルのみフィルタリング（画像、動画、音声）
-	// 3. メタデータを付与
-	return []models.MediaFile{}, nil
+// fileService はパスの解決とファイル内容のハッシュに使用する FileService を返します
+func (s *MultiMediaService) fileService() (*FileService, error) {
+	fileService, ok := s.services.fileService()
+	if !ok || fileService.jail == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("FileService is not available"))
+	}
+	return fileService, nil
 }
 
-// UploadMediaFile はメディアファイルをアップロードする
-func (s *MediaDataService) UploadMediaFile(file []byte, filename string) (*models.MediaFile, error) {
-	// 実装例
-	// 1. ファイルタイプの検証
-	// 2. ファイルサイズの検証
-	// 3. ファイルを保存
-	// 4. メタデータを抽出
-	// 5. データベースに記録
-	return &models.MediaFile{}, nil
+// GetThumbnail は JPEG・PNG・GIF の画像ファイルのサムネイルを返します
+// size はサムネイルの長辺のピクセル数です（未指定の場合は 256）
+// gRPCサービスの実装です
+func (s *MultiMediaService) GetThumbnail(
+	ctx context.Context, req *grpc.GetThumbnailRequest) (
+	*grpc.GetThumbnailResponse, error) {
+
+	thumb, digest, err := s.thumbnail(ctx, req.GetPathistFolder(), int(req.GetSize()))
+	switch {
+	case errors.Is(err, core.ErrThumbnailUnsupported):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, core.ErrThumbnailTooLarge):
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	case err != nil:
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	res := grpc.GetThumbnailResponse_builder{}.Build()
+	res.SetData(thumb.Data)
+	res.SetMimeType(thumb.MimeType)
+	res.SetWidth(int32(thumb.Width))
+	res.SetHeight(int32(thumb.Height))
+	res.SetDigest(digest)
+	return res, nil
 }
 
-// GetMediaMetadata はメディアファイルのメタデータを取得する
-func (s *MediaDataService) GetMediaMetadata(id string) (*models.MediaMetadata, error) {
-	// 実装例
-	// 1. ファイルを特定
-	// 2. EXIF情報等を抽出
-	// 3. ファイルサイズ、解像度等を取得
-	return &models.MediaMetadata{}, nil
+// ServeThumbnails は /thumbnails/<相対パス>?size=<ピクセル数> のサムネイルを通常のHTTPで配信します
+// ブラウザでフォルダー内の写真を一覧表示するための画像として使用します
+func (s *MultiMediaService) ServeThumbnails(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	size := 0
+	if optSize := r.URL.Query().Get("size"); optSize != "" {
+		n, err := strconv.Atoi(optSize)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		size = n
+	}
+
+	relPath := strings.TrimPrefix(r.URL.Path, ThumbnailsHTTPPrefix)
+	thumb, digest, err := s.thumbnail(r.Context(), relPath, size)
+	if err != nil {
+		status := httpStatusFrom(err)
+		switch {
+		case errors.Is(err, core.ErrThumbnailUnsupported):
+			status = http.StatusUnsupportedMediaType
+		case errors.Is(err, core.ErrThumbnailTooLarge):
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	// 内容のハッシュとサイズが同じであれば同じサムネイル
+	header := w.Header()
+	header.Set("Content-Type", thumb.MimeType)
+	header.Set("ETag", fmt.Sprintf(`"%s-%d"`, digest, thumbnailSizeFrom(size)))
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(thumb.Data))
 }
 
-// GenerateThumbnail はサムネイルを生成する
-func (s *MediaDataService) GenerateThumbnail(id string, size int) (*models.Thumbnail, error) {
-	// 実装例
-	// 1. キャッシュを確認
-	// 2. なければ生成
-	// 3. キャッシュに保存
-	// 4. サムネイルパスを返す
-	return &models.Thumbnail{}, nil
+// thumbnail は相対パスの画像のサムネイルとファイル内容のハッシュを返します
+// キャッシュにない場合は作成してキャッシュに保存します
+func (s *MultiMediaService) thumbnail(ctx context.Context, relPath string, size int) (*core.Thumbnail, string, error) {
+	fileService, err := s.fileService()
+	if err != nil {
+		return nil, "", err
+	}
+	absPath, err := fileService.GetAbsPathFrom(relPath)
+	if err != nil {
+		return nil, "", err
+	}
+	if !core.FilenameIsThumbnailSource(absPath) {
+		return nil, "", core.ErrThumbnailUnsupported
+	}
+	size = thumbnailSizeFrom(size)
+
+	// 内容のハッシュでキャッシュを確認
+	digest, err := fileService.hashes.Digest(absPath)
+	if err != nil {
+		return nil, "", err
+	}
+	if thumb, ok := s.thumbnails.Get(digest, size); ok {
+		return thumb, digest, nil
+	}
+
+	// 同時に作成する数を制限
+	select {
+	case s.generating <- struct{}{}:
+		defer func() { <-s.generating }()
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	}
+
+	thumb, err := core.GenerateThumbnail(absPath, size)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.thumbnails.Put(digest, size, thumb); err != nil {
+		log.Printf("MultiMediaService: Failed to cache thumbnail of %s: %v", absPath, err)
+	}
+	return thumb, digest, nil
 }
 
-// GetSupportedFormats はサポートされているメディア形式を返す
-func (s *MediaDataService) GetSupportedFormats() []string {
-	return []string{
-		// 画像
-		".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp",
-		// 動画
-		".mp4", ".avi", ".mov", ".wmv", ".flv",
-		// 音声
-		".mp3", ".wav", ".flac", ".aac", ".ogg",
+// thumbnailSizeFrom は要求されたサイズを作成可能な範囲に収めます（0以下の場合は既定値）
+func thumbnailSizeFrom(size int) int {
+	if size <= 0 {
+		return thumbnailDefaultSize
 	}
+	return min(max(size, core.ThumbnailMinSize), core.ThumbnailMaxSize)
 }
-*/
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"time"
+
+	grpc "server-grpc/gen/grpc/v1"
+	"server-grpc/internal/core"
+	"server-grpc/internal/models"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// albumDateLayout は写真アルバムの撮影日の書式
+const albumDateLayout = "2006-01-02"
+
+// albumPhoto は写真アルバムに追加する写真です
+type albumPhoto struct {
+	absPath     string
+	metadata    *grpc.MediaMetadata
+	captureTime time.Time
+}
+
+// GetMediaMetadata は画像ファイルのピクセル数と EXIF 情報（撮影日時・向き・カメラ・撮影位置）を返します
+// width, height は画像に記録されたピクセル数で、orientation による回転前の値です
+// gRPCサービスの実装です
+func (s *MultiMediaService) GetMediaMetadata(
+	_ context.Context, req *grpc.GetMediaMetadataRequest) (
+	*grpc.GetMediaMetadataResponse, error) {
+
+	fileService, err := s.fileService()
+	if err != nil {
+		return nil, err
+	}
+	absPath, err := fileService.GetAbsPathFrom(req.GetPathistFolder())
+	if err != nil {
+		return nil, connectError(err, connect.CodeInvalidArgument)
+	}
+	if !core.FilenameIsThumbnailSource(absPath) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, core.ErrThumbnailUnsupported)
+	}
+
+	photo, err := mediaMetadataOf(fileService.PathistFolder, absPath)
+	if errors.Is(err, core.ErrThumbnailUnsupported) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+
+	res := grpc.GetMediaMetadataResponse_builder{}.Build()
+	res.SetMetadata(photo.metadata)
+	return res, nil
+}
+
+// GetKojiPhotoAlbum は工事フォルダー配下の写真を撮影日ごとにまとめて返します。
+//   - 撮影日は EXIF の撮影日時（サーバーのローカル時刻）の日付です。
+//   - 工事の開始日より前、または終了日より後に撮影された写真には outside_period を設定します。
+//   - 撮影日時が記録されていない写真は undated に含めます。
+//
+// gRPCサービスの実装です
+func (s *MultiMediaService) GetKojiPhotoAlbum(
+	ctx context.Context, req *grpc.GetKojiPhotoAlbumRequest) (
+	*grpc.GetKojiPhotoAlbumResponse, error) {
+
+	// 工事情報を取得
+	kojiService, ok := s.services.kojiService()
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("KojiService is not available"))
+	}
+	koji, exist := kojiService.kojies.Load().Get(req.GetKojiId())
+	if !exist {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("koji not found"))
+	}
+	fileService, err := s.fileService()
+	if err != nil {
+		return nil, err
+	}
+	absFolder := koji.GetPathistFolder()
+	if err := fileService.jail.Verify(absFolder); err != nil {
+		return nil, connectError(err, connect.CodeFailedPrecondition)
+	}
+
+	// 工事フォルダー配下の画像ファイルを取得
+	entries, err := collectEntries(ctx, absFolder, fileQueryMaxDepth)
+	if err != nil {
+		return nil, connectError(err, connect.CodeInternal)
+	}
+	images := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.isDir && core.FilenameIsThumbnailSource(entry.absPath) {
+			images = append(images, entry.absPath)
+		}
+	}
+
+	// EXIF 情報を並列に読み込み、読み込めない画像は除外
+	photos, _ := core.ParallelMap(ctx, images,
+		func(_ context.Context, absPath string) (albumPhoto, error) {
+			return mediaMetadataOf(fileService.PathistFolder, absPath)
+		})
+	if err := ctx.Err(); err != nil {
+		return nil, connectError(err, connect.CodeCanceled)
+	}
+	slices.SortFunc(photos, func(a, b albumPhoto) int {
+		if c := a.captureTime.Compare(b.captureTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.absPath, b.absPath)
+	})
+
+	// 工事期間（日付単位）
+	startDay, endDay := "", ""
+	if start := (&models.Timestamp{Timestamp: koji.GetStart()}); koji.HasStart() && start.IsValid() {
+		startDay = start.AsTime().In(time.Local).Format(albumDateLayout)
+	}
+	if end := (&models.Timestamp{Timestamp: koji.GetPersistEnd()}); koji.HasPersistEnd() && end.IsValid() {
+		endDay = end.AsTime().In(time.Local).Format(albumDateLayout)
+	}
+
+	// 撮影日ごとにまとめる
+	days := make([]*grpc.PhotoAlbumDay, 0)
+	undated := make([]*grpc.PhotoAlbumItem, 0)
+	outsideCount := 0
+	for _, photo := range photos {
+		item := grpc.PhotoAlbumItem_builder{
+			Metadata:     photo.metadata,
+			RelativePath: s.relPathFrom(photo.absPath),
+		}.Build()
+		if photo.captureTime.IsZero() {
+			undated = append(undated, item)
+			continue
+		}
+
+		day := photo.captureTime.In(time.Local).Format(albumDateLayout)
+		outside := (startDay != "" && day < startDay) || (endDay != "" && day > endDay)
+		item.SetOutsidePeriod(outside)
+		if outside {
+			outsideCount++
+		}
+
+		if n := len(days); n == 0 || days[n-1].GetDate() != day {
+			days = append(days, grpc.PhotoAlbumDay_builder{Date: day, OutsidePeriod: outside}.Build())
+		}
+		current := days[len(days)-1]
+		current.SetPhotos(append(current.GetPhotos(), item))
+	}
+
+	res := grpc.GetKojiPhotoAlbumResponse_builder{}.Build()
+	res.SetKoji(koji.Koji)
+	res.SetDays(days)
+	res.SetUndated(undated)
+	res.SetPhotoCount(int32(len(photos)))
+	res.SetOutsideCount(int32(outsideCount))
+	return res, nil
+}
+
+// mediaMetadataOf は画像ファイルのファイル情報・ピクセル数・EXIF 情報を読み込みます
+// EXIF 情報がない画像はファイル情報とピクセル数のみを返します
+// root はファイル情報の ID を作成するためのファイルサービスのルートフォルダーです
+func mediaMetadataOf(root, absPath string) (albumPhoto, error) {
+	fi := models.NewFile()
+	if err := fi.ParseFrom(root, absPath); err != nil {
+		return albumPhoto{}, err
+	}
+	width, height, err := core.ReadImageSize(absPath)
+	if err != nil {
+		return albumPhoto{}, err
+	}
+
+	metadata := grpc.MediaMetadata_builder{
+		File:        fi.File,
+		Width:       int32(width),
+		Height:      int32(height),
+		Orientation: 1,
+	}.Build()
+	photo := albumPhoto{absPath: absPath, metadata: metadata}
+
+	exif, err := core.ReadExif(absPath)
+	if errors.Is(err, core.ErrExifNotFound) {
+		return photo, nil
+	}
+	if err != nil {
+		return albumPhoto{}, err
+	}
+	metadata.SetOrientation(int32(exif.Orientation))
+	metadata.SetCameraMake(exif.Make)
+	metadata.SetCameraModel(exif.Model)
+	if !exif.CaptureTime.IsZero() {
+		metadata.SetCaptureTime(timestamppb.New(exif.CaptureTime))
+		photo.captureTime = exif.CaptureTime
+	}
+	if exif.HasLocation {
+		metadata.SetHasLocation(true)
+		metadata.SetLatitude(exif.Latitude)
+		metadata.SetLongitude(exif.Longitude)
+	}
+	return photo, nil
+}
+
+// relPathFrom は絶対パスを MediaPath からの相対パス（スラッシュ区切り）に変換します
+func (s *MultiMediaService) relPathFrom(absPath string) string {
+	rel, err := filepath.Rel(s.MediaPath, absPath)
+	if err != nil {
+		return absPath
+	}
+	return filepath.ToSlash(rel)
+}
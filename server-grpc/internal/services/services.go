@@ -1,6 +1,18 @@
 package services
 
-import "server-grpc/internal/core"
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"server-grpc/internal/core"
+
+	"connectrpc.com/connect"
+	"github.com/fsnotify/fsnotify"
+)
 
 // Sevice は各サービスが実装すべきインターフェースを定義します。
 type Sevice interface {
@@ -11,6 +23,9 @@ type Sevice interface {
 // Services は各サービスのハンドラーをまとめた構造体です。
 type Services struct {
 	ServiceMap map[string]*Sevice
+
+	// Journal は各サービスが検知した変更を記録する変更ジャーナル
+	Journal *core.Journal
 }
 
 // NewServices は与えられたオプションでサービス群を初期化します。
@@ -28,6 +43,11 @@ func (ss *Services) AddService(serviceName string, service Sevice) {
 
 // StartAll はすべてのサービスを起動する
 func (ss *Services) StartAll() error {
+	// 変更ジャーナルは各サービスより先に開く
+	if err := ss.openJournal(&core.ConfigMap); err != nil {
+		return err
+	}
+
 	for _, s := range ss.ServiceMap {
 		if err := (*s).Start(ss, &core.ConfigMap); err != nil {
 			return err
@@ -41,4 +61,194 @@ func (ss *Services) CleanupAll() {
 	for _, srv := range ss.ServiceMap {
 		(*srv).Cleanup()
 	}
+
+	if ss.Journal != nil {
+		if err := ss.Journal.Close(); err != nil {
+			log.Printf("Services: Failed to close journal: %v", err)
+		}
+		ss.Journal = nil
+	}
+}
+
+// kojiService は登録されている KojiService を返します
+func (ss *Services) kojiService() (*KojiService, bool) {
+	if ss == nil {
+		return nil, false
+	}
+	srv, ok := ss.ServiceMap["KojiService"]
+	if !ok {
+		return nil, false
+	}
+	kojiService, ok := (*srv).(*KojiService)
+	return kojiService, ok
+}
+
+// companyService は登録されている CompanyService を返します
+func (ss *Services) companyService() (*CompanyService, bool) {
+	if ss == nil {
+		return nil, false
+	}
+	srv, ok := ss.ServiceMap["CompanyService"]
+	if !ok {
+		return nil, false
+	}
+	companyService, ok := (*srv).(*CompanyService)
+	return companyService, ok
+}
+
+// fileService は登録されている FileService を返します
+func (ss *Services) fileService() (*FileService, bool) {
+	if ss == nil {
+		return nil, false
+	}
+	srv, ok := ss.ServiceMap["FileService"]
+	if !ok {
+		return nil, false
+	}
+	fileService, ok := (*srv).(*FileService)
+	return fileService, ok
+}
+
+// kojiFolderOf は工事IDの工事フォルダーの絶対パスを返します
+func (ss *Services) kojiFolderOf(kojiId string) (string, error) {
+	kojiService, ok := ss.kojiService()
+	if !ok {
+		return "", connect.NewError(connect.CodeUnavailable, errors.New("KojiService is not available"))
+	}
+	koji, exist := kojiService.kojies.Load().Get(kojiId)
+	if !exist {
+		return "", connect.NewError(connect.CodeNotFound, errors.New("koji not found"))
+	}
+	return koji.GetPathistFolder(), nil
+}
+
+// companyFolderOf は会社IDの会社フォルダーの絶対パスを返します
+func (ss *Services) companyFolderOf(companyId string) (string, error) {
+	companyService, ok := ss.companyService()
+	if !ok {
+		return "", connect.NewError(connect.CodeUnavailable, errors.New("CompanyService is not available"))
+	}
+	company, exist := companyService.companies.Load().Get(companyId)
+	if !exist {
+		return "", connect.NewError(connect.CodeNotFound, errors.New("company not found"))
+	}
+	return company.GetPathistFolder(), nil
+}
+
+// openJournal はオプションに従って変更ジャーナルを開く
+func (ss *Services) openJournal(options *map[string]string) error {
+	if ss.Journal != nil {
+		return nil
+	}
+
+	optFolder, exists := (*options)["JournalFolder"]
+	if !exists {
+		return errors.New("JournalFolder option is required")
+	}
+	segmentMaxBytes, err := strconv.ParseInt((*options)["JournalSegmentMaxBytes"], 10, 64)
+	if err != nil {
+		return err
+	}
+	maxSegments, err := strconv.Atoi((*options)["JournalMaxSegments"])
+	if err != nil {
+		return err
+	}
+
+	journal, err := core.OpenJournal(optFolder, segmentMaxBytes, maxSegments)
+	if err != nil {
+		return err
+	}
+	ss.Journal = journal
+	return nil
+}
+
+// changeEntityOf は変更されたパスの種別とエンティティIDを返します
+// 会社フォルダー・工事フォルダー配下の場合は会社・工事のIDを、それ以外はファイルとして返します
+func (ss *Services) changeEntityOf(path string) (kind, entityId string) {
+	if companyService, ok := ss.companyService(); ok {
+		if id := companyService.companyIdFromPath(path); id != "" {
+			return core.JournalKindCompany, id
+		}
+	}
+	if kojiService, ok := ss.kojiService(); ok {
+		if id := kojiService.kojiIdFromPath(path); id != "" {
+			return core.JournalKindKoji, id
+		}
+	}
+	return core.JournalKindFile, ""
+}
+
+// RecordChange はファイルシステム監視イベントを変更ジャーナルに記録する
+// kind: 変更対象の種別（core.JournalKindFile など）
+// entityId: 変更対象のエンティティID（ファイルの場合は空文字列）
+func (ss *Services) RecordChange(kind string, event fsnotify.Event, entityId string) {
+	if ss == nil || ss.Journal == nil || event.Name == "" {
+		return
+	}
+
+	// Pathist の内部管理用ファイルの変更は記録しない
+	if core.PathIsPathistSystem(event.Name) {
+		return
+	}
+
+	_, err := ss.Journal.Append(core.JournalEntry{
+		Kind:     kind,
+		Op:       core.JournalOpFrom(event.Op),
+		Path:     event.Name,
+		EntityId: entityId,
+	})
+	if err != nil {
+		log.Printf("Services: Failed to record change %s: %v", event, err)
+	}
+}
+
+// connectError はエラーを Connect のエラーに変換します
+// 既知のエラーは対応するコードに変換し、それ以外は code を使用します
+func connectError(err error, code connect.Code) error {
+	var connectErr *connect.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &connectErr):
+		return err
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, core.ErrFolderLocked):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, core.ErrUploadInUse):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, core.ErrUploadMismatch), errors.Is(err, core.ErrUploadOffset):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, core.ErrUploadChecksum):
+		return connect.NewError(connect.CodeDataLoss, err)
+	case errors.Is(err, core.ErrUnsafeFilename):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, core.ErrPathOutsideRoot), errors.Is(err, core.ErrSystemPath):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, os.ErrNotExist):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, os.ErrExist):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, os.ErrPermission):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return connect.NewError(code, err)
+}
+
+// requestActor はリクエストを行ったユーザーまたは接続元を返します
+// X-Pathist-User ヘッダーが指定されている場合はその値、それ以外は接続元アドレスを使用します
+func requestActor(ctx context.Context) string {
+	info, ok := connect.CallInfoForHandlerContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	if user := strings.TrimSpace(info.RequestHeader().Get("X-Pathist-User")); user != "" {
+		return user
+	}
+	if addr := info.Peer().Addr; addr != "" {
+		return addr
+	}
+	return "unknown"
 }